@@ -0,0 +1,27 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ChildResourceStatus is the readiness of a resource generated by a composite resource, such as a DeadLetterTopology
+type ChildResourceStatus struct {
+	// Kind of the resource, e.g. Queue.
+	Kind string `json:"kind"`
+	// Name of the resource.
+	Name string `json:"name"`
+	// Status of the Ready condition of the resource; True, False, or Unknown.
+	// Unknown when the resource has not been reconciled since its last update.
+	Ready corev1.ConditionStatus `json:"ready"`
+	// Message of the Ready condition of the resource.
+	Message string `json:"message,omitempty"`
+}
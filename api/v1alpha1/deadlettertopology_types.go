@@ -0,0 +1,123 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	DeadLetterModeArguments = "arguments"
+	DeadLetterModePolicy    = "policy"
+)
+
+// DeadLetterTopologySpec defines the desired state of DeadLetterTopology
+type DeadLetterTopologySpec struct {
+	// Name of the work queue; required property.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Default to vhost '/'; cannot be updated
+	// +kubebuilder:default:=/
+	Vhost string `json:"vhost,omitempty"`
+	// Type of the work queue and of the dead letter queue; defaults to 'quorum'. Cannot be updated.
+	// +kubebuilder:validation:Enum=quorum;classic
+	// +kubebuilder:default:=quorum
+	Type string `json:"type,omitempty"`
+	// Name of the dead letter exchange; defaults to '<name>.dlx'. Cannot be updated.
+	// +kubebuilder:validation:Optional
+	DeadLetterExchange string `json:"deadLetterExchange,omitempty"`
+	// Name of the dead letter queue; defaults to '<name>.dlq'. Cannot be updated.
+	// +kubebuilder:validation:Optional
+	DeadLetterQueue string `json:"deadLetterQueue,omitempty"`
+	// Number of times a message can be redelivered before it is dead lettered.
+	// Only supported by quorum queues.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	DeliveryLimit *int64 `json:"deliveryLimit,omitempty"`
+	// Time to live of messages in the dead letter queue, in milliseconds.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum:=0
+	DeadLetterQueueTTL *int64 `json:"deadLetterQueueTTL,omitempty"`
+	// How the dead lettering settings are declared. With 'arguments', they are declared as queue arguments, and
+	// deliveryLimit and deadLetterQueueTTL cannot be updated. With 'policy', they are declared in policies matching
+	// the work queue and the dead letter queue, and can be updated.
+	// Defaults to 'arguments'; cannot be updated.
+	// +kubebuilder:validation:Enum=arguments;policy
+	// +kubebuilder:default:=arguments
+	Mode string `json:"mode,omitempty"`
+	// Reference to the RabbitmqCluster that the DeadLetterTopology will be created in.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference topologyv1beta1.RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+}
+
+// DeadLetterTopologyStatus defines the observed state of DeadLetterTopology
+type DeadLetterTopologyStatus struct {
+	// observedGeneration is the most recent successful generation observed for this DeadLetterTopology. It corresponds to the
+	// DeadLetterTopology's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contain a Ready condition, which is true when all generated resources are ready.
+	Conditions []topologyv1beta1.Condition `json:"conditions,omitempty"`
+	// Resources lists the resources generated by this DeadLetterTopology and their readiness.
+	Resources []ChildResourceStatus `json:"resources,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// DeadLetterTopology is the Schema for the deadlettertopologies API
+// It generates a work queue, a dead letter exchange, a dead letter queue and the binding between them.
+type DeadLetterTopology struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeadLetterTopologySpec   `json:"spec,omitempty"`
+	Status DeadLetterTopologyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeadLetterTopologyList contains a list of DeadLetterTopologies
+type DeadLetterTopologyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeadLetterTopology `json:"items"`
+}
+
+func (d *DeadLetterTopology) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    d.GroupVersionKind().Group,
+		Resource: d.GroupVersionKind().Kind,
+	}
+}
+
+// DeadLetterExchangeName returns the name of the dead letter exchange, which defaults to '<name>.dlx'
+func (s *DeadLetterTopologySpec) DeadLetterExchangeName() string {
+	if s.DeadLetterExchange != "" {
+		return s.DeadLetterExchange
+	}
+	return s.Name + ".dlx"
+}
+
+// DeadLetterQueueName returns the name of the dead letter queue, which defaults to '<name>.dlq'
+func (s *DeadLetterTopologySpec) DeadLetterQueueName() string {
+	if s.DeadLetterQueue != "" {
+		return s.DeadLetterQueue
+	}
+	return s.Name + ".dlq"
+}
+
+func init() {
+	SchemeBuilder.Register(&DeadLetterTopology{}, &DeadLetterTopologyList{})
+}
@@ -0,0 +1,122 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (d *DeadLetterTopology) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(d).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1alpha1-deadlettertopology,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=deadlettertopologies,versions=v1alpha1,name=vdeadlettertopology.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DeadLetterTopology{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// deliveryLimit is only supported by quorum queues
+func (d *DeadLetterTopology) ValidateCreate() error {
+	if err := d.Spec.RabbitmqClusterReference.ValidateOnCreate(d.GroupResource(), d.Name); err != nil {
+		return err
+	}
+	return d.validateSpec()
+}
+
+// ValidateUpdate returns error type 'forbidden' for updates on name, vhost, type, dead letter exchange and queue names,
+// mode and rabbitmqClusterReference
+// deliveryLimit and deadLetterQueueTTL can only be updated in mode 'policy', since queue arguments cannot be updated
+func (d *DeadLetterTopology) ValidateUpdate(old runtime.Object) error {
+	oldTopology, ok := old.(*DeadLetterTopology)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a deadlettertopology but got a %T", old))
+	}
+
+	detailMsg := "updates on name, vhost, type, deadLetterExchange, deadLetterQueue, mode and rabbitmqClusterReference are all forbidden"
+	if d.Spec.Name != oldTopology.Spec.Name {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
+	}
+	if d.Spec.Vhost != oldTopology.Spec.Vhost {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+	if d.Spec.Type != oldTopology.Spec.Type {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "type"), detailMsg))
+	}
+	if d.Spec.DeadLetterExchangeName() != oldTopology.Spec.DeadLetterExchangeName() {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "deadLetterExchange"), detailMsg))
+	}
+	if d.Spec.DeadLetterQueueName() != oldTopology.Spec.DeadLetterQueueName() {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "deadLetterQueue"), detailMsg))
+	}
+	if d.Spec.Mode != oldTopology.Spec.Mode {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "mode"), detailMsg))
+	}
+	if !oldTopology.Spec.RabbitmqClusterReference.Matches(&d.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+
+	if d.Spec.Mode != DeadLetterModePolicy {
+		argumentsMsg := "can only be updated when mode is 'policy'"
+		if !int64PtrEqual(d.Spec.DeliveryLimit, oldTopology.Spec.DeliveryLimit) {
+			return apierrors.NewForbidden(d.GroupResource(), d.Name,
+				field.Forbidden(field.NewPath("spec", "deliveryLimit"), argumentsMsg))
+		}
+		if !int64PtrEqual(d.Spec.DeadLetterQueueTTL, oldTopology.Spec.DeadLetterQueueTTL) {
+			return apierrors.NewForbidden(d.GroupResource(), d.Name,
+				field.Forbidden(field.NewPath("spec", "deadLetterQueueTTL"), argumentsMsg))
+		}
+	}
+
+	return d.validateSpec()
+}
+
+// ValidateDelete no validation on delete
+func (d *DeadLetterTopology) ValidateDelete() error {
+	return nil
+}
+
+func (d *DeadLetterTopology) validateSpec() error {
+	var errorList field.ErrorList
+	if d.Spec.DeliveryLimit != nil && d.Spec.Type == "classic" {
+		errorList = append(errorList, field.Invalid(field.NewPath("spec", "deliveryLimit"), *d.Spec.DeliveryLimit,
+			"not supported by queues of type 'classic'"))
+	}
+	if d.Spec.DeadLetterQueueName() == d.Spec.Name {
+		errorList = append(errorList, field.Invalid(field.NewPath("spec", "deadLetterQueue"), d.Spec.DeadLetterQueue,
+			"must be different from spec.name"))
+	}
+	if len(errorList) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("DeadLetterTopology").GroupKind(), d.Name, errorList)
+}
+
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,151 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("deadlettertopology webhook", func() {
+	var deadLetterTopology = DeadLetterTopology{}
+	BeforeEach(func() {
+		deadLetterTopology = DeadLetterTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: DeadLetterTopologySpec{
+				Name:               "orders",
+				Vhost:              "/",
+				Type:               "quorum",
+				DeliveryLimit:      pointer.Int64(5),
+				DeadLetterQueueTTL: pointer.Int64(60000),
+				Mode:               DeadLetterModeArguments,
+				RabbitmqClusterReference: topologyv1beta1.RabbitmqClusterReference{
+					Name: "a-cluster",
+				},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := deadLetterTopology.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := deadLetterTopology.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow a delivery limit for classic queues", func() {
+			notAllowed := deadLetterTopology.DeepCopy()
+			notAllowed.Spec.Type = "classic"
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.deliveryLimit: Invalid value")))
+		})
+
+		It("does not allow a dead letter queue named like the work queue", func() {
+			notAllowed := deadLetterTopology.DeepCopy()
+			notAllowed.Spec.DeadLetterQueue = "orders"
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.deadLetterQueue: Invalid value")))
+		})
+
+		It("allows a valid dead letter topology", func() {
+			Expect(deadLetterTopology.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on name", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.Name = "new-name"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on vhost", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on type", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.Type = "classic"
+			newTopology.Spec.DeliveryLimit = nil
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on dead letter exchange and queue names", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.DeadLetterExchange = "new-dlx"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+
+			newTopology = deadLetterTopology.DeepCopy()
+			newTopology.Spec.DeadLetterQueue = "new-dlq"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		It("allows setting dead letter exchange and queue names to their defaults", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.DeadLetterExchange = "orders.dlx"
+			newTopology.Spec.DeadLetterQueue = "orders.dlq"
+			Expect(newTopology.ValidateUpdate(&deadLetterTopology)).To(Succeed())
+		})
+
+		It("does not allow updates on mode", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.Mode = DeadLetterModePolicy
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newTopology := deadLetterTopology.DeepCopy()
+			newTopology.Spec.RabbitmqClusterReference = topologyv1beta1.RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&deadLetterTopology))).To(BeTrue())
+		})
+
+		When("mode is 'arguments'", func() {
+			It("does not allow updates on delivery limit", func() {
+				newTopology := deadLetterTopology.DeepCopy()
+				newTopology.Spec.DeliveryLimit = pointer.Int64(10)
+				err := newTopology.ValidateUpdate(&deadLetterTopology)
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("spec.deliveryLimit")))
+			})
+
+			It("does not allow updates on dead letter queue TTL", func() {
+				newTopology := deadLetterTopology.DeepCopy()
+				newTopology.Spec.DeadLetterQueueTTL = nil
+				err := newTopology.ValidateUpdate(&deadLetterTopology)
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err).To(MatchError(ContainSubstring("spec.deadLetterQueueTTL")))
+			})
+		})
+
+		When("mode is 'policy'", func() {
+			BeforeEach(func() {
+				deadLetterTopology.Spec.Mode = DeadLetterModePolicy
+			})
+
+			It("allows updates on delivery limit and dead letter queue TTL", func() {
+				newTopology := deadLetterTopology.DeepCopy()
+				newTopology.Spec.DeliveryLimit = pointer.Int64(10)
+				newTopology.Spec.DeadLetterQueueTTL = nil
+				Expect(newTopology.ValidateUpdate(&deadLetterTopology)).To(Succeed())
+			})
+		})
+	})
+})
@@ -0,0 +1,158 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// MessagingApplicationSpec defines the desired state of MessagingApplication
+type MessagingApplicationSpec struct {
+	// Name of the vhost of the application; required property. The vhost is created by the MessagingApplication,
+	// and all exchanges, queues, bindings and permissions are declared in it. Cannot be updated.
+	// +kubebuilder:validation:Required
+	Vhost string `json:"vhost"`
+	// Exchanges of the application.
+	// +kubebuilder:validation:Optional
+	Exchanges []ApplicationExchange `json:"exchanges,omitempty"`
+	// Queues of the application.
+	// +kubebuilder:validation:Optional
+	Queues []ApplicationQueue `json:"queues,omitempty"`
+	// Bindings of the application. Sources and destinations are usually exchanges and queues of the application.
+	// +kubebuilder:validation:Optional
+	Bindings []ApplicationBinding `json:"bindings,omitempty"`
+	// User of the application; when set, a user is created and granted permissions in the vhost.
+	// +kubebuilder:validation:Optional
+	User *ApplicationUser `json:"user,omitempty"`
+	// Reference to the RabbitmqCluster that the MessagingApplication will be created in.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference topologyv1beta1.RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+}
+
+// ApplicationExchange is an exchange declared by a MessagingApplication
+type ApplicationExchange struct {
+	// Name of the exchange; required property.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Cannot be updated
+	// +kubebuilder:default:=direct
+	Type string `json:"type,omitempty"`
+	// Cannot be updated
+	Durable bool `json:"durable,omitempty"`
+	// Cannot be updated
+	AutoDelete bool `json:"autoDelete,omitempty"`
+	// Cannot be updated
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Arguments *runtime.RawExtension `json:"arguments,omitempty"`
+}
+
+// ApplicationQueue is a queue declared by a MessagingApplication
+type ApplicationQueue struct {
+	// Name of the queue; required property.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Cannot be updated
+	Type string `json:"type,omitempty"`
+	// Cannot be updated
+	Durable bool `json:"durable,omitempty"`
+	// Cannot be updated
+	AutoDelete bool `json:"autoDelete,omitempty"`
+	// Queue arguments in the format of KEY: VALUE. e.g. x-delivery-limit: 10000. Cannot be updated.
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Arguments *runtime.RawExtension `json:"arguments,omitempty"`
+}
+
+// ApplicationBinding is a binding declared by a MessagingApplication
+type ApplicationBinding struct {
+	// Name of the source exchange; required property.
+	// +kubebuilder:validation:Required
+	Source string `json:"source"`
+	// Name of the destination queue or exchange; required property.
+	// +kubebuilder:validation:Required
+	Destination string `json:"destination"`
+	// Defaults to 'queue'.
+	// +kubebuilder:validation:Enum=exchange;queue
+	// +kubebuilder:default:=queue
+	DestinationType string `json:"destinationType,omitempty"`
+	// +kubebuilder:validation:Optional
+	RoutingKey string `json:"routingKey,omitempty"`
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Arguments *runtime.RawExtension `json:"arguments,omitempty"`
+}
+
+// ApplicationUser is the user of a MessagingApplication, and its permissions in the vhost of the application
+type ApplicationUser struct {
+	// List of permissions tags to associate with the user.
+	// For more information, see https://www.rabbitmq.com/management.html#permissions.
+	Tags []topologyv1beta1.UserTag `json:"tags,omitempty"`
+	// Defines a Secret used to pre-define the username and password of the user.
+	// The Secret must contain the keys `username` and `password` in its Data field.
+	// +kubebuilder:validation:Optional
+	ImportCredentialsSecret *corev1.LocalObjectReference `json:"importCredentialsSecret,omitempty"`
+	// Permissions to grant to the user in the vhost of the application; required property.
+	// +kubebuilder:validation:Required
+	Permissions topologyv1beta1.VhostPermissions `json:"permissions"`
+}
+
+// MessagingApplicationStatus defines the observed state of MessagingApplication
+type MessagingApplicationStatus struct {
+	// observedGeneration is the most recent successful generation observed for this MessagingApplication. It corresponds to the
+	// MessagingApplication's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contain a Ready condition, which is true when all generated resources are ready.
+	Conditions []topologyv1beta1.Condition `json:"conditions,omitempty"`
+	// Resources lists the resources generated by this MessagingApplication and their readiness.
+	Resources []ChildResourceStatus `json:"resources,omitempty"`
+	// Provides a reference to the Secret containing the credentials of the application user.
+	UserCredentials *corev1.LocalObjectReference `json:"userCredentials,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// MessagingApplication is the Schema for the messagingapplications API
+// It generates the vhost, exchanges, queues, bindings, user and permissions of an application, in dependency order.
+type MessagingApplication struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MessagingApplicationSpec   `json:"spec,omitempty"`
+	Status MessagingApplicationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MessagingApplicationList contains a list of MessagingApplications
+type MessagingApplicationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MessagingApplication `json:"items"`
+}
+
+func (m *MessagingApplication) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    m.GroupVersionKind().Group,
+		Resource: m.GroupVersionKind().Kind,
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&MessagingApplication{}, &MessagingApplicationList{})
+}
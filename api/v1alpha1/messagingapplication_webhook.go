@@ -0,0 +1,143 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (m *MessagingApplication) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(m).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1alpha1-messagingapplication,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=messagingapplications,versions=v1alpha1,name=vmessagingapplication.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &MessagingApplication{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// exchanges, queues and bindings must be unique
+func (m *MessagingApplication) ValidateCreate() error {
+	if err := m.Spec.RabbitmqClusterReference.ValidateOnCreate(m.GroupResource(), m.Name); err != nil {
+		return err
+	}
+	return m.validateSpec(nil)
+}
+
+// ValidateUpdate returns error type 'forbidden' for updates on vhost and rabbitmqClusterReference
+// exchanges, queues and bindings can be added and removed; type, durable, autoDelete and arguments of an existing exchange or queue cannot be updated
+func (m *MessagingApplication) ValidateUpdate(old runtime.Object) error {
+	oldApplication, ok := old.(*MessagingApplication)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a messagingapplication but got a %T", old))
+	}
+
+	detailMsg := "updates on vhost and rabbitmqClusterReference are all forbidden"
+	if m.Spec.Vhost != oldApplication.Spec.Vhost {
+		return apierrors.NewForbidden(m.GroupResource(), m.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+	if !oldApplication.Spec.RabbitmqClusterReference.Matches(&m.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(m.GroupResource(), m.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+
+	return m.validateSpec(&oldApplication.Spec)
+}
+
+// ValidateDelete no validation on delete
+func (m *MessagingApplication) ValidateDelete() error {
+	return nil
+}
+
+func (m *MessagingApplication) validateSpec(oldSpec *MessagingApplicationSpec) error {
+	var errorList field.ErrorList
+
+	oldExchanges := make(map[string]ApplicationExchange)
+	oldQueues := make(map[string]ApplicationQueue)
+	if oldSpec != nil {
+		for _, exchange := range oldSpec.Exchanges {
+			oldExchanges[exchange.Name] = exchange
+		}
+		for _, queue := range oldSpec.Queues {
+			oldQueues[queue.Name] = queue
+		}
+	}
+
+	exchanges := make(map[string]bool)
+	for i, exchange := range m.Spec.Exchanges {
+		path := field.NewPath("spec", "exchanges").Index(i)
+		if exchanges[exchange.Name] {
+			errorList = append(errorList, field.Duplicate(path.Child("name"), exchange.Name))
+		}
+		exchanges[exchange.Name] = true
+		if oldExchange, ok := oldExchanges[exchange.Name]; ok {
+			errorList = append(errorList, validateDeclarationUpdate(path,
+				exchange.Type, oldExchange.Type, exchange.Durable, oldExchange.Durable, exchange.AutoDelete, oldExchange.AutoDelete, exchange.Arguments, oldExchange.Arguments)...)
+		}
+	}
+
+	queues := make(map[string]bool)
+	for i, queue := range m.Spec.Queues {
+		path := field.NewPath("spec", "queues").Index(i)
+		if queues[queue.Name] {
+			errorList = append(errorList, field.Duplicate(path.Child("name"), queue.Name))
+		}
+		queues[queue.Name] = true
+		if oldQueue, ok := oldQueues[queue.Name]; ok {
+			errorList = append(errorList, validateDeclarationUpdate(path,
+				queue.Type, oldQueue.Type, queue.Durable, oldQueue.Durable, queue.AutoDelete, oldQueue.AutoDelete, queue.Arguments, oldQueue.Arguments)...)
+		}
+	}
+
+	bindings := make(map[string]bool)
+	for i, binding := range m.Spec.Bindings {
+		key := fmt.Sprintf("%s/%s/%s/%s", binding.Source, binding.DestinationType, binding.Destination, binding.RoutingKey)
+		if binding.Arguments != nil {
+			key += "/" + string(binding.Arguments.Raw)
+		}
+		if bindings[key] {
+			errorList = append(errorList, field.Duplicate(field.NewPath("spec", "bindings").Index(i), key))
+		}
+		bindings[key] = true
+	}
+
+	if len(errorList) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("MessagingApplication").GroupKind(), m.Name, errorList)
+}
+
+// validateDeclarationUpdate returns errors when type, durable, autoDelete or arguments of an existing exchange or queue are updated
+func validateDeclarationUpdate(path *field.Path, newType, oldType string, newDurable, oldDurable, newAutoDelete, oldAutoDelete bool, newArguments, oldArguments *runtime.RawExtension) field.ErrorList {
+	var errorList field.ErrorList
+	if newType != oldType {
+		errorList = append(errorList, field.Invalid(path.Child("type"), newType, "type cannot be updated"))
+	}
+	if newDurable != oldDurable {
+		errorList = append(errorList, field.Invalid(path.Child("durable"), newDurable, "durable cannot be updated"))
+	}
+	if newAutoDelete != oldAutoDelete {
+		errorList = append(errorList, field.Invalid(path.Child("autoDelete"), newAutoDelete, "autoDelete cannot be updated"))
+	}
+	if !reflect.DeepEqual(newArguments, oldArguments) {
+		errorList = append(errorList, field.Invalid(path.Child("arguments"), newArguments, "arguments cannot be updated"))
+	}
+	return errorList
+}
@@ -0,0 +1,150 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("messagingapplication webhook", func() {
+	var application = MessagingApplication{}
+	BeforeEach(func() {
+		application = MessagingApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: MessagingApplicationSpec{
+				Vhost: "orders",
+				Exchanges: []ApplicationExchange{
+					{Name: "orders", Type: "topic", Durable: true},
+				},
+				Queues: []ApplicationQueue{
+					{Name: "orders.created", Type: "quorum", Durable: true},
+				},
+				Bindings: []ApplicationBinding{
+					{Source: "orders", Destination: "orders.created", DestinationType: "queue", RoutingKey: "created"},
+				},
+				RabbitmqClusterReference: topologyv1beta1.RabbitmqClusterReference{
+					Name: "a-cluster",
+				},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := application.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := application.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow duplicate exchanges", func() {
+			notAllowed := application.DeepCopy()
+			notAllowed.Spec.Exchanges = append(notAllowed.Spec.Exchanges, ApplicationExchange{Name: "orders", Type: "fanout"})
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.exchanges[1].name: Duplicate value")))
+		})
+
+		It("does not allow duplicate queues", func() {
+			notAllowed := application.DeepCopy()
+			notAllowed.Spec.Queues = append(notAllowed.Spec.Queues, ApplicationQueue{Name: "orders.created"})
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.queues[1].name: Duplicate value")))
+		})
+
+		It("does not allow duplicate bindings", func() {
+			notAllowed := application.DeepCopy()
+			notAllowed.Spec.Bindings = append(notAllowed.Spec.Bindings, notAllowed.Spec.Bindings[0])
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.bindings[1]: Duplicate value")))
+		})
+
+		It("allows bindings which only differ by routing key", func() {
+			allowed := application.DeepCopy()
+			binding := allowed.Spec.Bindings[0]
+			binding.RoutingKey = "updated"
+			allowed.Spec.Bindings = append(allowed.Spec.Bindings, binding)
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on vhost", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newApplication.ValidateUpdate(&application))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.RabbitmqClusterReference = topologyv1beta1.RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newApplication.ValidateUpdate(&application))).To(BeTrue())
+		})
+
+		It("allows adding and removing exchanges, queues and bindings", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.Exchanges = append(newApplication.Spec.Exchanges, ApplicationExchange{Name: "payments", Type: "direct"})
+			newApplication.Spec.Queues = nil
+			newApplication.Spec.Bindings = nil
+			Expect(newApplication.ValidateUpdate(&application)).To(Succeed())
+		})
+
+		It("does not allow updates on the type of an existing exchange", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.Exchanges[0].Type = "fanout"
+			err := newApplication.ValidateUpdate(&application)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.exchanges[0].type")))
+		})
+
+		It("does not allow updates on durable of an existing queue", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.Queues[0].Durable = false
+			err := newApplication.ValidateUpdate(&application)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.queues[0].durable")))
+		})
+
+		It("does not allow updates on the arguments of an existing exchange", func() {
+			newApplication := application.DeepCopy()
+			newApplication.Spec.Exchanges[0].Arguments = &runtime.RawExtension{Raw: []byte(`{"alternate-exchange":"unrouted"}`)}
+			err := newApplication.ValidateUpdate(&application)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.exchanges[0].arguments")))
+		})
+
+		It("does not allow updates on the arguments of an existing queue", func() {
+			oldApplication := application.DeepCopy()
+			oldApplication.Spec.Queues[0].Arguments = &runtime.RawExtension{Raw: []byte(`{"x-delivery-limit":10}`)}
+			newApplication := oldApplication.DeepCopy()
+			newApplication.Spec.Queues[0].Arguments = &runtime.RawExtension{Raw: []byte(`{"x-delivery-limit":20}`)}
+			err := newApplication.ValidateUpdate(oldApplication)
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.queues[0].arguments")))
+		})
+
+		It("allows updates on the permissions of the user", func() {
+			oldApplication := application.DeepCopy()
+			oldApplication.Spec.User = &ApplicationUser{Permissions: topologyv1beta1.VhostPermissions{Read: ".*"}}
+			newApplication := oldApplication.DeepCopy()
+			newApplication.Spec.User.Permissions.Write = ".*"
+			Expect(newApplication.ValidateUpdate(oldApplication)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,117 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RetryTopologySpec defines the desired state of RetryTopology
+type RetryTopologySpec struct {
+	// Name of the work queue and of the exchange it is bound to; required property.
+	// Retry queues are named '<name>.retry.<delay in milliseconds>' and are bound to the exchange '<name>.retry'.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Default to vhost '/'; cannot be updated
+	// +kubebuilder:default:=/
+	Vhost string `json:"vhost,omitempty"`
+	// Type of the work queue and of the retry queues; defaults to 'quorum'. Cannot be updated.
+	// +kubebuilder:validation:Enum=quorum;classic
+	// +kubebuilder:default:=quorum
+	Type string `json:"type,omitempty"`
+	// Backoff delays, e.g. '5s', '1m' or '1h'. Each delay generates a retry queue whose messages expire after the delay
+	// and are dead lettered back to the work queue. Delays must be unique when rounded down to milliseconds.
+	// Removed delays are unbound from the retry exchange and deleted once their messages have expired.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems:=1
+	Delays []metav1.Duration `json:"delays"`
+	// Reference to the RabbitmqCluster that the RetryTopology will be created in.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference topologyv1beta1.RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+}
+
+// RetryTopologyStatus defines the observed state of RetryTopology
+type RetryTopologyStatus struct {
+	// observedGeneration is the most recent successful generation observed for this RetryTopology. It corresponds to the
+	// RetryTopology's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64                       `json:"observedGeneration,omitempty"`
+	Conditions         []topologyv1beta1.Condition `json:"conditions,omitempty"`
+	// Tiers lists the retry queues, including queues of removed delays which are draining.
+	Tiers []RetryTier `json:"tiers,omitempty"`
+}
+
+// RetryTier is a retry queue of a RetryTopology
+type RetryTier struct {
+	// Delay after which messages are dead lettered back to the work queue.
+	Delay metav1.Duration `json:"delay"`
+	// Name of the retry queue.
+	Queue string `json:"queue"`
+	// Routing key to publish messages to the retry exchange with, to retry them after this delay.
+	RoutingKey string `json:"routingKey"`
+	// DrainingSince is set when the delay has been removed from spec.delays and the retry queue has been unbound.
+	// The retry queue is deleted once the delay has elapsed since then.
+	DrainingSince *metav1.Time `json:"drainingSince,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// RetryTopology is the Schema for the retrytopologies API
+// It generates a work queue and a retry queue per backoff delay, which dead letters messages back to the work queue.
+type RetryTopology struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RetryTopologySpec   `json:"spec,omitempty"`
+	Status RetryTopologyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RetryTopologyList contains a list of RetryTopologies
+type RetryTopologyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RetryTopology `json:"items"`
+}
+
+func (r *RetryTopology) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    r.GroupVersionKind().Group,
+		Resource: r.GroupVersionKind().Kind,
+	}
+}
+
+// RetryExchangeName returns the name of the exchange retry queues are bound to
+func (s *RetryTopologySpec) RetryExchangeName() string {
+	return s.Name + ".retry"
+}
+
+// RetryRoutingKey returns the routing key of the retry queue with delay 'delayMilliseconds'
+// retry queues are identified by their delay in milliseconds, so that '60s' and '1m' are the same retry queue
+func RetryRoutingKey(delayMilliseconds int64) string {
+	return strconv.FormatInt(delayMilliseconds, 10)
+}
+
+// RetryQueueName returns the name of the retry queue with delay 'delayMilliseconds'
+func (s *RetryTopologySpec) RetryQueueName(delayMilliseconds int64) string {
+	return s.Name + ".retry." + RetryRoutingKey(delayMilliseconds)
+}
+
+func init() {
+	SchemeBuilder.Register(&RetryTopology{}, &RetryTopologyList{})
+}
@@ -0,0 +1,96 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (r *RetryTopology) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1alpha1-retrytopology,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=retrytopologies,versions=v1alpha1,name=vretrytopology.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RetryTopology{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// delays must be at least one millisecond and unique
+func (r *RetryTopology) ValidateCreate() error {
+	if err := r.Spec.RabbitmqClusterReference.ValidateOnCreate(r.GroupResource(), r.Name); err != nil {
+		return err
+	}
+	return r.validateDelays()
+}
+
+// ValidateUpdate returns error type 'forbidden' for updates on name, vhost, type and rabbitmqClusterReference
+// delays can be added and removed
+func (r *RetryTopology) ValidateUpdate(old runtime.Object) error {
+	oldTopology, ok := old.(*RetryTopology)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a retrytopology but got a %T", old))
+	}
+
+	detailMsg := "updates on name, vhost, type and rabbitmqClusterReference are all forbidden"
+	if r.Spec.Name != oldTopology.Spec.Name {
+		return apierrors.NewForbidden(r.GroupResource(), r.Name,
+			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
+	}
+	if r.Spec.Vhost != oldTopology.Spec.Vhost {
+		return apierrors.NewForbidden(r.GroupResource(), r.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+	if r.Spec.Type != oldTopology.Spec.Type {
+		return apierrors.NewForbidden(r.GroupResource(), r.Name,
+			field.Forbidden(field.NewPath("spec", "type"), detailMsg))
+	}
+	if !oldTopology.Spec.RabbitmqClusterReference.Matches(&r.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(r.GroupResource(), r.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+
+	return r.validateDelays()
+}
+
+// ValidateDelete no validation on delete
+func (r *RetryTopology) ValidateDelete() error {
+	return nil
+}
+
+func (r *RetryTopology) validateDelays() error {
+	var errorList field.ErrorList
+	seen := make(map[int64]bool)
+	for i, delay := range r.Spec.Delays {
+		path := field.NewPath("spec", "delays").Index(i)
+		if delay.Duration < time.Millisecond {
+			errorList = append(errorList, field.Invalid(path, delay.Duration.String(), "must be at least 1ms"))
+			continue
+		}
+		milliseconds := delay.Milliseconds()
+		if seen[milliseconds] {
+			errorList = append(errorList, field.Duplicate(path, delay.Duration.String()))
+		}
+		seen[milliseconds] = true
+	}
+	if len(errorList) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("RetryTopology").GroupKind(), r.Name, errorList)
+}
@@ -0,0 +1,107 @@
+package v1alpha1
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("retrytopology webhook", func() {
+	var retryTopology = RetryTopology{}
+	BeforeEach(func() {
+		retryTopology = RetryTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: RetryTopologySpec{
+				Name:   "orders",
+				Vhost:  "/",
+				Type:   "quorum",
+				Delays: []metav1.Duration{{Duration: 5 * time.Second}, {Duration: time.Minute}},
+				RabbitmqClusterReference: topologyv1beta1.RabbitmqClusterReference{
+					Name: "a-cluster",
+				},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := retryTopology.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := retryTopology.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow delays shorter than a millisecond", func() {
+			notAllowed := retryTopology.DeepCopy()
+			notAllowed.Spec.Delays = append(notAllowed.Spec.Delays, metav1.Duration{Duration: time.Microsecond})
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.delays[2]: Invalid value")))
+		})
+
+		It("does not allow duplicate delays", func() {
+			notAllowed := retryTopology.DeepCopy()
+			notAllowed.Spec.Delays = append(notAllowed.Spec.Delays, metav1.Duration{Duration: 60 * time.Second})
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.delays[2]: Duplicate value")))
+		})
+
+		It("allows a valid retry topology", func() {
+			Expect(retryTopology.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on name", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.Name = "new-name"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&retryTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on vhost", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&retryTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on type", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.Type = "classic"
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&retryTopology))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.RabbitmqClusterReference = topologyv1beta1.RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newTopology.ValidateUpdate(&retryTopology))).To(BeTrue())
+		})
+
+		It("allows adding and removing delays", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.Delays = []metav1.Duration{{Duration: time.Minute}, {Duration: time.Hour}}
+			Expect(newTopology.ValidateUpdate(&retryTopology)).To(Succeed())
+		})
+
+		It("does not allow duplicate delays", func() {
+			newTopology := retryTopology.DeepCopy()
+			newTopology.Spec.Delays = []metav1.Duration{{Duration: time.Minute}, {Duration: time.Minute}}
+			Expect(apierrors.IsInvalid(newTopology.ValidateUpdate(&retryTopology))).To(BeTrue())
+		})
+	})
+})
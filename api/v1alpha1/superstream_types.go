@@ -10,6 +10,8 @@ This product may include a number of subcomponents with separate copyright notic
 package v1alpha1
 
 import (
+	"time"
+
 	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -31,6 +33,18 @@ type SuperStreamSpec struct {
 	// If unset, the routing keys for the partitions will be set to the index of the partitions
 	// +kubebuilder:validation:Optional
 	RoutingKeys []string `json:"routingKeys,omitempty"`
+	// Additional binding keys of partitions, by routing key of the partition.
+	// Each binding key creates an extra binding from the SuperStream exchange to the partition, e.g. to route several regions to a partition.
+	// Binding keys must be unique across the SuperStream, and different from the routing keys of the partitions.
+	// +kubebuilder:validation:Optional
+	BindingKeys map[string][]string `json:"bindingKeys,omitempty"`
+	// Stream settings of every partition; cannot be updated.
+	// +kubebuilder:validation:Optional
+	Stream *topologyv1beta1.StreamSettings `json:"stream,omitempty"`
+	// Allows decreasing the number of partitions; when unset, the number of partitions can only be increased.
+	// Removed partitions are unbound from the exchange first, then deleted with their messages.
+	// +kubebuilder:validation:Optional
+	ScaleDown *SuperStreamScaleDown `json:"scaleDown,omitempty"`
 	// Reference to the RabbitmqCluster that the SuperStream will be created in.
 	// Required property.
 	// +kubebuilder:validation:Required
@@ -45,6 +59,53 @@ type SuperStreamStatus struct {
 	Conditions         []topologyv1beta1.Condition `json:"conditions,omitempty"`
 	// Partitions are a list of the stream queue names which form the partitions of this SuperStream.
 	Partitions []string `json:"partitions,omitempty"`
+	// RemovedPartitions are the partitions being removed after a scale down, and the step of their removal.
+	RemovedPartitions []SuperStreamPartitionRemoval `json:"removedPartitions,omitempty"`
+}
+
+// SuperStreamScaleDown configures how partitions are removed when the number of partitions is decreased
+type SuperStreamScaleDown struct {
+	// When true, a removed partition is only deleted once its stream has no consumers and no messages, or once drainTimeout
+	// has passed since it was unbound. Streams keep messages after they are consumed, until they are removed by the
+	// retention of the stream, e.g. x-max-age; without a retention, a removed partition is deleted once drainTimeout has passed.
+	// When false, a removed partition is deleted as soon as it is unbound.
+	// +kubebuilder:validation:Optional
+	WaitForDrain bool `json:"waitForDrain,omitempty"`
+	// Maximum time to wait for a removed partition to be drained; defaults to 1h.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:="1h"
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+}
+
+// DrainTimeoutDuration returns drainTimeout, or its default of 1h when unset
+func (s *SuperStreamScaleDown) DrainTimeoutDuration() time.Duration {
+	if s.DrainTimeout == nil {
+		return time.Hour
+	}
+	return s.DrainTimeout.Duration
+}
+
+const (
+	// The binding of the partition to the exchange is being deleted
+	PartitionRemovalUnbinding = "Unbinding"
+	// The partition is unbound, and waiting for its stream to have no consumers and no messages
+	PartitionRemovalDraining = "Draining"
+	// The partition is being deleted
+	PartitionRemovalDeleting = "Deleting"
+)
+
+// SuperStreamPartitionRemoval is the removal of a partition of a SuperStream
+type SuperStreamPartitionRemoval struct {
+	// Name of the stream queue of the partition.
+	Partition string `json:"partition"`
+	// Routing key of the partition.
+	RoutingKey string `json:"routingKey"`
+	// Step of the removal: 'Unbinding', 'Draining' or 'Deleting'.
+	Step string `json:"step"`
+	// Time at which the partition was unbound from the exchange.
+	UnboundSince *metav1.Time `json:"unboundSince,omitempty"`
+	// Details about the step, such as the number of consumers of a draining partition.
+	Message string `json:"message,omitempty"`
 }
 
 // +genclient
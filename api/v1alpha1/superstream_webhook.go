@@ -11,6 +11,10 @@ package v1alpha1
 
 import (
 	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -30,18 +34,23 @@ var _ webhook.Validator = &SuperStream{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// binding keys must be unique, and can only be set for routing keys of partitions
 func (s *SuperStream) ValidateCreate() error {
-	return s.Spec.RabbitmqClusterReference.ValidateOnCreate(s.GroupResource(), s.Name)
+	if err := s.Spec.RabbitmqClusterReference.ValidateOnCreate(s.GroupResource(), s.Name); err != nil {
+		return err
+	}
+	return s.validateBindingKeys()
 }
 
-// returns error type 'forbidden' for updates on superstream name, vhost and rabbitmqClusterReference
+// returns error type 'forbidden' for updates on superstream name, vhost, stream settings and rabbitmqClusterReference
+// partitions may only be removed when spec.scaleDown is set
 func (s *SuperStream) ValidateUpdate(old runtime.Object) error {
 	oldSuperStream, ok := old.(*SuperStream)
 	if !ok {
 		return apierrors.NewBadRequest(fmt.Sprintf("expected a superstream but got a %T", old))
 	}
 
-	detailMsg := "updates on name, vhost and rabbitmqClusterReference are all forbidden"
+	detailMsg := "updates on name, vhost, stream and rabbitmqClusterReference are all forbidden"
 	if s.Spec.Name != oldSuperStream.Spec.Name {
 		return apierrors.NewForbidden(s.GroupResource(), s.Name,
 			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
@@ -51,11 +60,30 @@ func (s *SuperStream) ValidateUpdate(old runtime.Object) error {
 			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
 	}
 
+	if !reflect.DeepEqual(s.Spec.Stream, oldSuperStream.Spec.Stream) {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "stream"), detailMsg))
+	}
+
 	if !oldSuperStream.Spec.RabbitmqClusterReference.Matches(&s.Spec.RabbitmqClusterReference) {
 		return apierrors.NewForbidden(s.GroupResource(), s.Name,
 			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
 	}
 
+	if err := s.validateBindingKeys(); err != nil {
+		return err
+	}
+
+	if s.Spec.ScaleDown != nil {
+		// partitions can be removed from the end of the list of routing keys
+		if !routingKeyUpdatePermitted(oldSuperStream.Spec.RoutingKeys, s.Spec.RoutingKeys) &&
+			!routingKeyUpdatePermitted(s.Spec.RoutingKeys, oldSuperStream.Spec.RoutingKeys) {
+			return apierrors.NewForbidden(s.GroupResource(), s.Name,
+				field.Forbidden(field.NewPath("spec", "routingKeys"), "updates may only add to or remove from the end of the existing list of routing keys"))
+		}
+		return nil
+	}
+
 	if !routingKeyUpdatePermitted(oldSuperStream.Spec.RoutingKeys, s.Spec.RoutingKeys) {
 		return apierrors.NewForbidden(s.GroupResource(), s.Name,
 			field.Forbidden(field.NewPath("spec", "routingKeys"), "updates may only add to the existing list of routing keys"))
@@ -74,11 +102,56 @@ func (s *SuperStream) ValidateDelete() error {
 	return nil
 }
 
+func (s *SuperStream) validateBindingKeys() error {
+	routingKeys := make(map[string]bool)
+	if len(s.Spec.RoutingKeys) == 0 {
+		for i := 0; i < s.Spec.Partitions; i++ {
+			routingKeys[strconv.Itoa(i)] = true
+		}
+	}
+	for _, routingKey := range s.Spec.RoutingKeys {
+		routingKeys[routingKey] = true
+	}
+
+	var errorList field.ErrorList
+	seen := make(map[string]bool)
+	partitions := make([]string, 0, len(s.Spec.BindingKeys))
+	for routingKey := range s.Spec.BindingKeys {
+		partitions = append(partitions, routingKey)
+	}
+	sort.Strings(partitions)
+	for _, routingKey := range partitions {
+		path := field.NewPath("spec", "bindingKeys").Key(routingKey)
+		if !routingKeys[routingKey] {
+			errorList = append(errorList, field.NotFound(path, routingKey))
+			continue
+		}
+		for i, bindingKey := range s.Spec.BindingKeys[routingKey] {
+			switch {
+			case bindingKey == "":
+				errorList = append(errorList, field.Required(path.Index(i), "binding keys cannot be empty"))
+			case routingKeys[bindingKey]:
+				errorList = append(errorList, field.Invalid(path.Index(i), bindingKey, "binding keys cannot be routing keys of partitions"))
+			case seen[bindingKey]:
+				errorList = append(errorList, field.Duplicate(path.Index(i), bindingKey))
+			}
+			seen[bindingKey] = true
+		}
+	}
+	if len(errorList) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("SuperStream").GroupKind(), s.Name, errorList)
+}
+
 // routingKeyUpdatePermitted allows updates only if adding additional keys at the end of the list of keys
 func routingKeyUpdatePermitted(old, new []string) bool {
 	if len(old) == 0 && len(new) != 0 {
 		return false
 	}
+	if len(new) < len(old) {
+		return false
+	}
 	for i := 0; i < len(old); i++ {
 		if old[i] != new[i] {
 			return false
@@ -40,6 +40,37 @@ var _ = Describe("superstream webhook", func() {
 			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
 			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
 		})
+
+		It("allows binding keys for routing keys of partitions", func() {
+			allowed := superstream.DeepCopy()
+			allowed.Spec.BindingKeys = map[string][]string{"a1": {"eu-west", "eu-north"}, "b2": {"us-east"}}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("allows binding keys for generated routing keys", func() {
+			allowed := superstream.DeepCopy()
+			allowed.Spec.RoutingKeys = nil
+			allowed.Spec.BindingKeys = map[string][]string{"3": {"eu-west"}}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow binding keys for unknown partitions", func() {
+			notAllowed := superstream.DeepCopy()
+			notAllowed.Spec.BindingKeys = map[string][]string{"z99": {"eu-west"}}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow duplicate binding keys", func() {
+			notAllowed := superstream.DeepCopy()
+			notAllowed.Spec.BindingKeys = map[string][]string{"a1": {"eu-west"}, "b2": {"eu-west"}}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow binding keys which are routing keys of partitions", func() {
+			notAllowed := superstream.DeepCopy()
+			notAllowed.Spec.BindingKeys = map[string][]string{"a1": {"b2"}}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -99,5 +130,63 @@ var _ = Describe("superstream webhook", func() {
 			newSuperStream.Spec.Partitions = 1
 			Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
 		})
+
+		It("does not allow updates on stream settings", func() {
+			superstream.Spec.Stream = &topologyv1beta1.StreamSettings{MaxAge: "7D"}
+			newSuperStream := superstream.DeepCopy()
+			newSuperStream.Spec.Stream.MaxAge = "1D"
+			Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
+			newSuperStream.Spec.Stream = nil
+			Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
+		})
+
+		It("allows binding keys to be added and removed", func() {
+			superstream.Spec.BindingKeys = map[string][]string{"a1": {"eu-west"}}
+			newSuperStream := superstream.DeepCopy()
+			newSuperStream.Spec.BindingKeys = map[string][]string{"b2": {"eu-west", "eu-north"}}
+			Expect(newSuperStream.ValidateUpdate(&superstream)).To(Succeed())
+		})
+
+		It("does not allow routing keys to be removed", func() {
+			newSuperStream := superstream.DeepCopy()
+			newSuperStream.Spec.RoutingKeys = []string{"a1", "b2"}
+			Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
+		})
+
+		When("spec.scaleDown is set", func() {
+			BeforeEach(func() {
+				superstream.Spec.ScaleDown = &SuperStreamScaleDown{WaitForDrain: true}
+			})
+
+			It("allows superstream.spec.partitions to be decreased", func() {
+				newSuperStream := superstream.DeepCopy()
+				newSuperStream.Spec.Partitions = 1
+				Expect(newSuperStream.ValidateUpdate(&superstream)).To(Succeed())
+			})
+
+			It("allows routing keys to be removed from the end of the list", func() {
+				newSuperStream := superstream.DeepCopy()
+				newSuperStream.Spec.RoutingKeys = []string{"a1", "b2"}
+				Expect(newSuperStream.ValidateUpdate(&superstream)).To(Succeed())
+			})
+
+			It("allows routing keys to be appended", func() {
+				newSuperStream := superstream.DeepCopy()
+				newSuperStream.Spec.RoutingKeys = []string{"a1", "b2", "f17", "z66"}
+				Expect(newSuperStream.ValidateUpdate(&superstream)).To(Succeed())
+			})
+
+			It("does not allow routing keys to be removed from the middle of the list", func() {
+				newSuperStream := superstream.DeepCopy()
+				newSuperStream.Spec.RoutingKeys = []string{"a1", "f17"}
+				Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
+			})
+
+			It("does not allow updates on superstream name", func() {
+				newSuperStream := superstream.DeepCopy()
+				newSuperStream.Spec.Name = "new-name"
+				Expect(apierrors.IsForbidden(newSuperStream.ValidateUpdate(&superstream))).To(BeTrue())
+			})
+		})
 	})
 })
@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	topologyv1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SuperStreamConsumerSpec defines the desired state of SuperStreamConsumer
+type SuperStreamConsumerSpec struct {
+	// Reference to the SuperStream to consume from, in the same namespace as the SuperStreamConsumer.
+	// Required property; cannot be updated.
+	// +kubebuilder:validation:Required
+	SuperStreamReference corev1.LocalObjectReference `json:"superStreamReference"`
+	// Number of partitions consumed by each consumer pod; partitions are assigned to pods in order.
+	// Defaults to '1', one pod per partition.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:default:=1
+	PartitionsPerPod int `json:"partitionsPerPod,omitempty"`
+	// Template of the consumer pods; required property.
+	// The operator sets the environment variables RABBITMQ_SUPER_STREAM and RABBITMQ_SUPER_STREAM_PARTITIONS,
+	// a comma-separated list of the partitions of the pod, in all containers.
+	// Pods are replaced when the template is updated, when partitions are added to the SuperStream, and when they terminate, e.g. after an eviction.
+	// +kubebuilder:validation:Required
+	PodTemplate corev1.PodTemplateSpec `json:"podTemplate"`
+}
+
+// SuperStreamConsumerStatus defines the observed state of SuperStreamConsumer
+type SuperStreamConsumerStatus struct {
+	// observedGeneration is the most recent successful generation observed for this SuperStreamConsumer. It corresponds to the
+	// SuperStreamConsumer's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions contain a Ready condition, which is true when all consumer pods are up to date and ready.
+	Conditions []topologyv1beta1.Condition `json:"conditions,omitempty"`
+	// Pods lists the consumer pods and the partitions they consume from.
+	Pods []SuperStreamConsumerPod `json:"pods,omitempty"`
+}
+
+// SuperStreamConsumerPod is a consumer pod of a SuperStreamConsumer
+type SuperStreamConsumerPod struct {
+	// Name of the pod.
+	Name string `json:"name"`
+	// Partitions consumed by the pod.
+	Partitions []string `json:"partitions,omitempty"`
+	// Status of the Ready condition of the pod; Unknown when the pod does not exist or is outdated.
+	Ready corev1.ConditionStatus `json:"ready"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// SuperStreamConsumer is the Schema for the superstreamconsumers API
+// It runs consumer pods for the partitions of a SuperStream.
+type SuperStreamConsumer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SuperStreamConsumerSpec   `json:"spec,omitempty"`
+	Status SuperStreamConsumerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SuperStreamConsumerList contains a list of SuperStreamConsumers
+type SuperStreamConsumerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SuperStreamConsumer `json:"items"`
+}
+
+func (s *SuperStreamConsumer) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    s.GroupVersionKind().Group,
+		Resource: s.GroupVersionKind().Kind,
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&SuperStreamConsumer{}, &SuperStreamConsumerList{})
+}
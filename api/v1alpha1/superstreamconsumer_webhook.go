@@ -0,0 +1,71 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (s *SuperStreamConsumer) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(s).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1alpha1-superstreamconsumer,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=superstreamconsumers,versions=v1alpha1,name=vsuperstreamconsumer.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &SuperStreamConsumer{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// superStreamReference.name must be provided, and the pod template must have at least one container
+func (s *SuperStreamConsumer) ValidateCreate() error {
+	return s.validateSpec()
+}
+
+// ValidateUpdate returns error type 'forbidden' for updates on superStreamReference
+// podTemplate and partitionsPerPod can be updated, which replaces the consumer pods
+func (s *SuperStreamConsumer) ValidateUpdate(old runtime.Object) error {
+	oldConsumer, ok := old.(*SuperStreamConsumer)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a superstreamconsumer but got a %T", old))
+	}
+
+	if s.Spec.SuperStreamReference.Name != oldConsumer.Spec.SuperStreamReference.Name {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "superStreamReference"), "updates on superStreamReference are forbidden"))
+	}
+
+	return s.validateSpec()
+}
+
+// ValidateDelete no validation on delete
+func (s *SuperStreamConsumer) ValidateDelete() error {
+	return nil
+}
+
+func (s *SuperStreamConsumer) validateSpec() error {
+	var errorList field.ErrorList
+	if s.Spec.SuperStreamReference.Name == "" {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "superStreamReference", "name"), "must provide the name of a SuperStream"))
+	}
+	if len(s.Spec.PodTemplate.Spec.Containers) == 0 {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "podTemplate", "spec", "containers"), "must provide at least one container"))
+	}
+	if len(errorList) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("SuperStreamConsumer").GroupKind(), s.Name, errorList)
+}
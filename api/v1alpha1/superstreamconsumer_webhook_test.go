@@ -0,0 +1,66 @@
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("superstreamconsumer webhook", func() {
+	var consumer = SuperStreamConsumer{}
+	BeforeEach(func() {
+		consumer = SuperStreamConsumer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: SuperStreamConsumerSpec{
+				SuperStreamReference: corev1.LocalObjectReference{Name: "orders"},
+				PartitionsPerPod:     1,
+				PodTemplate: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "consumer", Image: "orders-consumer:1.0"}},
+					},
+				},
+			},
+		}
+	})
+
+	Context("ValidateCreate", func() {
+		It("requires the name of a super stream", func() {
+			notAllowed := consumer.DeepCopy()
+			notAllowed.Spec.SuperStreamReference.Name = ""
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.superStreamReference.name: Required value")))
+		})
+
+		It("requires at least one container in the pod template", func() {
+			notAllowed := consumer.DeepCopy()
+			notAllowed.Spec.PodTemplate.Spec.Containers = nil
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.podTemplate.spec.containers: Required value")))
+		})
+
+		It("allows a valid super stream consumer", func() {
+			Expect(consumer.ValidateCreate()).To(Succeed())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on superStreamReference", func() {
+			newConsumer := consumer.DeepCopy()
+			newConsumer.Spec.SuperStreamReference.Name = "payments"
+			Expect(apierrors.IsForbidden(newConsumer.ValidateUpdate(&consumer))).To(BeTrue())
+		})
+
+		It("allows updates on the pod template and partitionsPerPod", func() {
+			newConsumer := consumer.DeepCopy()
+			newConsumer.Spec.PodTemplate.Spec.Containers[0].Image = "orders-consumer:2.0"
+			newConsumer.Spec.PartitionsPerPod = 2
+			Expect(newConsumer.ValidateUpdate(&consumer)).To(Succeed())
+		})
+	})
+})
@@ -16,9 +16,486 @@ package v1alpha1
 
 import (
 	"github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationBinding) DeepCopyInto(out *ApplicationBinding) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationBinding.
+func (in *ApplicationBinding) DeepCopy() *ApplicationBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationExchange) DeepCopyInto(out *ApplicationExchange) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationExchange.
+func (in *ApplicationExchange) DeepCopy() *ApplicationExchange {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationExchange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationQueue) DeepCopyInto(out *ApplicationQueue) {
+	*out = *in
+	if in.Arguments != nil {
+		in, out := &in.Arguments, &out.Arguments
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationQueue.
+func (in *ApplicationQueue) DeepCopy() *ApplicationQueue {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationQueue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationUser) DeepCopyInto(out *ApplicationUser) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]v1beta1.UserTag, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportCredentialsSecret != nil {
+		in, out := &in.ImportCredentialsSecret, &out.ImportCredentialsSecret
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	out.Permissions = in.Permissions
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationUser.
+func (in *ApplicationUser) DeepCopy() *ApplicationUser {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChildResourceStatus) DeepCopyInto(out *ChildResourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildResourceStatus.
+func (in *ChildResourceStatus) DeepCopy() *ChildResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChildResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterTopology) DeepCopyInto(out *DeadLetterTopology) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterTopology.
+func (in *DeadLetterTopology) DeepCopy() *DeadLetterTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeadLetterTopology) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterTopologyList) DeepCopyInto(out *DeadLetterTopologyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeadLetterTopology, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterTopologyList.
+func (in *DeadLetterTopologyList) DeepCopy() *DeadLetterTopologyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterTopologyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeadLetterTopologyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterTopologySpec) DeepCopyInto(out *DeadLetterTopologySpec) {
+	*out = *in
+	if in.DeliveryLimit != nil {
+		in, out := &in.DeliveryLimit, &out.DeliveryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeadLetterQueueTTL != nil {
+		in, out := &in.DeadLetterQueueTTL, &out.DeadLetterQueueTTL
+		*out = new(int64)
+		**out = **in
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterTopologySpec.
+func (in *DeadLetterTopologySpec) DeepCopy() *DeadLetterTopologySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterTopologySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeadLetterTopologyStatus) DeepCopyInto(out *DeadLetterTopologyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ChildResourceStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeadLetterTopologyStatus.
+func (in *DeadLetterTopologyStatus) DeepCopy() *DeadLetterTopologyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeadLetterTopologyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingApplication) DeepCopyInto(out *MessagingApplication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingApplication.
+func (in *MessagingApplication) DeepCopy() *MessagingApplication {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingApplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessagingApplication) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingApplicationList) DeepCopyInto(out *MessagingApplicationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MessagingApplication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingApplicationList.
+func (in *MessagingApplicationList) DeepCopy() *MessagingApplicationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingApplicationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MessagingApplicationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingApplicationSpec) DeepCopyInto(out *MessagingApplicationSpec) {
+	*out = *in
+	if in.Exchanges != nil {
+		in, out := &in.Exchanges, &out.Exchanges
+		*out = make([]ApplicationExchange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = make([]ApplicationQueue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bindings != nil {
+		in, out := &in.Bindings, &out.Bindings
+		*out = make([]ApplicationBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.User != nil {
+		in, out := &in.User, &out.User
+		*out = new(ApplicationUser)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingApplicationSpec.
+func (in *MessagingApplicationSpec) DeepCopy() *MessagingApplicationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingApplicationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessagingApplicationStatus) DeepCopyInto(out *MessagingApplicationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ChildResourceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserCredentials != nil {
+		in, out := &in.UserCredentials, &out.UserCredentials
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessagingApplicationStatus.
+func (in *MessagingApplicationStatus) DeepCopy() *MessagingApplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MessagingApplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTier) DeepCopyInto(out *RetryTier) {
+	*out = *in
+	out.Delay = in.Delay
+	if in.DrainingSince != nil {
+		in, out := &in.DrainingSince, &out.DrainingSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTier.
+func (in *RetryTier) DeepCopy() *RetryTier {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopology) DeepCopyInto(out *RetryTopology) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopology.
+func (in *RetryTopology) DeepCopy() *RetryTopology {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopology)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetryTopology) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopologyList) DeepCopyInto(out *RetryTopologyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RetryTopology, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopologyList.
+func (in *RetryTopologyList) DeepCopy() *RetryTopologyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopologyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RetryTopologyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopologySpec) DeepCopyInto(out *RetryTopologySpec) {
+	*out = *in
+	if in.Delays != nil {
+		in, out := &in.Delays, &out.Delays
+		*out = make([]metav1.Duration, len(*in))
+		copy(*out, *in)
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopologySpec.
+func (in *RetryTopologySpec) DeepCopy() *RetryTopologySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopologySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryTopologyStatus) DeepCopyInto(out *RetryTopologyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tiers != nil {
+		in, out := &in.Tiers, &out.Tiers
+		*out = make([]RetryTier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryTopologyStatus.
+func (in *RetryTopologyStatus) DeepCopy() *RetryTopologyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryTopologyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuperStream) DeepCopyInto(out *SuperStream) {
 	*out = *in
@@ -46,6 +523,131 @@ func (in *SuperStream) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamConsumer) DeepCopyInto(out *SuperStreamConsumer) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamConsumer.
+func (in *SuperStreamConsumer) DeepCopy() *SuperStreamConsumer {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamConsumer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SuperStreamConsumer) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamConsumerList) DeepCopyInto(out *SuperStreamConsumerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SuperStreamConsumer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamConsumerList.
+func (in *SuperStreamConsumerList) DeepCopy() *SuperStreamConsumerList {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamConsumerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SuperStreamConsumerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamConsumerPod) DeepCopyInto(out *SuperStreamConsumerPod) {
+	*out = *in
+	if in.Partitions != nil {
+		in, out := &in.Partitions, &out.Partitions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamConsumerPod.
+func (in *SuperStreamConsumerPod) DeepCopy() *SuperStreamConsumerPod {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamConsumerPod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamConsumerSpec) DeepCopyInto(out *SuperStreamConsumerSpec) {
+	*out = *in
+	out.SuperStreamReference = in.SuperStreamReference
+	in.PodTemplate.DeepCopyInto(&out.PodTemplate)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamConsumerSpec.
+func (in *SuperStreamConsumerSpec) DeepCopy() *SuperStreamConsumerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamConsumerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamConsumerStatus) DeepCopyInto(out *SuperStreamConsumerStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1beta1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pods != nil {
+		in, out := &in.Pods, &out.Pods
+		*out = make([]SuperStreamConsumerPod, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamConsumerStatus.
+func (in *SuperStreamConsumerStatus) DeepCopy() *SuperStreamConsumerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamConsumerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuperStreamList) DeepCopyInto(out *SuperStreamList) {
 	*out = *in
@@ -78,6 +680,45 @@ func (in *SuperStreamList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamPartitionRemoval) DeepCopyInto(out *SuperStreamPartitionRemoval) {
+	*out = *in
+	if in.UnboundSince != nil {
+		in, out := &in.UnboundSince, &out.UnboundSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamPartitionRemoval.
+func (in *SuperStreamPartitionRemoval) DeepCopy() *SuperStreamPartitionRemoval {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamPartitionRemoval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuperStreamScaleDown) DeepCopyInto(out *SuperStreamScaleDown) {
+	*out = *in
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamScaleDown.
+func (in *SuperStreamScaleDown) DeepCopy() *SuperStreamScaleDown {
+	if in == nil {
+		return nil
+	}
+	out := new(SuperStreamScaleDown)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuperStreamSpec) DeepCopyInto(out *SuperStreamSpec) {
 	*out = *in
@@ -86,6 +727,31 @@ func (in *SuperStreamSpec) DeepCopyInto(out *SuperStreamSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.BindingKeys != nil {
+		in, out := &in.BindingKeys, &out.BindingKeys
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(v1beta1.StreamSettings)
+		**out = **in
+	}
+	if in.ScaleDown != nil {
+		in, out := &in.ScaleDown, &out.ScaleDown
+		*out = new(SuperStreamScaleDown)
+		(*in).DeepCopyInto(*out)
+	}
 	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
 }
 
@@ -114,6 +780,13 @@ func (in *SuperStreamStatus) DeepCopyInto(out *SuperStreamStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemovedPartitions != nil {
+		in, out := &in.RemovedPartitions, &out.RemovedPartitions
+		*out = make([]SuperStreamPartitionRemoval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuperStreamStatus.
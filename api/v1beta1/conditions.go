@@ -5,7 +5,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-const ready ConditionType = "Ready"
+const (
+	ready ConditionType = "Ready"
+	// ConditionRunning is the type of the condition reporting whether an object, such as a shovel or a federation upstream, is running in RabbitMQ
+	ConditionRunning ConditionType = "Running"
+)
 
 type ConditionType string
 
@@ -24,7 +28,7 @@ type Condition struct {
 
 // Ready indicates that the last Create/Update operator on the CR was successful.
 func Ready(lastConditions []Condition) Condition {
-	time := lastTransitionTime(corev1.ConditionTrue, lastConditions)
+	time := lastTransitionTime(ready, corev1.ConditionTrue, lastConditions)
 	return Condition{
 		Type:               ready,
 		Status:             corev1.ConditionTrue,
@@ -35,7 +39,7 @@ func Ready(lastConditions []Condition) Condition {
 
 // NotReady indicates that the last Create/Update operator on the CR failed.
 func NotReady(msg string, lastConditions []Condition) Condition {
-	time := lastTransitionTime(corev1.ConditionFalse, lastConditions)
+	time := lastTransitionTime(ready, corev1.ConditionFalse, lastConditions)
 	return Condition{
 		Type:               ready,
 		Status:             corev1.ConditionFalse,
@@ -45,9 +49,46 @@ func NotReady(msg string, lastConditions []Condition) Condition {
 	}
 }
 
-func lastTransitionTime(newStatus corev1.ConditionStatus, lastConditions []Condition) metav1.Time {
+// Running indicates that the object declared in RabbitMQ, such as a shovel, is running.
+func Running(lastConditions []Condition) Condition {
+	time := lastTransitionTime(ConditionRunning, corev1.ConditionTrue, lastConditions)
+	return Condition{
+		Type:               ConditionRunning,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: time,
+		Reason:             "Running",
+	}
+}
+
+// NotRunning indicates that the object declared in RabbitMQ, such as a shovel, is not running.
+// The reason is a one word, camel-case summary, e.g. 'Starting' or 'Terminated'.
+func NotRunning(reason, msg string, lastConditions []Condition) Condition {
+	time := lastTransitionTime(ConditionRunning, corev1.ConditionFalse, lastConditions)
+	return Condition{
+		Type:               ConditionRunning,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: time,
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+// RunningUnknown indicates that whether the object declared in RabbitMQ is running cannot be determined,
+// e.g. a federation upstream which is not used by any link.
+func RunningUnknown(reason, msg string, lastConditions []Condition) Condition {
+	time := lastTransitionTime(ConditionRunning, corev1.ConditionUnknown, lastConditions)
+	return Condition{
+		Type:               ConditionRunning,
+		Status:             corev1.ConditionUnknown,
+		LastTransitionTime: time,
+		Reason:             reason,
+		Message:            msg,
+	}
+}
+
+func lastTransitionTime(conditionType ConditionType, newStatus corev1.ConditionStatus, lastConditions []Condition) metav1.Time {
 	for _, lastCondition := range lastConditions {
-		if lastCondition.Type == ready && lastCondition.Status == newStatus {
+		if lastCondition.Type == conditionType && lastCondition.Status == newStatus {
 			return lastCondition.LastTransitionTime
 		}
 	}
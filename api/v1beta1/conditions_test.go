@@ -26,6 +26,35 @@ var _ = Describe("Conditions", func() {
 			Expect(c.LastTransitionTime.IsZero()).To(BeFalse())
 		})
 	})
+	Describe("Running", func() {
+		It("returns 'Running' condition set to true", func() {
+			c := Running(nil)
+			Expect(string(c.Type)).To(Equal("Running"))
+			Expect(c.Status).To(Equal(corev1.ConditionTrue))
+			Expect(c.Reason).To(Equal("Running"))
+			Expect(c.LastTransitionTime.IsZero()).To(BeFalse())
+		})
+	})
+	Describe("NotRunning", func() {
+		It("returns 'Running' condition set to false", func() {
+			c := NotRunning("Terminated", "needs a uri", nil)
+			Expect(string(c.Type)).To(Equal("Running"))
+			Expect(c.Status).To(Equal(corev1.ConditionFalse))
+			Expect(c.Reason).To(Equal("Terminated"))
+			Expect(c.Message).To(Equal("needs a uri"))
+			Expect(c.LastTransitionTime.IsZero()).To(BeFalse())
+		})
+	})
+	Describe("RunningUnknown", func() {
+		It("returns 'Running' condition set to unknown", func() {
+			c := RunningUnknown("NoLinks", "not used by any link", nil)
+			Expect(string(c.Type)).To(Equal("Running"))
+			Expect(c.Status).To(Equal(corev1.ConditionUnknown))
+			Expect(c.Reason).To(Equal("NoLinks"))
+			Expect(c.Message).To(Equal("not used by any link"))
+			Expect(c.LastTransitionTime.IsZero()).To(BeFalse())
+		})
+	})
 	Context("LastTransitionTime", func() {
 		It("changes only if status changes", func() {
 			c1 := Ready(nil)
@@ -38,5 +67,13 @@ var _ = Describe("Conditions", func() {
 			c3 := NotReady("some message", []Condition{c2})
 			Expect(c3.LastTransitionTime.Time).To(BeTemporally(">", c2.LastTransitionTime.Time))
 		})
+		It("is tracked separately for each condition type", func() {
+			ready := Ready(nil)
+			notRunning := NotRunning("Starting", "", []Condition{ready})
+			Expect(notRunning.LastTransitionTime.Time).To(BeTemporally(">=", ready.LastTransitionTime.Time))
+			running := Running([]Condition{ready, notRunning})
+			Expect(running.LastTransitionTime.Time).To(BeTemporally(">", notRunning.LastTransitionTime.Time))
+			Expect(Ready([]Condition{ready, running}).LastTransitionTime.Time).To(BeTemporally("==", ready.LastTransitionTime.Time))
+		})
 	})
 })
@@ -0,0 +1,110 @@
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DefinitionsSpec defines the desired state of Definitions
+type DefinitionsSpec struct {
+	// ConfigMap holding the definitions document.
+	// Have to set either configMap or secret, but not both.
+	// +kubebuilder:validation:Optional
+	ConfigMap *corev1.LocalObjectReference `json:"configMap,omitempty"`
+	// Secret holding the definitions document.
+	// Have to set either configMap or secret, but not both.
+	// +kubebuilder:validation:Optional
+	Secret *corev1.LocalObjectReference `json:"secret,omitempty"`
+	// Key of the definitions document in the ConfigMap or Secret.
+	// Defaults to 'definitions.json'.
+	// +kubebuilder:default:=definitions.json
+	Key string `json:"key,omitempty"`
+	// Vhost to import objects into when the definitions document does not set a vhost for them,
+	// which is the case for definitions exported from a single vhost.
+	// Defaults to '/'.
+	// +kubebuilder:default:=/
+	Vhost string `json:"vhost,omitempty"`
+	// DeletionPolicy defines what happens to the imported objects when the Definitions object is deleted.
+	// 'retain' leaves them in RabbitMQ; 'delete' removes the objects which were created by this import.
+	// Objects which already existed before the import are never removed.
+	// Defaults to 'retain'.
+	// +kubebuilder:validation:Enum=retain;delete
+	// +kubebuilder:default:=retain
+	DeletionPolicy string `json:"deletionPolicy,omitempty"`
+	// Reference to the RabbitmqCluster that the definitions will be imported into.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+}
+
+// DefinitionsObject identifies a RabbitMQ object declared in a definitions document.
+type DefinitionsObject struct {
+	// Type of the object; one of 'vhost', 'exchange', 'queue', 'binding' or 'policy'.
+	Type string `json:"type"`
+	// Vhost of the object; empty for vhosts.
+	Vhost string `json:"vhost,omitempty"`
+	// Name of the object; empty for bindings.
+	Name string `json:"name,omitempty"`
+	// Source exchange of a binding.
+	Source string `json:"source,omitempty"`
+	// Destination of a binding.
+	Destination string `json:"destination,omitempty"`
+	// Destination type of a binding; either 'queue' or 'exchange'.
+	DestinationType string `json:"destinationType,omitempty"`
+	// Routing key of a binding.
+	RoutingKey string `json:"routingKey,omitempty"`
+	// Properties key RabbitMQ uses to identify a binding.
+	PropertiesKey string `json:"propertiesKey,omitempty"`
+	// Reason the import of the object failed.
+	Message string `json:"message,omitempty"`
+}
+
+// DefinitionsStatus defines the observed state of Definitions
+type DefinitionsStatus struct {
+	// observedGeneration is the most recent successful generation observed for this Definitions. It corresponds to the
+	// Definitions's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+	// Objects created by importing the definitions.
+	Created []DefinitionsObject `json:"created,omitempty"`
+	// Objects which already existed in RabbitMQ and were left untouched.
+	AlreadyExisted []DefinitionsObject `json:"alreadyExisted,omitempty"`
+	// Objects which failed to be imported.
+	Failed []DefinitionsObject `json:"failed,omitempty"`
+}
+
+// +genclient
+// +resourceName=definitions
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// Definitions is the Schema for the definitions API
+type Definitions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DefinitionsSpec   `json:"spec,omitempty"`
+	Status DefinitionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DefinitionsList contains a list of Definitions
+type DefinitionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Definitions `json:"items"`
+}
+
+func (d *Definitions) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    d.GroupVersionKind().Group,
+		Resource: d.GroupVersionKind().Kind,
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&Definitions{}, &DefinitionsList{})
+}
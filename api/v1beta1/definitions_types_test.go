@@ -0,0 +1,99 @@
+package v1beta1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("Definitions", func() {
+	var (
+		namespace = "default"
+		ctx       = context.Background()
+	)
+
+	It("creates definitions with default settings", func() {
+		definitions := Definitions{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-definitions",
+				Namespace: namespace,
+			},
+			Spec: DefinitionsSpec{
+				ConfigMap: &corev1.LocalObjectReference{
+					Name: "a-configmap",
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &definitions)).To(Succeed())
+		fetched := &Definitions{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      definitions.Name,
+			Namespace: definitions.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.ConfigMap.Name).To(Equal("a-configmap"))
+		Expect(fetched.Spec.Key).To(Equal("definitions.json"))
+		Expect(fetched.Spec.Vhost).To(Equal("/"))
+		Expect(fetched.Spec.DeletionPolicy).To(Equal("retain"))
+		Expect(fetched.Spec.RabbitmqClusterReference).To(Equal(RabbitmqClusterReference{
+			Name: "some-cluster",
+		}))
+	})
+
+	It("creates definitions with configurations", func() {
+		definitions := Definitions{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "random-definitions",
+				Namespace: namespace,
+			},
+			Spec: DefinitionsSpec{
+				Secret: &corev1.LocalObjectReference{
+					Name: "a-secret",
+				},
+				Key:            "export.json",
+				Vhost:          "a-vhost",
+				DeletionPolicy: "delete",
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &definitions)).To(Succeed())
+		fetched := &Definitions{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      definitions.Name,
+			Namespace: definitions.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.Secret.Name).To(Equal("a-secret"))
+		Expect(fetched.Spec.Key).To(Equal("export.json"))
+		Expect(fetched.Spec.Vhost).To(Equal("a-vhost"))
+		Expect(fetched.Spec.DeletionPolicy).To(Equal("delete"))
+	})
+
+	When("creating definitions with an invalid 'DeletionPolicy' value", func() {
+		It("fails with validation errors", func() {
+			definitions := Definitions{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "invalid",
+					Namespace: namespace,
+				},
+				Spec: DefinitionsSpec{
+					ConfigMap: &corev1.LocalObjectReference{
+						Name: "a-configmap",
+					},
+					DeletionPolicy: "purge",
+					RabbitmqClusterReference: RabbitmqClusterReference{
+						Name: "some-cluster",
+					},
+				},
+			}
+			Expect(k8sClient.Create(ctx, &definitions)).To(MatchError(`Definitions.rabbitmq.com "invalid" is invalid: spec.deletionPolicy: Unsupported value: "purge": supported values: "retain", "delete"`))
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (d *Definitions) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(d).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1beta1-definitions,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=definitions,versions=v1beta1,name=vdefinitions.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &Definitions{}
+
+// ValidateCreate checks if only one of spec.configMap and spec.secret is specified
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+func (d *Definitions) ValidateCreate() error {
+	if err := d.validateSource(); err != nil {
+		return err
+	}
+	return d.Spec.RabbitmqClusterReference.ValidateOnCreate(d.GroupResource(), d.Name)
+}
+
+// ValidateUpdate do not allow updates on spec.vhost and spec.rabbitmqClusterReference
+// updates on spec.configMap, spec.secret, spec.key and spec.deletionPolicy are allowed
+func (d *Definitions) ValidateUpdate(old runtime.Object) error {
+	oldDefinitions, ok := old.(*Definitions)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected definitions but got a %T", old))
+	}
+
+	if err := d.validateSource(); err != nil {
+		return err
+	}
+
+	detailMsg := "updates on vhost and rabbitmqClusterReference are all forbidden"
+	if d.Spec.Vhost != oldDefinitions.Spec.Vhost {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+
+	if !oldDefinitions.Spec.RabbitmqClusterReference.Matches(&d.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(d.GroupResource(), d.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+	return nil
+}
+
+// ValidateDelete no validation on delete
+func (d *Definitions) ValidateDelete() error {
+	return nil
+}
+
+// validateSource returns error type 'invalid' if neither or both of spec.configMap and spec.secret are specified
+func (d *Definitions) validateSource() error {
+	var errorList field.ErrorList
+	if d.Spec.ConfigMap == nil && d.Spec.Secret == nil {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "configMap and secret"),
+			"must specify either spec.configMap or spec.secret"))
+		return apierrors.NewInvalid(GroupVersion.WithKind("Definitions").GroupKind(), d.Name, errorList)
+	}
+
+	if d.Spec.ConfigMap != nil && d.Spec.Secret != nil {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "configMap and secret"),
+			"cannot specify spec.configMap and spec.secret at the same time"))
+		return apierrors.NewInvalid(GroupVersion.WithKind("Definitions").GroupKind(), d.Name, errorList)
+	}
+	return nil
+}
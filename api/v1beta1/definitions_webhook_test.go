@@ -0,0 +1,106 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("definitions webhook", func() {
+	var definitions = Definitions{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: DefinitionsSpec{
+			ConfigMap: &corev1.LocalObjectReference{
+				Name: "a-configmap",
+			},
+			Key:            "definitions.json",
+			Vhost:          "/",
+			DeletionPolicy: "retain",
+			RabbitmqClusterReference: RabbitmqClusterReference{
+				Name: "a-cluster",
+			},
+		},
+	}
+
+	Context("ValidateCreate", func() {
+		It("allows definitions from a configMap", func() {
+			Expect(definitions.ValidateCreate()).To(Succeed())
+		})
+
+		It("allows definitions from a secret", func() {
+			fromSecret := definitions.DeepCopy()
+			fromSecret.Spec.ConfigMap = nil
+			fromSecret.Spec.Secret = &corev1.LocalObjectReference{Name: "a-secret"}
+			Expect(fromSecret.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow both spec.configMap and spec.secret be configured", func() {
+			notAllowed := definitions.DeepCopy()
+			notAllowed.Spec.Secret = &corev1.LocalObjectReference{Name: "a-secret"}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.configMap and spec.secret cannot both be empty", func() {
+			notAllowed := definitions.DeepCopy()
+			notAllowed.Spec.ConfigMap = nil
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := definitions.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := definitions.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on vhost", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newDefinitions.ValidateUpdate(&definitions))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.RabbitmqClusterReference = RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newDefinitions.ValidateUpdate(&definitions))).To(BeTrue())
+		})
+
+		It("allows updates on definitions.spec.configMap", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.ConfigMap = &corev1.LocalObjectReference{Name: "new-configmap"}
+			Expect(newDefinitions.ValidateUpdate(&definitions)).To(Succeed())
+		})
+
+		It("allows updates on definitions.spec.key", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.Key = "export.json"
+			Expect(newDefinitions.ValidateUpdate(&definitions)).To(Succeed())
+		})
+
+		It("allows updates on definitions.spec.deletionPolicy", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.DeletionPolicy = "delete"
+			Expect(newDefinitions.ValidateUpdate(&definitions)).To(Succeed())
+		})
+
+		It("does not allow updates which set both spec.configMap and spec.secret", func() {
+			newDefinitions := definitions.DeepCopy()
+			newDefinitions.Spec.Secret = &corev1.LocalObjectReference{Name: "a-secret"}
+			Expect(apierrors.IsInvalid(newDefinitions.ValidateUpdate(&definitions))).To(BeTrue())
+		})
+	})
+})
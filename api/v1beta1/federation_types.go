@@ -22,10 +22,15 @@ type FederationSpec struct {
 	// Secret contains the AMQP URI(s) for the upstream.
 	// The Secret must contain the key `uri` or operator will error.
 	// `uri` should be one or multiple uris separated by ','.
-	// Required property.
-	// +kubebuilder:validation:Required
-	UriSecret     *corev1.LocalObjectReference `json:"uriSecret"`
-	PrefetchCount int                          `json:"prefetch-count,omitempty"`
+	// Have to set either uriSecret or upstreamCluster, but not both.
+	// +kubebuilder:validation:Optional
+	UriSecret *corev1.LocalObjectReference `json:"uriSecret,omitempty"`
+	// Upstream RabbitmqCluster and vhost; the operator provisions an upstream user in the upstream vhost,
+	// and generates the upstream URI with the credentials of that user. Cannot be updated.
+	// Have to set either uriSecret or upstreamCluster, but not both.
+	// +kubebuilder:validation:Optional
+	UpstreamCluster *ClusterEndpoint `json:"upstreamCluster,omitempty"`
+	PrefetchCount   int              `json:"prefetch-count,omitempty"`
 	// +kubebuilder:validation:Enum=on-confirm;on-publish;no-ack
 	AckMode        string `json:"ackMode,omitempty"`
 	Expires        int    `json:"expires,omitempty"`
@@ -43,6 +48,23 @@ type FederationStatus struct {
 	// Federation's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
 	Conditions         []Condition `json:"conditions,omitempty"`
+	// Links using this federation upstream, as reported by RabbitMQ.
+	// Refreshed periodically.
+	Links []FederationLink `json:"links,omitempty"`
+}
+
+// FederationLink is the status of a federation link
+type FederationLink struct {
+	// Type of the link; either 'exchange' or 'queue'.
+	Type string `json:"type"`
+	// Name of the federated exchange or queue.
+	Name string `json:"name"`
+	// Upstream URI the link is connected to; credentials are omitted.
+	URI string `json:"uri,omitempty"`
+	// Status of the link; one of 'starting', 'running', 'shutdown' or 'error'.
+	Status string `json:"status"`
+	// Error reported by RabbitMQ when the link is in status 'error'.
+	Error string `json:"error,omitempty"`
 }
 
 // +genclient
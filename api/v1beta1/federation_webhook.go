@@ -2,6 +2,8 @@ package v1beta1
 
 import (
 	"fmt"
+	"reflect"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -18,8 +20,12 @@ func (f *Federation) SetupWebhookWithManager(mgr ctrl.Manager) error {
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// either uriSecret or upstreamCluster must be provided but not both
 func (f *Federation) ValidateCreate() error {
-	return f.Spec.RabbitmqClusterReference.ValidateOnCreate(f.GroupResource(), f.Name)
+	if err := f.Spec.RabbitmqClusterReference.ValidateOnCreate(f.GroupResource(), f.Name); err != nil {
+		return err
+	}
+	return f.validateUpstream()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -29,7 +35,7 @@ func (f *Federation) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewBadRequest(fmt.Sprintf("expected a federation but got a %T", old))
 	}
 
-	detailMsg := "updates on name, vhost and rabbitmqClusterReference are all forbidden"
+	detailMsg := "updates on name, vhost, upstreamCluster and rabbitmqClusterReference are all forbidden"
 	if f.Spec.Name != oldFederation.Spec.Name {
 		return apierrors.NewForbidden(f.GroupResource(), f.Name,
 			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
@@ -44,6 +50,28 @@ func (f *Federation) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewForbidden(f.GroupResource(), f.Name,
 			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
 	}
+
+	if !reflect.DeepEqual(f.Spec.UpstreamCluster, oldFederation.Spec.UpstreamCluster) {
+		return apierrors.NewForbidden(f.GroupResource(), f.Name,
+			field.Forbidden(field.NewPath("spec", "upstreamCluster"), detailMsg))
+	}
+	return f.validateUpstream()
+}
+
+func (f *Federation) validateUpstream() error {
+	if f.Spec.UriSecret != nil && f.Spec.UpstreamCluster != nil {
+		return apierrors.NewForbidden(f.GroupResource(), f.Name,
+			field.Forbidden(field.NewPath("spec", "upstreamCluster"), "do not provide both spec.uriSecret and spec.upstreamCluster"))
+	}
+	if f.Spec.UriSecret == nil && f.Spec.UpstreamCluster == nil {
+		return apierrors.NewForbidden(f.GroupResource(), f.Name,
+			field.Forbidden(field.NewPath("spec", "uriSecret"), "must provide either spec.uriSecret or spec.upstreamCluster"))
+	}
+	if f.Spec.UpstreamCluster != nil {
+		if err := f.Spec.UpstreamCluster.validate(field.NewPath("spec", "upstreamCluster")); err != nil {
+			return apierrors.NewForbidden(f.GroupResource(), f.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -45,6 +45,34 @@ var _ = Describe("federation webhook", func() {
 			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
 			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
 		})
+
+		It("allows spec.upstreamCluster instead of spec.uriSecret", func() {
+			allowed := federation.DeepCopy()
+			allowed.Spec.UriSecret = nil
+			allowed.Spec.UpstreamCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "upstream"}, Vhost: "/"}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow both spec.uriSecret and spec.upstreamCluster be configured", func() {
+			notAllowed := federation.DeepCopy()
+			notAllowed.Spec.UpstreamCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "upstream"}}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.uriSecret and spec.upstreamCluster cannot both be empty", func() {
+			notAllowed := federation.DeepCopy()
+			notAllowed.Spec.UriSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.upstreamCluster.rabbitmqClusterReference must have either a name or a connectionSecret", func() {
+			notAllowed := federation.DeepCopy()
+			notAllowed.Spec.UriSecret = nil
+			notAllowed.Spec.UpstreamCluster = &ClusterEndpoint{}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+			notAllowed.Spec.UpstreamCluster.RabbitmqClusterReference = RabbitmqClusterReference{Name: "upstream", ConnectionSecret: &corev1.LocalObjectReference{Name: "a-secret"}}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -54,6 +82,15 @@ var _ = Describe("federation webhook", func() {
 			Expect(apierrors.IsForbidden(newFederation.ValidateUpdate(&federation))).To(BeTrue())
 		})
 
+		It("does not allow updates on upstreamCluster", func() {
+			oldFederation := federation.DeepCopy()
+			oldFederation.Spec.UriSecret = nil
+			oldFederation.Spec.UpstreamCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "upstream"}, Vhost: "/"}
+			newFederation := oldFederation.DeepCopy()
+			newFederation.Spec.UpstreamCluster.Vhost = "another-vhost"
+			Expect(apierrors.IsForbidden(newFederation.ValidateUpdate(oldFederation))).To(BeTrue())
+		})
+
 		It("does not allow updates on vhost", func() {
 			newFederation := federation.DeepCopy()
 			newFederation.Spec.Vhost = "new-vhost"
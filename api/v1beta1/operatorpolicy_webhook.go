@@ -0,0 +1,118 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// operatorPolicyDefinitionKeys lists the definition keys that RabbitMQ accepts in an operator policy
+// see https://www.rabbitmq.com/parameters.html#operator-policies
+var operatorPolicyDefinitionKeys = []string{
+	"delivery-limit",
+	"expires",
+	"max-in-memory-bytes",
+	"max-in-memory-length",
+	"max-length",
+	"max-length-bytes",
+	"message-ttl",
+}
+
+func (p *OperatorPolicy) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(p).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1beta1-operatorpolicy,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=operatorpolicies,versions=v1beta1,name=voperatorpolicy.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &OperatorPolicy{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// definition may only contain keys allowed in operator policies
+func (p *OperatorPolicy) ValidateCreate() error {
+	if err := p.validateDefinition(); err != nil {
+		return err
+	}
+	return p.Spec.RabbitmqClusterReference.ValidateOnCreate(p.GroupResource(), p.Name)
+}
+
+// ValidateUpdate returns error type 'forbidden' for updates on operator policy name, vhost and rabbitmqClusterReference
+// definition may only contain keys allowed in operator policies
+func (p *OperatorPolicy) ValidateUpdate(old runtime.Object) error {
+	oldOperatorPolicy, ok := old.(*OperatorPolicy)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an operator policy but got a %T", old))
+	}
+
+	detailMsg := "updates on name, vhost and rabbitmqClusterReference are all forbidden"
+	if p.Spec.Name != oldOperatorPolicy.Spec.Name {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
+	}
+
+	if p.Spec.Vhost != oldOperatorPolicy.Spec.Vhost {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+
+	if !oldOperatorPolicy.Spec.RabbitmqClusterReference.Matches(&p.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+	return p.validateDefinition()
+}
+
+// no validation on delete
+func (p *OperatorPolicy) ValidateDelete() error {
+	return nil
+}
+
+// validateDefinition returns error type 'invalid' when spec.definition is not a json object,
+// or when it contains keys that are not allowed in operator policies
+func (p *OperatorPolicy) validateDefinition() error {
+	if p.Spec.Definition == nil {
+		return nil
+	}
+
+	definitionPath := field.NewPath("spec", "definition")
+	definition := make(map[string]interface{})
+	if err := json.Unmarshal(p.Spec.Definition.Raw, &definition); err != nil {
+		return apierrors.NewInvalid(GroupVersion.WithKind("OperatorPolicy").GroupKind(), p.Name, field.ErrorList{
+			field.Invalid(definitionPath, string(p.Spec.Definition.Raw), "definition must be a valid json object"),
+		})
+	}
+
+	var keys []string
+	for key := range definition {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errorList field.ErrorList
+	for _, key := range keys {
+		if !containsString(operatorPolicyDefinitionKeys, key) {
+			errorList = append(errorList, field.NotSupported(definitionPath.Key(key), key, operatorPolicyDefinitionKeys))
+		}
+	}
+	if len(errorList) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("OperatorPolicy").GroupKind(), p.Name, errorList)
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("operatorPolicy webhook", func() {
+	var operatorPolicy = OperatorPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: OperatorPolicySpec{
+			Name:     "test",
+			Vhost:    "/test",
+			Pattern:  "a-pattern",
+			ApplyTo:  "queues",
+			Priority: 0,
+			Definition: &runtime.RawExtension{
+				Raw: []byte(`{"max-length":10000,"message-ttl":60000}`),
+			},
+			RabbitmqClusterReference: RabbitmqClusterReference{
+				Name: "a-cluster",
+			},
+		},
+	}
+
+	Context("ValidateCreate", func() {
+		It("allows definitions with operator policy keys", func() {
+			Expect(operatorPolicy.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := operatorPolicy.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := operatorPolicy.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow definition keys that operator policies do not support", func() {
+			notAllowed := operatorPolicy.DeepCopy()
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"max-length":10000,"ha-mode":"all"}`)}
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`spec.definition[ha-mode]: Unsupported value: "ha-mode"`)))
+		})
+
+		It("does not allow a definition that is not a json object", func() {
+			notAllowed := operatorPolicy.DeepCopy()
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`["max-length"]`)}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on operator policy name", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Name = "new-name"
+			Expect(apierrors.IsForbidden(newOperatorPolicy.ValidateUpdate(&operatorPolicy))).To(BeTrue())
+		})
+
+		It("does not allow updates on vhost", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newOperatorPolicy.ValidateUpdate(&operatorPolicy))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.RabbitmqClusterReference = RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newOperatorPolicy.ValidateUpdate(&operatorPolicy))).To(BeTrue())
+		})
+
+		It("does not allow updates on rabbitmqClusterReference.connectionSecret", func() {
+			connectionScr := OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test",
+				},
+				Spec: OperatorPolicySpec{
+					Name:     "test",
+					Vhost:    "/test",
+					Pattern:  "a-pattern",
+					ApplyTo:  "queues",
+					Priority: 0,
+					RabbitmqClusterReference: RabbitmqClusterReference{
+						ConnectionSecret: &corev1.LocalObjectReference{
+							Name: "a-secret",
+						},
+					},
+				},
+			}
+			new := connectionScr.DeepCopy()
+			new.Spec.RabbitmqClusterReference.ConnectionSecret.Name = "new-secret"
+			Expect(apierrors.IsForbidden(new.ValidateUpdate(&connectionScr))).To(BeTrue())
+		})
+
+		It("allows updates on operatorPolicy.spec.pattern", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Pattern = "new-pattern"
+			Expect(newOperatorPolicy.ValidateUpdate(&operatorPolicy)).To(Succeed())
+		})
+
+		It("allows updates on operatorPolicy.spec.applyTo", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.ApplyTo = "quorum_queues"
+			Expect(newOperatorPolicy.ValidateUpdate(&operatorPolicy)).To(Succeed())
+		})
+
+		It("allows updates on operatorPolicy.spec.priority", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Priority = 1000
+			Expect(newOperatorPolicy.ValidateUpdate(&operatorPolicy)).To(Succeed())
+		})
+
+		It("allows updates on operatorPolicy.spec.definition", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"expires":1800000}`)}
+			Expect(newOperatorPolicy.ValidateUpdate(&operatorPolicy)).To(Succeed())
+		})
+
+		It("does not allow updates to definition keys that operator policies do not support", func() {
+			newOperatorPolicy := operatorPolicy.DeepCopy()
+			newOperatorPolicy.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"queue-mode":"lazy"}`)}
+			Expect(apierrors.IsInvalid(newOperatorPolicy.ValidateUpdate(&operatorPolicy))).To(BeTrue())
+		})
+	})
+})
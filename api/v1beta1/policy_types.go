@@ -16,6 +16,7 @@ type PolicySpec struct {
 	// +kubebuilder:default:=/
 	Vhost string `json:"vhost,omitempty"`
 	// Regular expression pattern used to match queues and exchanges, e.g. "^amq.".
+	// Must be a valid regular expression.
 	// Required property.
 	// +kubebuilder:validation:Required
 	Pattern string `json:"pattern"`
@@ -29,6 +30,7 @@ type PolicySpec struct {
 	// +kubebuilder:default:=0
 	Priority int `json:"priority,omitempty"`
 	// Policy definition. Required property.
+	// Known policy keys must apply to applyTo, e.g. 'alternate-exchange' is not allowed when applyTo is 'queues'; keys defined by plugins are not validated.
 	// +kubebuilder:validation:Type=object
 	// +kubebuilder:pruning:PreserveUnknownFields
 	// +kubebuilder:validation:Required
@@ -1,7 +1,15 @@
 package v1beta1
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"regexp/syntax"
+	"sort"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -9,6 +17,59 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
+// policyDefinitionValueType is the type RabbitMQ expects for the value of a policy definition key
+type policyDefinitionValueType int
+
+const (
+	anyValue policyDefinitionValueType = iota
+	stringValue
+	nonNegativeIntegerValue
+	positiveIntegerValue
+	enumValue
+)
+
+// policyDefinitionKey describes a policy definition key, and whether it applies to queues, exchanges or both
+type policyDefinitionKey struct {
+	valueType policyDefinitionValueType
+	enum      []string
+	queues    bool
+	exchanges bool
+}
+
+// policyDefinitionKeys lists the definition keys of RabbitMQ and its tier-1 plugins, which are validated
+// other keys, such as keys defined by other plugins, are allowed without validation
+// see https://www.rabbitmq.com/parameters.html#policies
+var policyDefinitionKeys = map[string]policyDefinitionKey{
+	"alternate-exchange":            {valueType: stringValue, exchanges: true},
+	"consumer-timeout":              {valueType: nonNegativeIntegerValue, queues: true},
+	"dead-letter-exchange":          {valueType: stringValue, queues: true},
+	"dead-letter-routing-key":       {valueType: stringValue, queues: true},
+	"dead-letter-strategy":          {valueType: enumValue, enum: []string{"at-most-once", "at-least-once"}, queues: true},
+	"delivery-limit":                {valueType: nonNegativeIntegerValue, queues: true},
+	"expires":                       {valueType: positiveIntegerValue, queues: true},
+	"federation-upstream":           {valueType: stringValue, queues: true, exchanges: true},
+	"federation-upstream-set":       {valueType: stringValue, queues: true, exchanges: true},
+	"ha-mode":                       {valueType: enumValue, enum: []string{"all", "exactly", "nodes"}, queues: true},
+	"ha-params":                     {valueType: anyValue, queues: true},
+	"ha-promote-on-failure":         {valueType: enumValue, enum: []string{"always", "when-synced"}, queues: true},
+	"ha-promote-on-shutdown":        {valueType: enumValue, enum: []string{"always", "when-synced"}, queues: true},
+	"ha-sync-batch-size":            {valueType: positiveIntegerValue, queues: true},
+	"ha-sync-mode":                  {valueType: enumValue, enum: []string{"manual", "automatic"}, queues: true},
+	"max-age":                       {valueType: stringValue, queues: true},
+	"max-in-memory-bytes":           {valueType: nonNegativeIntegerValue, queues: true},
+	"max-in-memory-length":          {valueType: nonNegativeIntegerValue, queues: true},
+	"max-length":                    {valueType: nonNegativeIntegerValue, queues: true},
+	"max-length-bytes":              {valueType: nonNegativeIntegerValue, queues: true},
+	"message-ttl":                   {valueType: nonNegativeIntegerValue, queues: true},
+	"overflow":                      {valueType: enumValue, enum: []string{"drop-head", "reject-publish", "reject-publish-dlx"}, queues: true},
+	"queue-leader-locator":          {valueType: enumValue, enum: []string{"client-local", "balanced"}, queues: true},
+	"queue-master-locator":          {valueType: enumValue, enum: []string{"min-masters", "client-local", "random"}, queues: true},
+	"queue-mode":                    {valueType: enumValue, enum: []string{"default", "lazy"}, queues: true},
+	"queue-version":                 {valueType: enumValue, enum: []string{"1", "2"}, queues: true},
+	"stream-max-segment-size-bytes": {valueType: nonNegativeIntegerValue, queues: true},
+	"target-group-size":             {valueType: positiveIntegerValue, queues: true},
+}
+
 func (p *Policy) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(p).
@@ -21,11 +82,16 @@ var _ webhook.Validator = &Policy{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// pattern must be a valid regular expression and known policy keys in definition must apply to spec.applyTo
 func (p *Policy) ValidateCreate() error {
+	if err := p.validateSpec(); err != nil {
+		return err
+	}
 	return p.Spec.RabbitmqClusterReference.ValidateOnCreate(p.GroupResource(), p.Name)
 }
 
 // ValidateUpdate returns error type 'forbidden' for updates on policy name, vhost and rabbitmqClusterReference
+// when spec is updated, pattern must be a valid regular expression and known policy keys in definition must apply to spec.applyTo
 func (p *Policy) ValidateUpdate(old runtime.Object) error {
 	oldPolicy, ok := old.(*Policy)
 	if !ok {
@@ -47,10 +113,98 @@ func (p *Policy) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewForbidden(p.GroupResource(), p.Name,
 			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
 	}
-	return nil
+
+	// policies created before their spec was validated must remain updatable
+	if reflect.DeepEqual(p.Spec, oldPolicy.Spec) {
+		return nil
+	}
+	return p.validateSpec()
 }
 
 // no validation on delete
 func (p *Policy) ValidateDelete() error {
 	return nil
 }
+
+// validateSpec returns error type 'invalid' when spec.pattern is not a valid regular expression,
+// when spec.definition is not a json object, contains known keys with values of the wrong type,
+// or contains known keys which do not apply to spec.applyTo
+func (p *Policy) validateSpec() error {
+	var errorList field.ErrorList
+	if err := validatePolicyPattern(p.Spec.Pattern); err != nil {
+		errorList = append(errorList, field.Invalid(field.NewPath("spec", "pattern"), p.Spec.Pattern, err.Error()))
+	}
+	errorList = append(errorList, p.validateDefinition()...)
+	if len(errorList) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("Policy").GroupKind(), p.Name, errorList)
+	}
+	return nil
+}
+
+// validatePolicyPattern compiles pattern as a regular expression
+// RabbitMQ uses PCRE, which supports Perl syntax such as lookarounds that Go does not; patterns using it are not rejected
+func validatePolicyPattern(pattern string) error {
+	_, err := regexp.Compile(pattern)
+	var syntaxErr *syntax.Error
+	if errors.As(err, &syntaxErr) && syntaxErr.Code == syntax.ErrInvalidPerlOp {
+		return nil
+	}
+	return err
+}
+
+func (p *Policy) validateDefinition() field.ErrorList {
+	if p.Spec.Definition == nil {
+		return nil
+	}
+
+	definitionPath := field.NewPath("spec", "definition")
+	definition := make(map[string]interface{})
+	if err := json.Unmarshal(p.Spec.Definition.Raw, &definition); err != nil {
+		return field.ErrorList{
+			field.Invalid(definitionPath, string(p.Spec.Definition.Raw), "definition must be a valid json object"),
+		}
+	}
+
+	var keys []string
+	for key := range definition {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errorList field.ErrorList
+	for _, key := range keys {
+		path := definitionPath.Key(key)
+		value := definition[key]
+		definitionKey, ok := policyDefinitionKeys[key]
+		if !ok {
+			continue
+		}
+
+		if p.Spec.ApplyTo == "queues" && !definitionKey.queues {
+			errorList = append(errorList, field.Invalid(path, value, "does not apply to queues; set applyTo to 'exchanges' or 'all'"))
+		}
+		if p.Spec.ApplyTo == "exchanges" && !definitionKey.exchanges {
+			errorList = append(errorList, field.Invalid(path, value, "does not apply to exchanges; set applyTo to 'queues' or 'all'"))
+		}
+
+		switch definitionKey.valueType {
+		case stringValue:
+			if _, ok := value.(string); !ok {
+				errorList = append(errorList, field.Invalid(path, value, "must be a string"))
+			}
+		case nonNegativeIntegerValue:
+			if n, ok := value.(float64); !ok || n < 0 || n != math.Trunc(n) {
+				errorList = append(errorList, field.Invalid(path, value, "must be a non-negative integer"))
+			}
+		case positiveIntegerValue:
+			if n, ok := value.(float64); !ok || n < 1 || n != math.Trunc(n) {
+				errorList = append(errorList, field.Invalid(path, value, "must be a positive integer"))
+			}
+		case enumValue:
+			if !containsString(definitionKey.enum, fmt.Sprintf("%v", value)) {
+				errorList = append(errorList, field.NotSupported(path, value, definitionKey.enum))
+			}
+		}
+	}
+	return errorList
+}
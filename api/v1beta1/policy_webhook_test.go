@@ -1,6 +1,8 @@
 package v1beta1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -39,6 +41,88 @@ var _ = Describe("policy webhook", func() {
 			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
 			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
 		})
+
+		It("allows known definition keys with valid values", func() {
+			allowed := policy.DeepCopy()
+			allowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{
+"max-length": 10,
+"overflow": "reject-publish",
+"queue-mode": "lazy",
+"ha-mode": "exactly",
+"ha-params": 2,
+"federation-upstream": "an-upstream",
+"alternate-exchange": "an-exchange"
+}`)}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow patterns which are not valid regular expressions", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.Pattern = "^amq.(["
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.pattern: Invalid value"))
+		})
+
+		It("allows patterns using lookarounds supported by RabbitMQ", func() {
+			allowed := policy.DeepCopy()
+			allowed.Spec.Pattern = `^(?!amq\.).*`
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow definitions which are not json objects", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`["max-length"]`)}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("allows definition keys which are not known, such as keys defined by plugins", func() {
+			allowed := policy.DeepCopy()
+			allowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"some-plugin-key": "a-value", "target-group-size": 3}`)}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow definition values of the wrong type", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"max-length": "10", "message-ttl": 1.5, "expires": 0}`)}
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(SatisfyAll(
+				ContainSubstring("spec.definition[max-length]: Invalid value: \"10\": must be a non-negative integer"),
+				ContainSubstring("spec.definition[message-ttl]: Invalid value: 1.5: must be a non-negative integer"),
+				ContainSubstring("spec.definition[expires]: Invalid value: 0: must be a positive integer"),
+			))
+		})
+
+		It("does not allow definition values outside of the enums", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"overflow": "drop-tail", "queue-mode": "eager"}`)}
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(SatisfyAll(
+				ContainSubstring("spec.definition[overflow]: Unsupported value: \"drop-tail\""),
+				ContainSubstring("spec.definition[queue-mode]: Unsupported value: \"eager\""),
+			))
+		})
+
+		It("does not allow queue keys in policies which apply to exchanges", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.ApplyTo = "exchanges"
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"max-length": 10, "federation-upstream": "an-upstream"}`)}
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.definition[max-length]: Invalid value: 10: does not apply to exchanges"))
+			Expect(err.Error()).NotTo(ContainSubstring("federation-upstream"))
+		})
+
+		It("does not allow exchange keys in policies which apply to queues", func() {
+			notAllowed := policy.DeepCopy()
+			notAllowed.Spec.ApplyTo = "queues"
+			notAllowed.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"alternate-exchange": "an-exchange"}`)}
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.definition[alternate-exchange]: Invalid value: \"an-exchange\": does not apply to queues"))
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -103,10 +187,42 @@ var _ = Describe("policy webhook", func() {
 			Expect(newPolicy.ValidateUpdate(&policy)).To(Succeed())
 		})
 
+		It("does not allow updates to an invalid definition", func() {
+			newPolicy := policy.DeepCopy()
+			newPolicy.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"queue-mode": "eager"}`)}
+			Expect(apierrors.IsInvalid(newPolicy.ValidateUpdate(&policy))).To(BeTrue())
+		})
+
 		It("allows updates on policy.spec.definition", func() {
 			newPolicy := policy.DeepCopy()
-			newPolicy.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"key":"new-definition-value"}`)}
+			newPolicy.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"max-length":10}`)}
 			Expect(newPolicy.ValidateUpdate(&policy)).To(Succeed())
 		})
+
+		It("allows updates which do not change the spec of policies with an invalid spec", func() {
+			invalid := policy.DeepCopy()
+			invalid.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"queue-mode": "eager"}`)}
+			newPolicy := invalid.DeepCopy()
+			newPolicy.Labels = map[string]string{"a-label": "a-value"}
+			Expect(newPolicy.ValidateUpdate(invalid)).To(Succeed())
+		})
+
+		It("allows removing the finalizer of a policy being deleted with an invalid definition", func() {
+			invalid := policy.DeepCopy()
+			invalid.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"queue-mode": "eager"}`)}
+			invalid.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			invalid.Finalizers = []string{"deletion.finalizers.policies.rabbitmq.com"}
+			newPolicy := invalid.DeepCopy()
+			newPolicy.Finalizers = nil
+			Expect(newPolicy.ValidateUpdate(invalid)).To(Succeed())
+		})
+
+		It("does not allow updates on name of a policy being deleted", func() {
+			deleting := policy.DeepCopy()
+			deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			newPolicy := deleting.DeepCopy()
+			newPolicy.Spec.Name = "another-policy"
+			Expect(apierrors.IsForbidden(newPolicy.ValidateUpdate(deleting))).To(BeTrue())
+		})
 	})
 })
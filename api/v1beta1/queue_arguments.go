@@ -0,0 +1,192 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// typedQueueArgument is a queue argument which can be set through a typed field of QueueSpec
+type typedQueueArgument struct {
+	argument string
+	path     *field.Path
+	value    func(*QueueSpec) (interface{}, bool)
+}
+
+var typedQueueArguments = []typedQueueArgument{
+	{"x-dead-letter-exchange", field.NewPath("spec", "deadLetterExchange"), func(s *QueueSpec) (interface{}, bool) {
+		return s.DeadLetterExchange, s.DeadLetterExchange != ""
+	}},
+	{"x-dead-letter-routing-key", field.NewPath("spec", "deadLetterRoutingKey"), func(s *QueueSpec) (interface{}, bool) {
+		return s.DeadLetterRoutingKey, s.DeadLetterRoutingKey != ""
+	}},
+	{"x-message-ttl", field.NewPath("spec", "messageTTL"), func(s *QueueSpec) (interface{}, bool) {
+		if s.MessageTTL == nil {
+			return nil, false
+		}
+		return *s.MessageTTL, true
+	}},
+	{"x-max-length", field.NewPath("spec", "maxLength"), func(s *QueueSpec) (interface{}, bool) {
+		if s.MaxLength == nil {
+			return nil, false
+		}
+		return *s.MaxLength, true
+	}},
+	{"x-overflow", field.NewPath("spec", "overflow"), func(s *QueueSpec) (interface{}, bool) {
+		return s.Overflow, s.Overflow != ""
+	}},
+	{"x-delivery-limit", field.NewPath("spec", "deliveryLimit"), func(s *QueueSpec) (interface{}, bool) {
+		if s.DeliveryLimit == nil {
+			return nil, false
+		}
+		return *s.DeliveryLimit, true
+	}},
+	{"x-single-active-consumer", field.NewPath("spec", "singleActiveConsumer"), func(s *QueueSpec) (interface{}, bool) {
+		return s.SingleActiveConsumer, s.SingleActiveConsumer
+	}},
+	{"x-quorum-initial-group-size", field.NewPath("spec", "initialGroupSize"), func(s *QueueSpec) (interface{}, bool) {
+		return s.InitialGroupSize, s.InitialGroupSize != 0
+	}},
+	{"x-max-age", field.NewPath("spec", "stream", "maxAge"), func(s *QueueSpec) (interface{}, bool) {
+		if s.Stream == nil {
+			return nil, false
+		}
+		return s.Stream.MaxAge, s.Stream.MaxAge != ""
+	}},
+	{"x-max-length-bytes", field.NewPath("spec", "stream", "maxLengthBytes"), func(s *QueueSpec) (interface{}, bool) {
+		if s.Stream == nil {
+			return nil, false
+		}
+		return s.Stream.MaxLengthBytes, s.Stream.MaxLengthBytes != 0
+	}},
+	{"x-stream-max-segment-size-bytes", field.NewPath("spec", "stream", "maxSegmentSizeBytes"), func(s *QueueSpec) (interface{}, bool) {
+		if s.Stream == nil {
+			return nil, false
+		}
+		return s.Stream.MaxSegmentSizeBytes, s.Stream.MaxSegmentSizeBytes != 0
+	}},
+	{"x-initial-cluster-size", field.NewPath("spec", "stream", "initialClusterSize"), func(s *QueueSpec) (interface{}, bool) {
+		if s.Stream == nil {
+			return nil, false
+		}
+		return s.Stream.InitialClusterSize, s.Stream.InitialClusterSize != 0
+	}},
+}
+
+// unsupportedQueueArguments lists queue arguments which are not supported by a queue type
+// for more information, see: https://www.rabbitmq.com/queues.html#optional-arguments
+var unsupportedQueueArguments = map[string][]string{
+	"classic": {
+		"x-delivery-limit",
+		"x-quorum-initial-group-size",
+		"x-dead-letter-strategy",
+		"x-max-age",
+		"x-stream-max-segment-size-bytes",
+		"x-initial-cluster-size",
+	},
+	"quorum": {
+		"x-max-priority",
+		"x-queue-mode",
+		"x-queue-master-locator",
+		"x-queue-version",
+		"x-max-age",
+		"x-stream-max-segment-size-bytes",
+		"x-initial-cluster-size",
+	},
+	"stream": {
+		"x-dead-letter-exchange",
+		"x-dead-letter-routing-key",
+		"x-dead-letter-strategy",
+		"x-message-ttl",
+		"x-expires",
+		"x-max-length",
+		"x-overflow",
+		"x-delivery-limit",
+		"x-single-active-consumer",
+		"x-quorum-initial-group-size",
+		"x-max-priority",
+		"x-queue-mode",
+		"x-queue-master-locator",
+		"x-queue-version",
+	},
+}
+
+// TypedArguments returns the queue arguments set through typed fields, such as deadLetterExchange and stream settings
+func (s *QueueSpec) TypedArguments() map[string]interface{} {
+	arguments := make(map[string]interface{})
+	for _, a := range typedQueueArguments {
+		if value, ok := a.value(s); ok {
+			arguments[a.argument] = value
+		}
+	}
+	return arguments
+}
+
+// validateArguments returns errors for queue arguments set both as a typed field and in spec.arguments
+// and for queue arguments which are not supported by the queue type
+func (s *QueueSpec) validateArguments() field.ErrorList {
+	var errorList field.ErrorList
+
+	rawArguments := make(map[string]interface{})
+	if s.Arguments != nil {
+		if err := json.Unmarshal(s.Arguments.Raw, &rawArguments); err != nil {
+			return append(errorList, field.Invalid(field.NewPath("spec", "arguments"), string(s.Arguments.Raw), err.Error()))
+		}
+	}
+
+	queueType := s.Type
+	if queueType == "" {
+		queueType = "classic"
+	}
+	unsupported := make(map[string]bool)
+	for _, argument := range unsupportedQueueArguments[queueType] {
+		unsupported[argument] = true
+	}
+
+	for _, a := range typedQueueArguments {
+		value, ok := a.value(s)
+		if !ok {
+			continue
+		}
+		if _, duplicate := rawArguments[a.argument]; duplicate {
+			errorList = append(errorList, field.Duplicate(field.NewPath("spec", "arguments").Key(a.argument),
+				fmt.Sprintf("argument is already set by %s", a.path)))
+		}
+		if unsupported[a.argument] {
+			errorList = append(errorList, field.Invalid(a.path, value,
+				fmt.Sprintf("not supported by queues of type '%s'", queueType)))
+		}
+		if a.argument == "x-overflow" && queueType == "quorum" && value == "reject-publish-dlx" {
+			errorList = append(errorList, field.NotSupported(a.path, value, []string{"drop-head", "reject-publish"}))
+		}
+	}
+
+	// sort raw arguments so that errors are returned in a stable order
+	keys := make([]string, 0, len(rawArguments))
+	for k := range rawArguments {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		path := field.NewPath("spec", "arguments").Key(k)
+		if unsupported[k] {
+			errorList = append(errorList, field.Invalid(path, rawArguments[k],
+				fmt.Sprintf("not supported by queues of type '%s'", queueType)))
+		}
+		if k == "x-overflow" && queueType == "quorum" && rawArguments[k] == "reject-publish-dlx" {
+			errorList = append(errorList, field.NotSupported(path, rawArguments[k], []string{"drop-head", "reject-publish"}))
+		}
+	}
+	return errorList
+}
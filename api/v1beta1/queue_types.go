@@ -34,15 +34,67 @@ type QueueSpec struct {
 	AutoDelete bool `json:"autoDelete,omitempty"`
 	// Queue arguments in the format of KEY: VALUE. e.g. x-delivery-limit: 10000.
 	// Configuring queues through arguments is not recommended because they cannot be updated once set; we recommend configuring queues through policies instead.
+	// Arguments which are also set through typed fields, such as deadLetterExchange, are not allowed.
 	// +kubebuilder:validation:Type=object
 	// +kubebuilder:pruning:PreserveUnknownFields
 	Arguments *runtime.RawExtension `json:"arguments,omitempty"`
+	// Exchange to which messages are dead lettered; sets argument 'x-dead-letter-exchange'.
+	// Not supported by streams.
+	DeadLetterExchange string `json:"deadLetterExchange,omitempty"`
+	// Routing key used when dead lettering messages; sets argument 'x-dead-letter-routing-key'.
+	// Not supported by streams.
+	DeadLetterRoutingKey string `json:"deadLetterRoutingKey,omitempty"`
+	// Time in milliseconds a message can remain in the queue; sets argument 'x-message-ttl'.
+	// Not supported by streams.
+	// +kubebuilder:validation:Minimum:=0
+	MessageTTL *int64 `json:"messageTTL,omitempty"`
+	// Maximum number of ready messages in the queue; sets argument 'x-max-length'.
+	// Not supported by streams.
+	// +kubebuilder:validation:Minimum:=0
+	MaxLength *int64 `json:"maxLength,omitempty"`
+	// Behaviour when the queue reaches its maximum length; sets argument 'x-overflow'.
+	// 'reject-publish-dlx' is only supported by classic queues. Not supported by streams.
+	// +kubebuilder:validation:Enum=drop-head;reject-publish;reject-publish-dlx
+	Overflow string `json:"overflow,omitempty"`
+	// Number of times a message is delivered before it is dropped or dead lettered; sets argument 'x-delivery-limit'.
+	// Only supported by quorum queues.
+	// +kubebuilder:validation:Minimum:=0
+	DeliveryLimit *int64 `json:"deliveryLimit,omitempty"`
+	// When set to true, only one consumer at a time consumes from the queue; sets argument 'x-single-active-consumer'.
+	// Not supported by streams.
+	SingleActiveConsumer bool `json:"singleActiveConsumer,omitempty"`
+	// Number of replicas the quorum queue is declared with; sets argument 'x-quorum-initial-group-size'.
+	// Only supported by quorum queues.
+	// +kubebuilder:validation:Minimum:=1
+	InitialGroupSize int `json:"initialGroupSize,omitempty"`
+	// Stream specific settings; can only be set for queues of type 'stream'.
+	// Settings are added to the queue arguments; the same arguments cannot be set in spec.arguments.
+	// +kubebuilder:validation:Optional
+	Stream *StreamSettings `json:"stream,omitempty"`
 	// Reference to the RabbitmqCluster that the queue will be created in.
 	// Required property.
 	// +kubebuilder:validation:Required
 	RabbitmqClusterReference RabbitmqClusterReference `json:"rabbitmqClusterReference"`
 }
 
+// StreamSettings defines retention and replication settings of a stream.
+// For more information, see: https://www.rabbitmq.com/streams.html.
+type StreamSettings struct {
+	// Maximum age of messages in the stream; sets argument 'x-max-age'.
+	// Must be a positive integer followed by one of the units 'Y', 'M', 'D', 'h', 'm' or 's', e.g. '7D'.
+	// +kubebuilder:validation:Pattern:="^[1-9][0-9]*[YMDhms]$"
+	MaxAge string `json:"maxAge,omitempty"`
+	// Maximum total size of the stream in bytes; sets argument 'x-max-length-bytes'.
+	// +kubebuilder:validation:Minimum:=1
+	MaxLengthBytes int64 `json:"maxLengthBytes,omitempty"`
+	// Maximum size of a segment file of the stream in bytes; sets argument 'x-stream-max-segment-size-bytes'.
+	// +kubebuilder:validation:Minimum:=1
+	MaxSegmentSizeBytes int64 `json:"maxSegmentSizeBytes,omitempty"`
+	// Number of replicas the stream is declared with; sets argument 'x-initial-cluster-size'.
+	// +kubebuilder:validation:Minimum:=1
+	InitialClusterSize int `json:"initialClusterSize,omitempty"`
+}
+
 // QueueStatus defines the observed state of Queue
 type QueueStatus struct {
 	// observedGeneration is the most recent successful generation observed for this Queue. It corresponds to the
@@ -2,6 +2,8 @@ package v1beta1
 
 import (
 	"fmt"
+	"reflect"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -20,6 +22,7 @@ func (q *Queue) SetupWebhookWithManager(mgr ctrl.Manager) error {
 var _ webhook.Validator = &Queue{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+// returns error type 'invalid' for queue arguments which are set both as a typed field and in spec.arguments, or which are not supported by the queue type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
 func (q *Queue) ValidateCreate() error {
 	if q.Spec.Type == "quorum" && q.Spec.Durable == false {
@@ -27,12 +30,21 @@ func (q *Queue) ValidateCreate() error {
 			field.Forbidden(field.NewPath("spec", "durable"),
 				"Quorum queues must have durable set to true"))
 	}
+	if q.Spec.Stream != nil && q.Spec.Type != "stream" {
+		return apierrors.NewForbidden(q.GroupResource(), q.Name,
+			field.Forbidden(field.NewPath("spec", "stream"),
+				"stream settings can only be set for queues of type 'stream'"))
+	}
+	if errorList := q.Spec.validateArguments(); len(errorList) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("Queue").GroupKind(), q.Name, errorList)
+	}
 	return q.Spec.RabbitmqClusterReference.ValidateOnCreate(q.GroupResource(), q.Name)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
 // returns error type 'forbidden' for updates that the controller chooses to disallow: queue name/vhost/rabbitmqClusterReference
-// returns error type 'invalid' for updates that will be rejected by rabbitmq server: queue types/autoDelete/durable
+// returns error type 'invalid' for updates that will be rejected by rabbitmq server: queue types/autoDelete/durable/typed arguments
+// and for changes to queue arguments which are duplicated or not supported by the queue type
 // queue arguments not handled because implementation couldn't change
 func (q *Queue) ValidateUpdate(old runtime.Object) error {
 	oldQueue, ok := old.(*Queue)
@@ -81,6 +93,19 @@ func (q *Queue) ValidateUpdate(old runtime.Object) error {
 		))
 	}
 
+	for _, a := range typedQueueArguments {
+		value, ok := a.value(&q.Spec)
+		oldValue, oldOk := a.value(&oldQueue.Spec)
+		if ok != oldOk || value != oldValue {
+			allErrs = append(allErrs, field.Invalid(a.path, value, fmt.Sprintf("%s cannot be updated", a.argument)))
+		}
+	}
+
+	// queues created before arguments were validated must remain updatable
+	if !reflect.DeepEqual(q.Spec.Arguments, oldQueue.Spec.Arguments) {
+		allErrs = append(allErrs, q.Spec.validateArguments()...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil
 	}
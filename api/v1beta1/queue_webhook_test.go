@@ -1,11 +1,14 @@
 package v1beta1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 var _ = Describe("queue webhook", func() {
@@ -45,6 +48,90 @@ var _ = Describe("queue webhook", func() {
 			notAllowedQ.Spec.AutoDelete = false
 			Expect(apierrors.IsForbidden(notAllowedQ.ValidateCreate())).To(BeTrue(), "Expected 'forbidden' response for non-durable quorum queue")
 		})
+
+		It("does not allow stream settings for queues which are not streams", func() {
+			notAllowedQ := queue.DeepCopy()
+			notAllowedQ.Spec.Durable = true
+			notAllowedQ.Spec.Stream = &StreamSettings{MaxAge: "7D"}
+			Expect(apierrors.IsForbidden(notAllowedQ.ValidateCreate())).To(BeTrue(), "Expected 'forbidden' response for stream settings on a quorum queue")
+		})
+
+		It("allows typed arguments supported by the queue type", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			deliveryLimit := int64(5)
+			q.Spec.DeadLetterExchange = "dlx"
+			q.Spec.Overflow = "reject-publish"
+			q.Spec.DeliveryLimit = &deliveryLimit
+			q.Spec.InitialGroupSize = 3
+			q.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-max-in-memory-length": 500}`)}
+			Expect(q.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow arguments which are set both as a typed field and in spec.arguments", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			q.Spec.DeadLetterExchange = "dlx"
+			q.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-dead-letter-exchange": "another-dlx"}`)}
+			err := q.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.arguments[x-dead-letter-exchange]: Duplicate value"))
+		})
+
+		It("does not allow arguments which are not supported by quorum queues", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			q.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-max-priority": 10}`)}
+			err := q.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.arguments[x-max-priority]: Invalid value: 10: not supported by queues of type 'quorum'"))
+		})
+
+		It("does not allow overflow 'reject-publish-dlx' for quorum queues", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			q.Spec.Overflow = "reject-publish-dlx"
+			err := q.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.overflow: Unsupported value"))
+		})
+
+		It("does not allow typed arguments which are only supported by quorum queues for classic queues", func() {
+			q := queue.DeepCopy()
+			q.Spec.Type = "classic"
+			deliveryLimit := int64(5)
+			q.Spec.DeliveryLimit = &deliveryLimit
+			err := q.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.deliveryLimit: Invalid value: 5: not supported by queues of type 'classic'"))
+
+			q.Spec.Type = ""
+			Expect(apierrors.IsInvalid(q.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow dead lettering for streams", func() {
+			q := queue.DeepCopy()
+			q.Spec.Type = "stream"
+			q.Spec.Durable = true
+			q.Spec.DeadLetterExchange = "dlx"
+			err := q.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("spec.deadLetterExchange: Invalid value: \"dlx\": not supported by queues of type 'stream'"))
+		})
+
+		It("allows stream settings for streams", func() {
+			stream := queue.DeepCopy()
+			stream.Spec.Type = "stream"
+			stream.Spec.Durable = true
+			stream.Spec.AutoDelete = false
+			stream.Spec.Stream = &StreamSettings{
+				MaxAge:              "7D",
+				MaxLengthBytes:      20000000000,
+				MaxSegmentSizeBytes: 500000000,
+				InitialClusterSize:  3,
+			}
+			Expect(stream.ValidateCreate()).To(Succeed())
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -107,10 +194,70 @@ var _ = Describe("queue webhook", func() {
 			Expect(apierrors.IsInvalid(newQueue.ValidateUpdate(&queue))).To(BeTrue())
 		})
 
+		It("does not allow updates on stream settings", func() {
+			stream := queue.DeepCopy()
+			stream.Spec.Type = "stream"
+			stream.Spec.Stream = &StreamSettings{MaxAge: "7D"}
+			newStream := stream.DeepCopy()
+			newStream.Spec.Stream.MaxAge = "1D"
+			Expect(apierrors.IsInvalid(newStream.ValidateUpdate(stream))).To(BeTrue())
+			newStream.Spec.Stream = nil
+			Expect(apierrors.IsInvalid(newStream.ValidateUpdate(stream))).To(BeTrue())
+		})
+
+		It("does not allow updates on typed arguments", func() {
+			q := queue.DeepCopy()
+			q.Spec.DeadLetterExchange = "dlx"
+			newQ := q.DeepCopy()
+			newQ.Spec.DeadLetterExchange = "another-dlx"
+			Expect(apierrors.IsInvalid(newQ.ValidateUpdate(q))).To(BeTrue())
+
+			messageTTL := int64(1000)
+			newQ = q.DeepCopy()
+			newQ.Spec.MessageTTL = &messageTTL
+			Expect(apierrors.IsInvalid(newQ.ValidateUpdate(q))).To(BeTrue())
+		})
+
+		It("does not allow adding raw arguments which duplicate typed arguments", func() {
+			q := queue.DeepCopy()
+			q.Spec.DeadLetterExchange = "dlx"
+			newQ := q.DeepCopy()
+			newQ.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-dead-letter-exchange": "dlx"}`)}
+			Expect(apierrors.IsInvalid(newQ.ValidateUpdate(q))).To(BeTrue())
+		})
+
 		It("does not allow updates on autoDelete", func() {
 			newQueue := queue.DeepCopy()
 			newQueue.Spec.AutoDelete = false
 			Expect(apierrors.IsInvalid(newQueue.ValidateUpdate(&queue))).To(BeTrue())
 		})
+
+		It("allows updates which do not change the arguments of queues with invalid arguments", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			q.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-max-priority": 10}`)}
+			newQ := q.DeepCopy()
+			newQ.Labels = map[string]string{"a-label": "a-value"}
+			Expect(newQ.ValidateUpdate(q)).To(Succeed())
+		})
+
+		It("allows removing the finalizer of a queue being deleted with invalid arguments", func() {
+			q := queue.DeepCopy()
+			q.Spec.Durable = true
+			q.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-max-priority": 10}`)}
+			q.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			q.Finalizers = []string{"deletion.finalizers.queues.rabbitmq.com"}
+			newQ := q.DeepCopy()
+			newQ.Finalizers = nil
+			Expect(newQ.ValidateUpdate(q)).To(Succeed())
+		})
+
+		It("does not allow updates on name of a queue being deleted", func() {
+			q := queue.DeepCopy()
+			q.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			newQ := q.DeepCopy()
+			newQ.Spec.Name = "another-queue"
+			Expect(apierrors.IsForbidden(newQ.ValidateUpdate(q))).To(BeTrue())
+		})
 	})
 })
@@ -1,6 +1,8 @@
 package v1beta1
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -62,3 +64,26 @@ func (ref *RabbitmqClusterReference) ValidateOnCreate(groupResource schema.Group
 	}
 	return nil
 }
+
+// ClusterEndpoint is a vhost of another RabbitmqCluster, which the operator connects to with a user it provisions in that cluster.
+type ClusterEndpoint struct {
+	// Reference to the RabbitmqCluster of the endpoint.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+	// Vhost of the endpoint; defaults to '/'.
+	// +kubebuilder:default:=/
+	Vhost string `json:"vhost,omitempty"`
+}
+
+// validate returns an error when neither or both of rabbitmqClusterReference.name and rabbitmqClusterReference.connectionSecret are provided
+func (e *ClusterEndpoint) validate(path *field.Path) *field.Error {
+	path = path.Child("rabbitmqClusterReference")
+	if e.RabbitmqClusterReference.Name != "" && e.RabbitmqClusterReference.ConnectionSecret != nil {
+		return field.Forbidden(path, fmt.Sprintf("do not provide both %s.name and %s.connectionSecret", path, path))
+	}
+	if e.RabbitmqClusterReference.Name == "" && e.RabbitmqClusterReference.ConnectionSecret == nil {
+		return field.Forbidden(path, fmt.Sprintf("must provide either %s.name or %s.connectionSecret", path, path))
+	}
+	return nil
+}
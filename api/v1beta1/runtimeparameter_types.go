@@ -0,0 +1,74 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// RuntimeParameterSpec defines the desired state of RuntimeParameter
+type RuntimeParameterSpec struct {
+	// Name of the runtime parameter; required property; cannot be updated.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// Component the runtime parameter belongs to, e.g. 'federation-upstream-set'; cannot be updated.
+	// Leave it empty to declare a global runtime parameter, e.g. 'mqtt_port_to_vhost_mapping'.
+	// +kubebuilder:validation:Optional
+	Component string `json:"component,omitempty"`
+	// Name of the vhost the runtime parameter is declared in; cannot be updated.
+	// Defaults to '/' when component is set; must be empty for global runtime parameters.
+	// +kubebuilder:validation:Optional
+	Vhost string `json:"vhost,omitempty"`
+	// Value of the runtime parameter; can be any valid JSON value. Required property.
+	// See RabbitMQ doc for more information: https://www.rabbitmq.com/parameters.html
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Required
+	Value *runtime.RawExtension `json:"value"`
+	// Reference to the RabbitmqCluster that the runtime parameter will be created in.
+	// Required property.
+	// +kubebuilder:validation:Required
+	RabbitmqClusterReference RabbitmqClusterReference `json:"rabbitmqClusterReference"`
+}
+
+// RuntimeParameterStatus defines the observed state of RuntimeParameter
+type RuntimeParameterStatus struct {
+	// observedGeneration is the most recent successful generation observed for this RuntimeParameter. It corresponds to the
+	// RuntimeParameter's generation, which is updated on mutation by the API Server.
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	Conditions         []Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=all;rabbitmq
+// +kubebuilder:subresource:status
+
+// RuntimeParameter is the Schema for the runtimeparameters API
+type RuntimeParameter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeParameterSpec   `json:"spec,omitempty"`
+	Status RuntimeParameterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RuntimeParameterList contains a list of RuntimeParameter
+type RuntimeParameterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuntimeParameter `json:"items"`
+}
+
+func (p *RuntimeParameter) GroupResource() schema.GroupResource {
+	return schema.GroupResource{
+		Group:    p.GroupVersionKind().Group,
+		Resource: p.GroupVersionKind().Kind,
+	}
+}
+
+func init() {
+	SchemeBuilder.Register(&RuntimeParameter{}, &RuntimeParameterList{})
+}
@@ -0,0 +1,78 @@
+package v1beta1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("RuntimeParameter", func() {
+	var (
+		namespace = "default"
+		ctx       = context.Background()
+	)
+
+	It("creates a global runtime parameter", func() {
+		parameter := RuntimeParameter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-global-parameter",
+				Namespace: namespace,
+			},
+			Spec: RuntimeParameterSpec{
+				Name: "mqtt_port_to_vhost_mapping",
+				Value: &runtime.RawExtension{
+					Raw: []byte(`{"1883":"vhost1","8883":"vhost2"}`),
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &parameter)).To(Succeed())
+		fetched := &RuntimeParameter{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      parameter.Name,
+			Namespace: parameter.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.Name).To(Equal("mqtt_port_to_vhost_mapping"))
+		Expect(fetched.Spec.Component).To(BeEmpty())
+		Expect(fetched.Spec.Vhost).To(BeEmpty())
+		Expect(fetched.Spec.Value.Raw).To(Equal([]byte(`{"1883":"vhost1","8883":"vhost2"}`)))
+		Expect(fetched.Spec.RabbitmqClusterReference).To(Equal(RabbitmqClusterReference{
+			Name: "some-cluster",
+		}))
+	})
+
+	It("creates a vhost scoped runtime parameter with a non-object value", func() {
+		parameter := RuntimeParameter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vhost-parameter",
+				Namespace: namespace,
+			},
+			Spec: RuntimeParameterSpec{
+				Name:      "a-set",
+				Component: "federation-upstream-set",
+				Vhost:     "/a-vhost",
+				Value: &runtime.RawExtension{
+					Raw: []byte(`[{"upstream":"upstream-1"}]`),
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &parameter)).To(Succeed())
+		fetched := &RuntimeParameter{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      parameter.Name,
+			Namespace: parameter.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.Component).To(Equal("federation-upstream-set"))
+		Expect(fetched.Spec.Vhost).To(Equal("/a-vhost"))
+		Expect(fetched.Spec.Value.Raw).To(Equal([]byte(`[{"upstream":"upstream-1"}]`)))
+	})
+})
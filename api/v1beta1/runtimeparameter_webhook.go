@@ -0,0 +1,88 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (p *RuntimeParameter) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(p).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1beta1-runtimeparameter,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=runtimeparameters,versions=v1beta1,name=vruntimeparameter.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &RuntimeParameter{}
+
+// ValidateCreate checks that spec.vhost is only set together with spec.component and that spec.value is valid json
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+func (p *RuntimeParameter) ValidateCreate() error {
+	if err := p.validateSpec(); err != nil {
+		return err
+	}
+	return p.Spec.RabbitmqClusterReference.ValidateOnCreate(p.GroupResource(), p.Name)
+}
+
+// ValidateUpdate do not allow updates on spec.name, spec.component, spec.vhost and spec.rabbitmqClusterReference
+// updates on spec.value are allowed
+func (p *RuntimeParameter) ValidateUpdate(old runtime.Object) error {
+	oldParameter, ok := old.(*RuntimeParameter)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a runtime parameter but got a %T", old))
+	}
+
+	detailMsg := "updates on name, component, vhost and rabbitmqClusterReference are all forbidden"
+	if p.Spec.Name != oldParameter.Spec.Name {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
+	}
+
+	if p.Spec.Component != oldParameter.Spec.Component {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "component"), detailMsg))
+	}
+
+	if p.Spec.Vhost != oldParameter.Spec.Vhost {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+
+	if !oldParameter.Spec.RabbitmqClusterReference.Matches(&p.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+
+	return p.validateSpec()
+}
+
+// ValidateDelete no validation on delete
+func (p *RuntimeParameter) ValidateDelete() error {
+	return nil
+}
+
+// validateSpec returns error type 'invalid' if spec.vhost is set for a global runtime parameter
+// or if spec.value is not valid json
+func (p *RuntimeParameter) validateSpec() error {
+	var errorList field.ErrorList
+	if p.Spec.Component == "" && p.Spec.Vhost != "" {
+		errorList = append(errorList, field.Invalid(field.NewPath("spec", "vhost"), p.Spec.Vhost,
+			"global runtime parameters are not scoped to a vhost; set spec.component or remove spec.vhost"))
+	}
+
+	if p.Spec.Value == nil || !json.Valid(p.Spec.Value.Raw) {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "value"),
+			"value must be valid json"))
+	}
+
+	if len(errorList) > 0 {
+		return apierrors.NewInvalid(GroupVersion.WithKind("RuntimeParameter").GroupKind(), p.Name, errorList)
+	}
+	return nil
+}
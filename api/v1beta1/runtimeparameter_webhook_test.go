@@ -0,0 +1,109 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("runtimeParameter webhook", func() {
+	var parameter = RuntimeParameter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: RuntimeParameterSpec{
+			Name:      "a-set",
+			Component: "federation-upstream-set",
+			Vhost:     "/test",
+			Value: &runtime.RawExtension{
+				Raw: []byte(`[{"upstream":"upstream-1"}]`),
+			},
+			RabbitmqClusterReference: RabbitmqClusterReference{
+				Name: "a-cluster",
+			},
+		},
+	}
+
+	Context("ValidateCreate", func() {
+		It("allows vhost scoped runtime parameters", func() {
+			Expect(parameter.ValidateCreate()).To(Succeed())
+		})
+
+		It("allows global runtime parameters", func() {
+			global := parameter.DeepCopy()
+			global.Spec.Component = ""
+			global.Spec.Vhost = ""
+			Expect(global.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow a vhost on global runtime parameters", func() {
+			notAllowed := parameter.DeepCopy()
+			notAllowed.Spec.Component = ""
+			err := notAllowed.ValidateCreate()
+			Expect(apierrors.IsInvalid(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("spec.vhost")))
+		})
+
+		It("does not allow a value that is not valid json", func() {
+			notAllowed := parameter.DeepCopy()
+			notAllowed.Spec.Value = &runtime.RawExtension{Raw: []byte(`{not-json`)}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := parameter.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := parameter.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on runtime parameter name", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.Name = "new-name"
+			Expect(apierrors.IsForbidden(newParameter.ValidateUpdate(&parameter))).To(BeTrue())
+		})
+
+		It("does not allow updates on component", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.Component = "shovel"
+			Expect(apierrors.IsForbidden(newParameter.ValidateUpdate(&parameter))).To(BeTrue())
+		})
+
+		It("does not allow updates on vhost", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newParameter.ValidateUpdate(&parameter))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.RabbitmqClusterReference = RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newParameter.ValidateUpdate(&parameter))).To(BeTrue())
+		})
+
+		It("allows updates on runtimeParameter.spec.value", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.Value = &runtime.RawExtension{Raw: []byte(`[{"upstream":"upstream-2"}]`)}
+			Expect(newParameter.ValidateUpdate(&parameter)).To(Succeed())
+		})
+
+		It("does not allow updates to a value that is not valid json", func() {
+			newParameter := parameter.DeepCopy()
+			newParameter.Spec.Value = &runtime.RawExtension{Raw: []byte(`[`)}
+			Expect(apierrors.IsInvalid(newParameter.ValidateUpdate(&parameter))).To(BeTrue())
+		})
+	})
+})
@@ -22,9 +22,20 @@ type ShovelSpec struct {
 	// Secret contains the AMQP URI(s) to configure Shovel destination and source.
 	// The Secret must contain the key `destUri` and `srcUri` or operator will error.
 	// Both fields should be one or multiple uris separated by ','.
-	// Required property.
-	// +kubebuilder:validation:Required
-	UriSecret *corev1.LocalObjectReference `json:"uriSecret"`
+	// The key `srcUri` is not read when srcCluster is set, and the key `destUri` is not read when destCluster is set.
+	// Required property unless both srcCluster and destCluster are set.
+	// +kubebuilder:validation:Optional
+	UriSecret *corev1.LocalObjectReference `json:"uriSecret,omitempty"`
+	// Source RabbitmqCluster and vhost; the operator provisions a source user in the source vhost,
+	// and generates the source URI with the credentials of that user. Cannot be updated.
+	// Only supported with the 'amqp091' source protocol.
+	// +kubebuilder:validation:Optional
+	SourceCluster *ClusterEndpoint `json:"srcCluster,omitempty"`
+	// Destination RabbitmqCluster and vhost; the operator provisions a destination user in the destination vhost,
+	// and generates the destination URI with the credentials of that user. Cannot be updated.
+	// Only supported with the 'amqp091' destination protocol.
+	// +kubebuilder:validation:Optional
+	DestinationCluster *ClusterEndpoint `json:"destCluster,omitempty"`
 	// +kubebuilder:validation:Enum=on-confirm;on-publish;no-ack
 	AckMode                          string `json:"ackMode,omitempty"`
 	AddForwardHeaders                bool   `json:"addForwardHeaders,omitempty"`
@@ -56,6 +67,13 @@ type ShovelStatus struct {
 	// Shovel's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
 	Conditions         []Condition `json:"conditions,omitempty"`
+	// State of the shovel as reported by RabbitMQ; one of 'starting', 'running' or 'terminated'.
+	// Refreshed periodically.
+	State string `json:"state,omitempty"`
+	// Reason the shovel last terminated, as reported by RabbitMQ; cleared once the shovel is running.
+	LastError string `json:"lastError,omitempty"`
+	// Name of the RabbitMQ node running the shovel.
+	Node string `json:"node,omitempty"`
 }
 
 // +genclient
@@ -2,6 +2,8 @@ package v1beta1
 
 import (
 	"fmt"
+	"reflect"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -21,8 +23,12 @@ var _ webhook.Validator = &Shovel{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// uriSecret must be provided unless both srcCluster and destCluster are provided
 func (s *Shovel) ValidateCreate() error {
-	return s.Spec.RabbitmqClusterReference.ValidateOnCreate(s.GroupResource(), s.Name)
+	if err := s.Spec.RabbitmqClusterReference.ValidateOnCreate(s.GroupResource(), s.Name); err != nil {
+		return err
+	}
+	return s.validateEndpoints()
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -32,7 +38,7 @@ func (s *Shovel) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewBadRequest(fmt.Sprintf("expected a shovel but got a %T", oldShovel))
 	}
 
-	detailMsg := "updates on name, vhost and rabbitmqClusterReference are all forbidden"
+	detailMsg := "updates on name, vhost, srcCluster, destCluster and rabbitmqClusterReference are all forbidden"
 	if s.Spec.Name != oldShovel.Spec.Name {
 		return apierrors.NewForbidden(s.GroupResource(), s.Name,
 			field.Forbidden(field.NewPath("spec", "name"), detailMsg))
@@ -47,6 +53,46 @@ func (s *Shovel) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewForbidden(s.GroupResource(), s.Name,
 			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
 	}
+
+	if !reflect.DeepEqual(s.Spec.SourceCluster, oldShovel.Spec.SourceCluster) {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "srcCluster"), detailMsg))
+	}
+
+	if !reflect.DeepEqual(s.Spec.DestinationCluster, oldShovel.Spec.DestinationCluster) {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "destCluster"), detailMsg))
+	}
+	return s.validateEndpoints()
+}
+
+func (s *Shovel) validateEndpoints() error {
+	if s.Spec.SourceCluster != nil && s.Spec.DestinationCluster != nil && s.Spec.UriSecret != nil {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "uriSecret"), "do not provide spec.uriSecret when both spec.srcCluster and spec.destCluster are provided"))
+	}
+	if (s.Spec.SourceCluster == nil || s.Spec.DestinationCluster == nil) && s.Spec.UriSecret == nil {
+		return apierrors.NewForbidden(s.GroupResource(), s.Name,
+			field.Forbidden(field.NewPath("spec", "uriSecret"), "must provide spec.uriSecret unless both spec.srcCluster and spec.destCluster are provided"))
+	}
+	if s.Spec.SourceCluster != nil {
+		if s.Spec.SourceProtocol != "" && s.Spec.SourceProtocol != "amqp091" {
+			return apierrors.NewForbidden(s.GroupResource(), s.Name,
+				field.Forbidden(field.NewPath("spec", "srcCluster"), "spec.srcCluster is only supported with the 'amqp091' source protocol"))
+		}
+		if err := s.Spec.SourceCluster.validate(field.NewPath("spec", "srcCluster")); err != nil {
+			return apierrors.NewForbidden(s.GroupResource(), s.Name, err)
+		}
+	}
+	if s.Spec.DestinationCluster != nil {
+		if s.Spec.DestinationProtocol != "" && s.Spec.DestinationProtocol != "amqp091" {
+			return apierrors.NewForbidden(s.GroupResource(), s.Name,
+				field.Forbidden(field.NewPath("spec", "destCluster"), "spec.destCluster is only supported with the 'amqp091' destination protocol"))
+		}
+		if err := s.Spec.DestinationCluster.validate(field.NewPath("spec", "destCluster")); err != nil {
+			return apierrors.NewForbidden(s.GroupResource(), s.Name, err)
+		}
+	}
 	return nil
 }
 
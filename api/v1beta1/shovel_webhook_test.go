@@ -60,6 +60,48 @@ var _ = Describe("shovel webhook", func() {
 			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
 			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
 		})
+
+		It("allows spec.srcCluster and spec.destCluster instead of spec.uriSecret", func() {
+			allowed := shovel.DeepCopy()
+			allowed.Spec.UriSecret = nil
+			allowed.Spec.SourceCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "source"}, Vhost: "/"}
+			allowed.Spec.DestinationCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "destination"}, Vhost: "/"}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("allows spec.srcCluster together with spec.uriSecret for the destination", func() {
+			allowed := shovel.DeepCopy()
+			allowed.Spec.SourceCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "source"}, Vhost: "/"}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow spec.uriSecret when both spec.srcCluster and spec.destCluster are configured", func() {
+			notAllowed := shovel.DeepCopy()
+			notAllowed.Spec.SourceCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "source"}}
+			notAllowed.Spec.DestinationCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "destination"}}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("requires spec.uriSecret unless both spec.srcCluster and spec.destCluster are configured", func() {
+			notAllowed := shovel.DeepCopy()
+			notAllowed.Spec.UriSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+			notAllowed.Spec.DestinationCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "destination"}}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow spec.srcCluster with the 'amqp10' source protocol", func() {
+			notAllowed := shovel.DeepCopy()
+			notAllowed.Spec.SourceProtocol = "amqp10"
+			notAllowed.Spec.SourceCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "source"}}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.destCluster.rabbitmqClusterReference must have either a name or a connectionSecret", func() {
+			notAllowed := shovel.DeepCopy()
+			notAllowed.Spec.DestinationCluster = &ClusterEndpoint{}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -106,6 +148,20 @@ var _ = Describe("shovel webhook", func() {
 			Expect(apierrors.IsForbidden(new.ValidateUpdate(&connectionScr))).To(BeTrue())
 		})
 
+		It("does not allow updates on srcCluster", func() {
+			oldShovel := shovel.DeepCopy()
+			oldShovel.Spec.SourceCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "source"}, Vhost: "/"}
+			newShovel := oldShovel.DeepCopy()
+			newShovel.Spec.SourceCluster.Vhost = "/another-vhost"
+			Expect(apierrors.IsForbidden(newShovel.ValidateUpdate(oldShovel))).To(BeTrue())
+		})
+
+		It("does not allow updates on destCluster", func() {
+			newShovel := shovel.DeepCopy()
+			newShovel.Spec.DestinationCluster = &ClusterEndpoint{RabbitmqClusterReference: RabbitmqClusterReference{Name: "destination"}, Vhost: "/"}
+			Expect(apierrors.IsForbidden(newShovel.ValidateUpdate(&shovel))).To(BeTrue())
+		})
+
 		It("allows updates on shovel.spec.uriSecret", func() {
 			newShovel := shovel.DeepCopy()
 			newShovel.Spec.UriSecret = &corev1.LocalObjectReference{Name: "another-secret"}
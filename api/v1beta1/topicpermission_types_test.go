@@ -0,0 +1,80 @@
+package v1beta1
+
+import (
+	"context"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("TopicPermission spec", func() {
+	var (
+		namespace = "default"
+		ctx       = context.Background()
+	)
+
+	It("creates a topic permission with no read and write patterns configured", func() {
+		topicPermission := TopicPermission{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-topic-permission-0",
+				Namespace: namespace,
+			},
+			Spec: TopicPermissionSpec{
+				User:  "test",
+				Vhost: "/test",
+				Permissions: TopicPermissionConfig{
+					Exchange: "amq.topic",
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &topicPermission)).To(Succeed())
+		fetched := &TopicPermission{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      topicPermission.Name,
+			Namespace: topicPermission.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.User).To(Equal("test"))
+		Expect(fetched.Spec.Vhost).To(Equal("/test"))
+		Expect(fetched.Spec.RabbitmqClusterReference.Name).To(Equal("some-cluster"))
+
+		Expect(fetched.Spec.Permissions.Exchange).To(Equal("amq.topic"))
+		Expect(fetched.Spec.Permissions.Write).To(Equal(""))
+		Expect(fetched.Spec.Permissions.Read).To(Equal(""))
+	})
+
+	It("creates a topic permission with userReference and read and write patterns configured", func() {
+		topicPermission := TopicPermission{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-topic-permission-1",
+				Namespace: namespace,
+			},
+			Spec: TopicPermissionSpec{
+				UserReference: &corev1.LocalObjectReference{Name: "a-user"},
+				Vhost:         "/test",
+				Permissions: TopicPermissionConfig{
+					Exchange: "amq.topic",
+					Read:     "^?",
+					Write:    ".*",
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &topicPermission)).To(Succeed())
+		fetched := &TopicPermission{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      topicPermission.Name,
+			Namespace: topicPermission.Namespace,
+		}, fetched)).To(Succeed())
+		Expect(fetched.Spec.UserReference.Name).To(Equal("a-user"))
+		Expect(fetched.Spec.Permissions.Exchange).To(Equal("amq.topic"))
+		Expect(fetched.Spec.Permissions.Write).To(Equal(".*"))
+		Expect(fetched.Spec.Permissions.Read).To(Equal("^?"))
+	})
+})
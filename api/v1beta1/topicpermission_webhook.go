@@ -0,0 +1,93 @@
+package v1beta1
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func (p *TopicPermission) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(p).
+		Complete()
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-rabbitmq-com-v1beta1-topicpermission,mutating=false,failurePolicy=fail,groups=rabbitmq.com,resources=topicpermissions,versions=v1beta1,name=vtopicpermission.kb.io,sideEffects=none,admissionReviewVersions=v1
+
+var _ webhook.Validator = &TopicPermission{}
+
+// ValidateCreate checks if only one of spec.user and spec.userReference is specified
+// either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+func (p *TopicPermission) ValidateCreate() error {
+	if err := p.validateUser(); err != nil {
+		return err
+	}
+	return p.Spec.RabbitmqClusterReference.ValidateOnCreate(p.GroupResource(), p.Name)
+}
+
+// ValidateUpdate do not allow updates on spec.vhost, spec.user, spec.userReference, spec.permissions.exchange and spec.rabbitmqClusterReference
+// updates on spec.permissions.write and spec.permissions.read are allowed
+// only one of spec.user and spec.userReference can be specified
+func (p *TopicPermission) ValidateUpdate(old runtime.Object) error {
+	oldPermission, ok := old.(*TopicPermission)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected a topic permission but got a %T", old))
+	}
+
+	if err := p.validateUser(); err != nil {
+		return err
+	}
+
+	detailMsg := "updates on exchange, user, userReference, vhost and rabbitmqClusterReference are all forbidden"
+	if p.Spec.Permissions.Exchange != oldPermission.Spec.Permissions.Exchange {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "permissions", "exchange"), detailMsg))
+	}
+
+	if p.Spec.User != oldPermission.Spec.User {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "user"), detailMsg))
+	}
+
+	if userReferenceUpdated(p.Spec.UserReference, oldPermission.Spec.UserReference) {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "userReference"), detailMsg))
+	}
+
+	if p.Spec.Vhost != oldPermission.Spec.Vhost {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "vhost"), detailMsg))
+	}
+
+	if !oldPermission.Spec.RabbitmqClusterReference.Matches(&p.Spec.RabbitmqClusterReference) {
+		return apierrors.NewForbidden(p.GroupResource(), p.Name,
+			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), detailMsg))
+	}
+	return nil
+}
+
+// ValidateDelete no validation on delete
+func (p *TopicPermission) ValidateDelete() error {
+	return nil
+}
+
+// validateUser returns error type 'invalid' if neither or both of spec.user and spec.userReference are specified
+func (p *TopicPermission) validateUser() error {
+	var errorList field.ErrorList
+	if p.Spec.User == "" && p.Spec.UserReference == nil {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "user and userReference"),
+			"must specify either spec.user or spec.userReference"))
+		return apierrors.NewInvalid(GroupVersion.WithKind("TopicPermission").GroupKind(), p.Name, errorList)
+	}
+
+	if p.Spec.User != "" && p.Spec.UserReference != nil {
+		errorList = append(errorList, field.Required(field.NewPath("spec", "user and userReference"),
+			"cannot specify spec.user and spec.userReference at the same time"))
+		return apierrors.NewInvalid(GroupVersion.WithKind("TopicPermission").GroupKind(), p.Name, errorList)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package v1beta1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("topic permission webhook", func() {
+	var topicPermission = TopicPermission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+		Spec: TopicPermissionSpec{
+			User:  "test-user",
+			Vhost: "/a-vhost",
+			Permissions: TopicPermissionConfig{
+				Exchange: "amq.topic",
+				Read:     ".*",
+				Write:    ".*",
+			},
+			RabbitmqClusterReference: RabbitmqClusterReference{
+				Name: "a-cluster",
+			},
+		},
+	}
+
+	Context("ValidateCreate", func() {
+		It("does not allow user and userReference to be specified at the same time", func() {
+			invalidPermission := topicPermission.DeepCopy()
+			invalidPermission.Spec.UserReference = &corev1.LocalObjectReference{Name: "invalid"}
+			invalidPermission.Spec.User = "test-user"
+			Expect(apierrors.IsInvalid(invalidPermission.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow both user and userReference to be unset", func() {
+			invalidPermission := topicPermission.DeepCopy()
+			invalidPermission.Spec.UserReference = nil
+			invalidPermission.Spec.User = ""
+			Expect(apierrors.IsInvalid(invalidPermission.ValidateCreate())).To(BeTrue())
+		})
+
+		It("does not allow both spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret be configured", func() {
+			notAllowed := topicPermission.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = &corev1.LocalObjectReference{Name: "some-secret"}
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		It("spec.rabbitmqClusterReference.name and spec.rabbitmqClusterReference.connectionSecret cannot both be empty", func() {
+			notAllowed := topicPermission.DeepCopy()
+			notAllowed.Spec.RabbitmqClusterReference.Name = ""
+			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
+			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+	})
+
+	Context("ValidateUpdate", func() {
+		It("does not allow updates on user", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.User = "new-user"
+			Expect(apierrors.IsForbidden(newPermission.ValidateUpdate(&topicPermission))).To(BeTrue())
+		})
+
+		It("does not allow updates on userReference", func() {
+			permissionWithUserRef := topicPermission.DeepCopy()
+			permissionWithUserRef.Spec.User = ""
+			permissionWithUserRef.Spec.UserReference = &corev1.LocalObjectReference{Name: "a-user"}
+			newPermission := permissionWithUserRef.DeepCopy()
+			newPermission.Spec.UserReference = &corev1.LocalObjectReference{Name: "a-new-user"}
+			Expect(apierrors.IsForbidden(newPermission.ValidateUpdate(permissionWithUserRef))).To(BeTrue())
+		})
+
+		It("does not allow updates on vhost", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.Vhost = "new-vhost"
+			Expect(apierrors.IsForbidden(newPermission.ValidateUpdate(&topicPermission))).To(BeTrue())
+		})
+
+		It("does not allow updates on exchange", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.Permissions.Exchange = "another-exchange"
+			Expect(apierrors.IsForbidden(newPermission.ValidateUpdate(&topicPermission))).To(BeTrue())
+		})
+
+		It("does not allow updates on RabbitmqClusterReference", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.RabbitmqClusterReference = RabbitmqClusterReference{
+				Name: "new-cluster",
+			}
+			Expect(apierrors.IsForbidden(newPermission.ValidateUpdate(&topicPermission))).To(BeTrue())
+		})
+
+		It("does not allow user and userReference to be specified at the same time", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.UserReference = &corev1.LocalObjectReference{Name: "a-user"}
+			Expect(apierrors.IsInvalid(newPermission.ValidateUpdate(&topicPermission))).To(BeTrue())
+		})
+
+		It("allows updates on topic permission.spec.permissions.write and read", func() {
+			newPermission := topicPermission.DeepCopy()
+			newPermission.Spec.Permissions.Write = "^tenant-a\\..*"
+			newPermission.Spec.Permissions.Read = "^tenant-a\\..*"
+			Expect(newPermission.ValidateUpdate(&topicPermission)).To(Succeed())
+		})
+	})
+})
@@ -10,6 +10,10 @@ This product may include a number of subcomponents with separate copyright notic
 package v1beta1
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,6 +38,104 @@ type UserSpec struct {
 	// Note that this import only occurs at creation time, and is ignored once a password has been set
 	// on a User.
 	ImportCredentialsSecret *corev1.LocalObjectReference `json:"importCredentialsSecret,omitempty"`
+	// Limits to apply to the user, such as the maximum number of connections and channels.
+	// Omitting a limit clears it from the user.
+	// For more information, see https://www.rabbitmq.com/user-limits.html.
+	// +kubebuilder:validation:Optional
+	Limits *UserLimits `json:"limits,omitempty"`
+	// Vhost written to the credentials Secret of the user, together with the host, port and URI of the RabbitmqCluster,
+	// for applications binding to the Secret as a Provisioned Service. Defaults to '/'.
+	// It does not grant any permission to the user in the vhost; see Permission.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default:=/
+	Vhost string `json:"vhost,omitempty"`
+	// Rotation policy of the user password. The password is rotated every interval, and whenever the annotation
+	// 'rabbitmq.com/rotate-password' is set to a new value, e.g. the current date.
+	// The password is only rotated on demand when omitted.
+	// +kubebuilder:validation:Optional
+	PasswordRotation *PasswordRotation `json:"passwordRotation,omitempty"`
+	// Backend storing the generated or imported credentials of the user; defaults to a Kubernetes Secret
+	// named after the user. Cannot be updated.
+	// +kubebuilder:validation:Optional
+	CredentialsBackend *UserCredentialsBackend `json:"credentialsBackend,omitempty"`
+}
+
+// UserCredentialsBackend defines where the credentials of a user are stored.
+type UserCredentialsBackend struct {
+	// Stores the credentials in Vault instead of a Kubernetes Secret.
+	// +kubebuilder:validation:Optional
+	Vault *VaultCredentialsBackend `json:"vault,omitempty"`
+}
+
+// VaultCredentialsBackend stores the credentials of a user in a KV secrets engine version 2 in Vault,
+// with the Vault client of the operator.
+type VaultCredentialsBackend struct {
+	// Path of the secret in Vault, including the 'data' segment of the KV secrets engine version 2,
+	// e.g. 'secret/data/rabbitmq/users/my-user'. The secret holds the keys 'username' and 'password'.
+	// The path must be below the prefix set with OPERATOR_VAULT_USER_CREDENTIALS_PATH_PREFIX on the operator.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// VaultCredentialsBackend returns the Vault backend of the user credentials, or nil if credentials are stored in a Kubernetes Secret
+func (s *UserSpec) VaultCredentialsBackend() *VaultCredentialsBackend {
+	if s.CredentialsBackend == nil {
+		return nil
+	}
+	return s.CredentialsBackend.Vault
+}
+
+// VaultUserCredentialsPathPrefix is the Vault path below which users can store their credentials, e.g. 'secret/data/rabbitmq/users'.
+// It is set by the operator from the environment variable OPERATOR_VAULT_USER_CREDENTIALS_PATH_PREFIX;
+// when empty, credentials cannot be stored in Vault.
+var VaultUserCredentialsPathPrefix string
+
+// ValidateVaultCredentialsPath returns an error unless path is below prefix, so that the Vault client of the operator
+// does not read or write secrets of others, such as the credentials of the default user of a cluster
+func ValidateVaultCredentialsPath(path, prefix string) error {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return fmt.Errorf("credentials of users cannot be stored in Vault: OPERATOR_VAULT_USER_CREDENTIALS_PATH_PREFIX is not set on the operator")
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return fmt.Errorf("vault path '%s' must not contain empty, '.' or '..' segments", path)
+		}
+	}
+	if !strings.HasPrefix(path, prefix+"/") {
+		return fmt.Errorf("vault path '%s' must be below '%s'", path, prefix)
+	}
+	return nil
+}
+
+// AnnotationRotatePassword triggers a rotation of the user password whenever it is set to a new value
+const AnnotationRotatePassword = "rabbitmq.com/rotate-password"
+
+// PasswordRotation defines how often the password of a user is rotated.
+// Rotating the password updates the password in RabbitMQ, then the credentials Secret of the user.
+// There is no grace period, as RabbitMQ stores a single password per user: connections opened with the previous password stay open,
+// but new connections must use the new password.
+type PasswordRotation struct {
+	// Interval between two password rotations, e.g. '2160h' for 90 days.
+	// +kubebuilder:validation:Required
+	Interval metav1.Duration `json:"interval"`
+}
+
+// Due returns when the password is due for rotation, given when it was last rotated
+func (p *PasswordRotation) Due(lastRotation time.Time) time.Time {
+	return lastRotation.Add(p.Interval.Duration)
+}
+
+// UserLimits defines the limits that can be applied to a user.
+// A negative value means no limit.
+type UserLimits struct {
+	// Maximum number of connections the user can open.
+	// +kubebuilder:validation:Optional
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+	// Maximum number of channels the user can open, across all of its connections.
+	// +kubebuilder:validation:Optional
+	MaxChannels *int32 `json:"maxChannels,omitempty"`
 }
 
 // UserStatus defines the observed state of User.
@@ -44,8 +146,14 @@ type UserStatus struct {
 	Conditions         []Condition `json:"conditions,omitempty"`
 	// Provides a reference to a Secret object containing the user credentials.
 	Credentials *corev1.LocalObjectReference `json:"credentials,omitempty"`
+	// Path of the secret containing the user credentials in Vault, when credentials are stored in Vault.
+	CredentialsVaultPath string `json:"credentialsVaultPath,omitempty"`
 	// Provide rabbitmq Username
 	Username string `json:"username"`
+	// Time of the last password rotation.
+	LastPasswordRotation *metav1.Time `json:"lastPasswordRotation,omitempty"`
+	// Value of the annotation 'rabbitmq.com/rotate-password' at the last on-demand password rotation.
+	LastPasswordRotationRequest string `json:"lastPasswordRotationRequest,omitempty"`
 }
 
 // UserTag defines the level of access to the management UI allocated to the user.
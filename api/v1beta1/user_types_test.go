@@ -40,6 +40,34 @@ var _ = Describe("user spec", func() {
 		Expect(len(fetcheduser.Spec.Tags)).To(Equal(0))
 	})
 
+	It("creates a user with limits configured", func() {
+		maxConnections := int32(5)
+		maxChannels := int32(20)
+		user := User{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-user-with-limits",
+				Namespace: namespace,
+			},
+			Spec: UserSpec{
+				Limits: &UserLimits{
+					MaxConnections: &maxConnections,
+					MaxChannels:    &maxChannels,
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "some-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &user)).To(Succeed())
+		fetcheduser := &User{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      user.Name,
+			Namespace: user.Namespace,
+		}, fetcheduser)).To(Succeed())
+		Expect(*fetcheduser.Spec.Limits.MaxConnections).To(Equal(int32(5)))
+		Expect(*fetcheduser.Spec.Limits.MaxChannels).To(Equal(int32(20)))
+	})
+
 	When("creating a user with configuration", func() {
 		var tags []UserTag
 		var user User
@@ -2,6 +2,9 @@ package v1beta1
 
 import (
 	"fmt"
+	"reflect"
+	"time"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -21,11 +24,19 @@ var _ webhook.Validator = &User{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
 // either rabbitmqClusterReference.name or rabbitmqClusterReference.connectionSecret must be provided but not both
+// passwordRotation.interval must be at least one minute
+// credentialsBackend.vault.path must be below the prefix configured on the operator
 func (u *User) ValidateCreate() error {
-	return u.Spec.RabbitmqClusterReference.ValidateOnCreate(u.GroupResource(), u.Name)
+	if err := u.Spec.RabbitmqClusterReference.ValidateOnCreate(u.GroupResource(), u.Name); err != nil {
+		return err
+	}
+	if err := u.validateVaultCredentialsPath(); err != nil {
+		return err
+	}
+	return u.validatePasswordRotation()
 }
 
-// ValidateUpdate returns error type 'forbidden' for updates on rabbitmqClusterReference
+// ValidateUpdate returns error type 'forbidden' for updates on rabbitmqClusterReference and credentialsBackend
 // user.spec.tags can be updated
 func (u *User) ValidateUpdate(old runtime.Object) error {
 	oldUser, ok := old.(*User)
@@ -37,9 +48,35 @@ func (u *User) ValidateUpdate(old runtime.Object) error {
 		return apierrors.NewForbidden(u.GroupResource(), u.Name,
 			field.Forbidden(field.NewPath("spec", "rabbitmqClusterReference"), "update on rabbitmqClusterReference is forbidden"))
 	}
+
+	if !reflect.DeepEqual(oldUser.Spec.CredentialsBackend, u.Spec.CredentialsBackend) {
+		return apierrors.NewForbidden(u.GroupResource(), u.Name,
+			field.Forbidden(field.NewPath("spec", "credentialsBackend"), "update on credentialsBackend is forbidden"))
+	}
+	return u.validatePasswordRotation()
+}
+
+func (u *User) validateVaultCredentialsPath() error {
+	vaultBackend := u.Spec.VaultCredentialsBackend()
+	if vaultBackend == nil {
+		return nil
+	}
+	if err := ValidateVaultCredentialsPath(vaultBackend.Path, VaultUserCredentialsPathPrefix); err != nil {
+		return apierrors.NewForbidden(u.GroupResource(), u.Name,
+			field.Forbidden(field.NewPath("spec", "credentialsBackend", "vault", "path"), err.Error()))
+	}
 	return nil
 }
 
+func (u *User) validatePasswordRotation() error {
+	if u.Spec.PasswordRotation == nil || u.Spec.PasswordRotation.Interval.Duration >= time.Minute {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("User").GroupKind(), u.Name, field.ErrorList{
+		field.Invalid(field.NewPath("spec", "passwordRotation", "interval"), u.Spec.PasswordRotation.Interval.Duration.String(), "must be at least 1m"),
+	})
+}
+
 // no validation on delete
 func (u *User) ValidateDelete() error {
 	return nil
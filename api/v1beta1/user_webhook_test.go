@@ -1,6 +1,8 @@
 package v1beta1
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
@@ -34,6 +36,55 @@ var _ = Describe("user webhook", func() {
 			notAllowed.Spec.RabbitmqClusterReference.ConnectionSecret = nil
 			Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
 		})
+
+		It("allows a password rotation interval of at least one minute", func() {
+			allowed := user.DeepCopy()
+			allowed.Spec.PasswordRotation = &PasswordRotation{Interval: metav1.Duration{Duration: 2160 * time.Hour}}
+			Expect(allowed.ValidateCreate()).To(Succeed())
+		})
+
+		It("does not allow a password rotation interval shorter than one minute", func() {
+			notAllowed := user.DeepCopy()
+			notAllowed.Spec.PasswordRotation = &PasswordRotation{Interval: metav1.Duration{Duration: time.Second}}
+			Expect(apierrors.IsInvalid(notAllowed.ValidateCreate())).To(BeTrue())
+		})
+
+		When("credentials are stored in Vault", func() {
+			BeforeEach(func() {
+				VaultUserCredentialsPathPrefix = "secret/data/rabbitmq/users/"
+			})
+
+			AfterEach(func() {
+				VaultUserCredentialsPathPrefix = ""
+			})
+
+			It("allows a path below the configured prefix", func() {
+				allowed := user.DeepCopy()
+				allowed.Spec.CredentialsBackend = &UserCredentialsBackend{Vault: &VaultCredentialsBackend{Path: "secret/data/rabbitmq/users/a-user"}}
+				Expect(allowed.ValidateCreate()).To(Succeed())
+			})
+
+			It("does not allow a path outside of the configured prefix", func() {
+				notAllowed := user.DeepCopy()
+				notAllowed.Spec.CredentialsBackend = &UserCredentialsBackend{Vault: &VaultCredentialsBackend{Path: "secret/data/rabbitmq/default-user"}}
+				Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+				notAllowed.Spec.CredentialsBackend.Vault.Path = "secret/data/rabbitmq/users-other/a-user"
+				Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+			})
+
+			It("does not allow a path which escapes the configured prefix", func() {
+				notAllowed := user.DeepCopy()
+				notAllowed.Spec.CredentialsBackend = &UserCredentialsBackend{Vault: &VaultCredentialsBackend{Path: "secret/data/rabbitmq/users/../default-user"}}
+				Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+			})
+
+			It("does not allow any path when no prefix is configured", func() {
+				VaultUserCredentialsPathPrefix = ""
+				notAllowed := user.DeepCopy()
+				notAllowed.Spec.CredentialsBackend = &UserCredentialsBackend{Vault: &VaultCredentialsBackend{Path: "secret/data/rabbitmq/users/a-user"}}
+				Expect(apierrors.IsForbidden(notAllowed.ValidateCreate())).To(BeTrue())
+			})
+		})
 	})
 
 	Context("ValidateUpdate", func() {
@@ -70,5 +121,23 @@ var _ = Describe("user webhook", func() {
 			newUser.Spec.Tags = []UserTag{"monitoring"}
 			Expect(newUser.ValidateUpdate(&user)).To(Succeed())
 		})
+
+		It("does not allow updates on credentialsBackend", func() {
+			newUser := user.DeepCopy()
+			newUser.Spec.CredentialsBackend = &UserCredentialsBackend{Vault: &VaultCredentialsBackend{Path: "secret/data/a-user"}}
+			Expect(apierrors.IsForbidden(newUser.ValidateUpdate(&user))).To(BeTrue())
+		})
+
+		It("allows update on passwordRotation", func() {
+			newUser := user.DeepCopy()
+			newUser.Spec.PasswordRotation = &PasswordRotation{Interval: metav1.Duration{Duration: 24 * time.Hour}}
+			Expect(newUser.ValidateUpdate(&user)).To(Succeed())
+		})
+
+		It("does not allow a password rotation interval shorter than one minute", func() {
+			newUser := user.DeepCopy()
+			newUser.Spec.PasswordRotation = &PasswordRotation{}
+			Expect(apierrors.IsInvalid(newUser.ValidateUpdate(&user))).To(BeTrue())
+		})
 	})
 })
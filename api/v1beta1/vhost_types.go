@@ -21,18 +21,36 @@ type VhostSpec struct {
 	Name    string   `json:"name"`
 	Tracing bool     `json:"tracing,omitempty"`
 	Tags    []string `json:"tags,omitempty"`
+	// Limits defines limits to be applied to the vhost.
+	// Supported limits are maxConnections and maxQueues; unset limits are cleared from the vhost.
+	// See https://www.rabbitmq.com/vhosts.html#limits
+	// +kubebuilder:validation:Optional
+	Limits *VhostLimits `json:"limits,omitempty"`
 	// Reference to the RabbitmqCluster that the vhost will be created in.
 	// Required property.
 	// +kubebuilder:validation:Required
 	RabbitmqClusterReference RabbitmqClusterReference `json:"rabbitmqClusterReference"`
 }
 
+// Limits that can be applied to a vhost.
+// A negative value means no limit.
+type VhostLimits struct {
+	// Maximum number of concurrent client connections to the vhost.
+	// +kubebuilder:validation:Optional
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+	// Maximum number of queues that can be declared in the vhost.
+	// +kubebuilder:validation:Optional
+	MaxQueues *int32 `json:"maxQueues,omitempty"`
+}
+
 // VhostStatus defines the observed state of Vhost
 type VhostStatus struct {
 	// observedGeneration is the most recent successful generation observed for this Vhost. It corresponds to the
 	// Vhost's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
 	Conditions         []Condition `json:"conditions,omitempty"`
+	// Limits currently in effect for the vhost on the RabbitMQ server.
+	Limits *VhostLimits `json:"limits,omitempty"`
 }
 
 // +genclient
@@ -99,4 +99,34 @@ var _ = Describe("Vhost", func() {
 			Name: "random-cluster",
 		}))
 	})
+
+	It("creates a vhost with limits configured", func() {
+		maxConnections := int32(100)
+		maxQueues := int32(0)
+		vhost := Vhost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vhost-with-limits",
+				Namespace: namespace,
+			},
+			Spec: VhostSpec{
+				Name: "vhost-with-limits",
+				Limits: &VhostLimits{
+					MaxConnections: &maxConnections,
+					MaxQueues:      &maxQueues,
+				},
+				RabbitmqClusterReference: RabbitmqClusterReference{
+					Name: "random-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, &vhost)).To(Succeed())
+		fetched := &Vhost{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{
+			Name:      vhost.Name,
+			Namespace: vhost.Namespace,
+		}, fetched)).To(Succeed())
+
+		Expect(*fetched.Spec.Limits.MaxConnections).To(Equal(int32(100)))
+		Expect(*fetched.Spec.Limits.MaxQueues).To(Equal(int32(0)))
+	})
 })
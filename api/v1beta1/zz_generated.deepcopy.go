@@ -121,6 +121,22 @@ func (in *BindingStatus) DeepCopy() *BindingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterEndpoint) DeepCopyInto(out *ClusterEndpoint) {
+	*out = *in
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterEndpoint.
+func (in *ClusterEndpoint) DeepCopy() *ClusterEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Condition) DeepCopyInto(out *Condition) {
 	*out = *in
@@ -137,6 +153,143 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Definitions) DeepCopyInto(out *Definitions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Definitions.
+func (in *Definitions) DeepCopy() *Definitions {
+	if in == nil {
+		return nil
+	}
+	out := new(Definitions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Definitions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefinitionsList) DeepCopyInto(out *DefinitionsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Definitions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefinitionsList.
+func (in *DefinitionsList) DeepCopy() *DefinitionsList {
+	if in == nil {
+		return nil
+	}
+	out := new(DefinitionsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DefinitionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefinitionsObject) DeepCopyInto(out *DefinitionsObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefinitionsObject.
+func (in *DefinitionsObject) DeepCopy() *DefinitionsObject {
+	if in == nil {
+		return nil
+	}
+	out := new(DefinitionsObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefinitionsSpec) DeepCopyInto(out *DefinitionsSpec) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefinitionsSpec.
+func (in *DefinitionsSpec) DeepCopy() *DefinitionsSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DefinitionsSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefinitionsStatus) DeepCopyInto(out *DefinitionsStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = make([]DefinitionsObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.AlreadyExisted != nil {
+		in, out := &in.AlreadyExisted, &out.AlreadyExisted
+		*out = make([]DefinitionsObject, len(*in))
+		copy(*out, *in)
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = make([]DefinitionsObject, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefinitionsStatus.
+func (in *DefinitionsStatus) DeepCopy() *DefinitionsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DefinitionsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Exchange) DeepCopyInto(out *Exchange) {
 	*out = *in
@@ -266,6 +419,21 @@ func (in *Federation) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederationLink) DeepCopyInto(out *FederationLink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationLink.
+func (in *FederationLink) DeepCopy() *FederationLink {
+	if in == nil {
+		return nil
+	}
+	out := new(FederationLink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FederationList) DeepCopyInto(out *FederationList) {
 	*out = *in
@@ -307,6 +475,11 @@ func (in *FederationSpec) DeepCopyInto(out *FederationSpec) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.UpstreamCluster != nil {
+		in, out := &in.UpstreamCluster, &out.UpstreamCluster
+		*out = new(ClusterEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationSpec.
@@ -329,6 +502,11 @@ func (in *FederationStatus) DeepCopyInto(out *FederationStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Links != nil {
+		in, out := &in.Links, &out.Links
+		*out = make([]FederationLink, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederationStatus.
@@ -341,6 +519,124 @@ func (in *FederationStatus) DeepCopy() *FederationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorPolicy) DeepCopyInto(out *OperatorPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorPolicy.
+func (in *OperatorPolicy) DeepCopy() *OperatorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorPolicyList) DeepCopyInto(out *OperatorPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OperatorPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorPolicyList.
+func (in *OperatorPolicyList) DeepCopy() *OperatorPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OperatorPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorPolicySpec) DeepCopyInto(out *OperatorPolicySpec) {
+	*out = *in
+	if in.Definition != nil {
+		in, out := &in.Definition, &out.Definition
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorPolicySpec.
+func (in *OperatorPolicySpec) DeepCopy() *OperatorPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatorPolicyStatus) DeepCopyInto(out *OperatorPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatorPolicyStatus.
+func (in *OperatorPolicyStatus) DeepCopy() *OperatorPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatorPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotation) DeepCopyInto(out *PasswordRotation) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotation.
+func (in *PasswordRotation) DeepCopy() *PasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Permission) DeepCopyInto(out *Permission) {
 	*out = *in
@@ -613,6 +909,26 @@ func (in *QueueSpec) DeepCopyInto(out *QueueSpec) {
 		*out = new(runtime.RawExtension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MessageTTL != nil {
+		in, out := &in.MessageTTL, &out.MessageTTL
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxLength != nil {
+		in, out := &in.MaxLength, &out.MaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DeliveryLimit != nil {
+		in, out := &in.DeliveryLimit, &out.DeliveryLimit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(StreamSettings)
+		**out = **in
+	}
 	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
 }
 
@@ -668,6 +984,108 @@ func (in *RabbitmqClusterReference) DeepCopy() *RabbitmqClusterReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeParameter) DeepCopyInto(out *RuntimeParameter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeParameter.
+func (in *RuntimeParameter) DeepCopy() *RuntimeParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuntimeParameter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeParameterList) DeepCopyInto(out *RuntimeParameterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RuntimeParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeParameterList.
+func (in *RuntimeParameterList) DeepCopy() *RuntimeParameterList {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeParameterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RuntimeParameterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeParameterSpec) DeepCopyInto(out *RuntimeParameterSpec) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeParameterSpec.
+func (in *RuntimeParameterSpec) DeepCopy() *RuntimeParameterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeParameterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeParameterStatus) DeepCopyInto(out *RuntimeParameterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeParameterStatus.
+func (in *RuntimeParameterStatus) DeepCopy() *RuntimeParameterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeParameterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SchemaReplication) DeepCopyInto(out *SchemaReplication) {
 	*out = *in
@@ -859,6 +1277,16 @@ func (in *ShovelSpec) DeepCopyInto(out *ShovelSpec) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.SourceCluster != nil {
+		in, out := &in.SourceCluster, &out.SourceCluster
+		*out = new(ClusterEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationCluster != nil {
+		in, out := &in.DestinationCluster, &out.DestinationCluster
+		*out = new(ClusterEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShovelSpec.
@@ -893,6 +1321,139 @@ func (in *ShovelStatus) DeepCopy() *ShovelStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StreamSettings) DeepCopyInto(out *StreamSettings) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StreamSettings.
+func (in *StreamSettings) DeepCopy() *StreamSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(StreamSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicPermission) DeepCopyInto(out *TopicPermission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicPermission.
+func (in *TopicPermission) DeepCopy() *TopicPermission {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicPermission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopicPermission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicPermissionConfig) DeepCopyInto(out *TopicPermissionConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicPermissionConfig.
+func (in *TopicPermissionConfig) DeepCopy() *TopicPermissionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicPermissionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicPermissionList) DeepCopyInto(out *TopicPermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TopicPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicPermissionList.
+func (in *TopicPermissionList) DeepCopy() *TopicPermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicPermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TopicPermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicPermissionSpec) DeepCopyInto(out *TopicPermissionSpec) {
+	*out = *in
+	if in.UserReference != nil {
+		in, out := &in.UserReference, &out.UserReference
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	out.Permissions = in.Permissions
+	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicPermissionSpec.
+func (in *TopicPermissionSpec) DeepCopy() *TopicPermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicPermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TopicPermissionStatus) DeepCopyInto(out *TopicPermissionStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TopicPermissionStatus.
+func (in *TopicPermissionStatus) DeepCopy() *TopicPermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TopicPermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -920,6 +1481,51 @@ func (in *User) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserCredentialsBackend) DeepCopyInto(out *UserCredentialsBackend) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultCredentialsBackend)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserCredentialsBackend.
+func (in *UserCredentialsBackend) DeepCopy() *UserCredentialsBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(UserCredentialsBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserLimits) DeepCopyInto(out *UserLimits) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxChannels != nil {
+		in, out := &in.MaxChannels, &out.MaxChannels
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserLimits.
+func (in *UserLimits) DeepCopy() *UserLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(UserLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserList) DeepCopyInto(out *UserList) {
 	*out = *in
@@ -966,6 +1572,21 @@ func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(UserLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotation)
+		**out = **in
+	}
+	if in.CredentialsBackend != nil {
+		in, out := &in.CredentialsBackend, &out.CredentialsBackend
+		*out = new(UserCredentialsBackend)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
@@ -993,6 +1614,10 @@ func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
+	if in.LastPasswordRotation != nil {
+		in, out := &in.LastPasswordRotation, &out.LastPasswordRotation
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
@@ -1005,6 +1630,21 @@ func (in *UserStatus) DeepCopy() *UserStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultCredentialsBackend) DeepCopyInto(out *VaultCredentialsBackend) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultCredentialsBackend.
+func (in *VaultCredentialsBackend) DeepCopy() *VaultCredentialsBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultCredentialsBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultSpec) DeepCopyInto(out *VaultSpec) {
 	*out = *in
@@ -1047,6 +1687,31 @@ func (in *Vhost) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostLimits) DeepCopyInto(out *VhostLimits) {
+	*out = *in
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxQueues != nil {
+		in, out := &in.MaxQueues, &out.MaxQueues
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostLimits.
+func (in *VhostLimits) DeepCopy() *VhostLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VhostList) DeepCopyInto(out *VhostList) {
 	*out = *in
@@ -1102,6 +1767,11 @@ func (in *VhostSpec) DeepCopyInto(out *VhostSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(VhostLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	in.RabbitmqClusterReference.DeepCopyInto(&out.RabbitmqClusterReference)
 }
 
@@ -1125,6 +1795,11 @@ func (in *VhostStatus) DeepCopyInto(out *VhostStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = new(VhostLimits)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostStatus.
@@ -0,0 +1,111 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var errClusterEndpointCredentialsNotReady = errors.New("credentials of the user provisioned for the endpoint are not generated yet")
+
+// clusterEndpointURI provisions a user with permissions in the vhost of a ClusterEndpoint, and returns the AMQP URI of the vhost
+// with the credentials of the user. The User and Permission are children of owner, named after owner and endpointName, e.g. '-upstream'.
+// It returns errClusterEndpointCredentialsNotReady until the User controller has generated the credentials of the user.
+func clusterEndpointURI(ctx context.Context, c client.Client, scheme *runtime.Scheme, owner client.Object, endpointName string,
+	endpoint *topology.ClusterEndpoint, permissions topology.VhostPermissions, clusterDomain string) (string, error) {
+	rmqClusterRef, err := childRabbitmqClusterReference(ctx, c, endpoint.RabbitmqClusterReference, owner.GetNamespace())
+	if err != nil {
+		return "", err
+	}
+
+	vhost := endpoint.Vhost
+	if vhost == "" {
+		vhost = "/"
+	}
+
+	managedResourceBuilder := managedresource.Builder{
+		ObjectOwner: owner,
+		Scheme:      scheme,
+	}
+	builders := []managedresource.ResourceBuilder{
+		managedResourceBuilder.ClusterEndpointUser(endpointName, rmqClusterRef),
+		managedResourceBuilder.ClusterEndpointPermission(endpointName, vhost, permissions, rmqClusterRef),
+	}
+	var user *topology.User
+	for _, builder := range builders {
+		resource, err := builder.Build()
+		if err != nil {
+			return "", err
+		}
+		if err := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			_, apiError := controllerutil.CreateOrUpdate(ctx, c, resource, func() error {
+				return builder.Update(resource)
+			})
+			return apiError
+		}); err != nil {
+			return "", fmt.Errorf("failed to declare %s of endpoint: %w", builder.ResourceType(), err)
+		}
+		if u, ok := resource.(*topology.User); ok {
+			user = u
+		}
+	}
+
+	if user.Status.Credentials == nil {
+		return "", errClusterEndpointCredentialsNotReady
+	}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Name: user.Status.Credentials.Name, Namespace: user.Namespace}, secret); err != nil {
+		return "", err
+	}
+
+	amqpEndpoint, err := rabbitmqclient.AMQPEndpoint(ctx, c, endpoint.RabbitmqClusterReference, owner.GetNamespace(), clusterDomain)
+	if err != nil {
+		return "", err
+	}
+	return internal.GenerateAMQPURI(amqpEndpoint, string(secret.Data["username"]), string(secret.Data["password"]), vhost)
+}
+
+// clusterEndpointOwners maps the credentials Secret of a user provisioned for a ClusterEndpoint to the owner of the user, of kind ownerKind,
+// so that the URI of the endpoint is updated when the credentials of the user change
+func clusterEndpointOwners(c client.Client, ownerKind string) handler.MapFunc {
+	return func(object client.Object) []reconcile.Request {
+		secretOwner := metav1.GetControllerOf(object)
+		if secretOwner == nil || secretOwner.Kind != "User" {
+			return nil
+		}
+		user := &topology.User{}
+		if err := c.Get(context.Background(), types.NamespacedName{Name: secretOwner.Name, Namespace: object.GetNamespace()}, user); err != nil {
+			return nil
+		}
+		userOwner := metav1.GetControllerOf(user)
+		if userOwner == nil || userOwner.Kind != ownerKind {
+			return nil
+		}
+		return []reconcile.Request{{
+			NamespacedName: types.NamespacedName{Name: userOwner.Name, Namespace: user.Namespace},
+		}}
+	}
+}
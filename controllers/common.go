@@ -21,25 +21,34 @@ const (
 
 // names for each of the controllers
 const (
-	VhostControllerName             = "vhost-controller"
-	QueueControllerName             = "queue-controller"
-	ExchangeControllerName          = "exchange-controller"
-	BindingControllerName           = "binding-controller"
-	UserControllerName              = "user-controller"
-	PolicyControllerName            = "policy-controller"
-	PermissionControllerName        = "permission-controller"
-	SchemaReplicationControllerName = "schema-replication-controller"
-	FederationControllerName        = "federation-controller"
-	ShovelControllerName            = "shovel-controller"
-	SuperStreamControllerName       = "super-stream-controller"
+	VhostControllerName                = "vhost-controller"
+	QueueControllerName                = "queue-controller"
+	ExchangeControllerName             = "exchange-controller"
+	BindingControllerName              = "binding-controller"
+	UserControllerName                 = "user-controller"
+	PolicyControllerName               = "policy-controller"
+	OperatorPolicyControllerName       = "operator-policy-controller"
+	PermissionControllerName           = "permission-controller"
+	TopicPermissionControllerName      = "topic-permission-controller"
+	SchemaReplicationControllerName    = "schema-replication-controller"
+	FederationControllerName           = "federation-controller"
+	ShovelControllerName               = "shovel-controller"
+	SuperStreamControllerName          = "super-stream-controller"
+	RuntimeParameterControllerName     = "runtime-parameter-controller"
+	DefinitionsControllerName          = "definitions-controller"
+	DeadLetterTopologyControllerName   = "dead-letter-topology-controller"
+	RetryTopologyControllerName        = "retry-topology-controller"
+	MessagingApplicationControllerName = "messaging-application-controller"
+	SuperStreamConsumerControllerName  = "super-stream-consumer-controller"
 )
 
 // names for environment variables
 const (
-	KubernetesInternalDomainEnvVar = "MESSAGING_DOMAIN_NAME"
-	OperatorNamespaceEnvVar        = "OPERATOR_NAMESPACE"
-	EnableWebhooksEnvVar           = "ENABLE_WEBHOOKS"
-	ControllerSyncPeriodEnvVar     = "SYNC_PERIOD"
+	KubernetesInternalDomainEnvVar       = "MESSAGING_DOMAIN_NAME"
+	OperatorNamespaceEnvVar              = "OPERATOR_NAMESPACE"
+	EnableWebhooksEnvVar                 = "ENABLE_WEBHOOKS"
+	ControllerSyncPeriodEnvVar           = "SYNC_PERIOD"
+	VaultUserCredentialsPathPrefixEnvVar = "OPERATOR_VAULT_USER_CREDENTIALS_PATH_PREFIX"
 )
 
 type TopologyController interface {
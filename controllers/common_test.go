@@ -2,6 +2,7 @@ package controllers_test
 
 import (
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -85,6 +86,44 @@ var _ = Describe("Controllers/Common", func() {
 				ObjectMeta: metav1.ObjectMeta{Name: "some-super-stream", Namespace: "default"},
 				Spec:       topologyV1alpha.SuperStreamSpec{RabbitmqClusterReference: commonRabbitmqClusterRef},
 			},
+			&topology.OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-operator-policy", Namespace: "default"},
+				Spec: topology.OperatorPolicySpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					Definition: &runtime.RawExtension{
+						Raw: []byte(`{"max-length":10}`),
+					}},
+			},
+			&topology.TopicPermission{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-topic-permission", Namespace: "default"},
+				Spec:       topology.TopicPermissionSpec{RabbitmqClusterReference: commonRabbitmqClusterRef},
+			},
+			&topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-runtime-parameter", Namespace: "default"},
+				Spec: topology.RuntimeParameterSpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					Value: &runtime.RawExtension{
+						Raw: []byte(`"some-value"`),
+					}},
+			},
+			&topology.Definitions{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-definitions", Namespace: "default"},
+				Spec: topology.DefinitionsSpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					ConfigMap: &corev1.LocalObjectReference{Name: "some-definitions"}},
+			},
+			&topologyV1alpha.DeadLetterTopology{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-dead-letter-topology", Namespace: "default"},
+				Spec: topologyV1alpha.DeadLetterTopologySpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					Name: "some-work-queue"},
+			},
+			&topologyV1alpha.RetryTopology{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-retry-topology", Namespace: "default"},
+				Spec: topologyV1alpha.RetryTopologySpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					Name: "some-retried-queue", Delays: []metav1.Duration{{Duration: time.Second}}},
+			},
+			&topologyV1alpha.MessagingApplication{
+				ObjectMeta: metav1.ObjectMeta{Name: "some-messaging-application", Namespace: "default"},
+				Spec: topologyV1alpha.MessagingApplicationSpec{RabbitmqClusterReference: commonRabbitmqClusterRef,
+					Vhost: "some-application-vhost"},
+			},
 		}
 
 		fakeRabbitMQClient.DeclareBindingReturns(commonHttpCreatedResponse, nil)
@@ -107,6 +146,12 @@ var _ = Describe("Controllers/Common", func() {
 		fakeRabbitMQClient.DeleteFederationUpstreamReturns(commonHttpDeletedResponse, nil)
 		fakeRabbitMQClient.DeclareShovelReturns(commonHttpCreatedResponse, nil)
 		fakeRabbitMQClient.DeleteShovelReturns(commonHttpDeletedResponse, nil)
+		fakeRabbitMQClient.PutOperatorPolicyReturns(commonHttpCreatedResponse, nil)
+		fakeRabbitMQClient.DeleteOperatorPolicyReturns(commonHttpDeletedResponse, nil)
+		fakeRabbitMQClient.UpdateTopicPermissionsInReturns(commonHttpCreatedResponse, nil)
+		fakeRabbitMQClient.DeleteTopicPermissionsInReturns(commonHttpDeletedResponse, nil)
+		fakeRabbitMQClient.PutRuntimeParameterReturns(commonHttpCreatedResponse, nil)
+		fakeRabbitMQClient.DeleteRuntimeParameterReturns(commonHttpDeletedResponse, nil)
 	})
 
 	It("sets the domain name in the URI to connect to RabbitMQ", func() {
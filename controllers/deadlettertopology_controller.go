@@ -0,0 +1,158 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DeadLetterTopologyReconciler reconciles a DeadLetterTopology, and the queues, exchange, binding and policies it comprises of
+type DeadLetterTopologyReconciler struct {
+	client.Client
+	Log                      logr.Logger
+	Scheme                   *runtime.Scheme
+	Recorder                 record.EventRecorder
+	RabbitmqClientFactory    rabbitmqclient.Factory
+	KubernetesInternalDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=exchanges,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=queues,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=bindings,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=policies,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=deadlettertopologies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=deadlettertopologies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=deadlettertopologies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;create;patch
+
+func (r *DeadLetterTopologyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	deadLetterTopology := &topologyv1alpha1.DeadLetterTopology{}
+	if err := r.Get(ctx, req.NamespacedName, deadLetterTopology); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	rmqClusterRef, err := childRabbitmqClusterReference(ctx, r.Client, deadLetterTopology.Spec.RabbitmqClusterReference, deadLetterTopology.Namespace)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, deadLetterTopology, &deadLetterTopology.Status.Conditions, err)
+	}
+
+	logger.Info("Start reconciling")
+
+	// Each DeadLetterTopology generates a work queue, a dead letter exchange, a dead letter queue and a binding
+	// In mode 'policy', dead lettering settings are declared in policies instead of queue arguments
+	managedResourceBuilder := managedresource.Builder{
+		ObjectOwner: deadLetterTopology,
+		Scheme:      r.Scheme,
+	}
+	spec := &deadLetterTopology.Spec
+	builders := []managedresource.ResourceBuilder{
+		managedResourceBuilder.DeadLetterExchange(spec, rmqClusterRef),
+		managedResourceBuilder.DeadLetterQueue(spec, rmqClusterRef),
+		managedResourceBuilder.DeadLetterBinding(spec, rmqClusterRef),
+		managedResourceBuilder.DeadLetterWorkQueue(spec, rmqClusterRef),
+	}
+	var staleBuilders []managedresource.ResourceBuilder
+	if spec.Mode == topologyv1alpha1.DeadLetterModePolicy {
+		builders = append(builders, managedResourceBuilder.DeadLetterPolicy(spec, rmqClusterRef))
+		if spec.DeadLetterQueueTTL != nil {
+			builders = append(builders, managedResourceBuilder.DeadLetterQueuePolicy(spec, rmqClusterRef))
+		} else {
+			staleBuilders = append(staleBuilders, managedResourceBuilder.DeadLetterQueuePolicy(spec, rmqClusterRef))
+		}
+	}
+
+	var resources []topologyv1alpha1.ChildResourceStatus
+	for _, builder := range builders {
+		resource, err := builder.Build()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		err = clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			var apiError error
+			_, apiError = controllerutil.CreateOrUpdate(ctx, r.Client, resource, func() error {
+				return builder.Update(resource)
+			})
+			return apiError
+		})
+		if err != nil {
+			msg := fmt.Sprintf("FailedReconcile%s", builder.ResourceType())
+			if writerErr := r.SetReconcileSuccess(ctx, deadLetterTopology, topology.NotReady(msg, deadLetterTopology.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", deadLetterTopology.Status)
+			}
+			return ctrl.Result{}, err
+		}
+
+		resources = append(resources, childResourceReadiness(resource))
+	}
+
+	for _, builder := range staleBuilders {
+		resource, err := builder.Build()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.Delete(ctx, resource); client.IgnoreNotFound(err) != nil {
+			msg := fmt.Sprintf("FailedDelete%s", builder.ResourceType())
+			if writerErr := r.SetReconcileSuccess(ctx, deadLetterTopology, topology.NotReady(msg, deadLetterTopology.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", deadLetterTopology.Status)
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	deadLetterTopology.Status.Resources = resources
+	if err := r.SetReconcileSuccess(ctx, deadLetterTopology, aggregateReadiness(resources, deadLetterTopology.Status.Conditions)); err != nil {
+		logger.Error(err, failedStatusUpdate)
+	}
+
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DeadLetterTopologyReconciler) SetReconcileSuccess(ctx context.Context, deadLetterTopology *topologyv1alpha1.DeadLetterTopology, condition topology.Condition) error {
+	deadLetterTopology.Status.Conditions = []topology.Condition{condition}
+	deadLetterTopology.Status.ObservedGeneration = deadLetterTopology.GetGeneration()
+	return clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, deadLetterTopology)
+	})
+}
+
+func (r *DeadLetterTopologyReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesInternalDomain = domainName
+}
+
+func (r *DeadLetterTopologyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topologyv1alpha1.DeadLetterTopology{}).
+		Owns(&topology.Exchange{}).
+		Owns(&topology.Binding{}).
+		Owns(&topology.Queue{}).
+		Owns(&topology.Policy{}).
+		Complete(r)
+}
@@ -0,0 +1,245 @@
+package controllers_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("dead-letter-topology-controller", func() {
+
+	var deadLetterTopology topologyv1alpha1.DeadLetterTopology
+	var deadLetterTopologyName string
+	var mode string
+
+	fetchConditions := func() []topology.Condition {
+		_ = client.Get(
+			ctx,
+			types.NamespacedName{Name: deadLetterTopologyName, Namespace: "default"},
+			&deadLetterTopology,
+		)
+		return deadLetterTopology.Status.Conditions
+	}
+
+	BeforeEach(func() {
+		mode = topologyv1alpha1.DeadLetterModeArguments
+	})
+
+	JustBeforeEach(func() {
+		fakeRabbitMQClient.DeclareExchangeReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareQueueReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareBindingReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.PutPolicyReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeletePolicyReturns(&http.Response{
+			Status:     "204 No Content",
+			StatusCode: http.StatusNoContent,
+		}, nil)
+		deadLetterTopology = topologyv1alpha1.DeadLetterTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deadLetterTopologyName,
+				Namespace: "default",
+			},
+			Spec: topologyv1alpha1.DeadLetterTopologySpec{
+				Name:               deadLetterTopologyName,
+				Vhost:              "/",
+				Type:               "quorum",
+				DeliveryLimit:      pointer.Int64(5),
+				DeadLetterQueueTTL: pointer.Int64(60000),
+				Mode:               mode,
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "example-rabbit",
+				},
+			},
+		}
+	})
+
+	When("mode is 'arguments'", func() {
+		BeforeEach(func() {
+			deadLetterTopologyName = "dead-letter-arguments"
+		})
+
+		It("creates the queues, exchange and binding with dead lettering queue arguments", func() {
+			Expect(client.Create(ctx, &deadLetterTopology)).To(Succeed())
+
+			By("creating the work queue", func() {
+				var queue topology.Queue
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-queue", Namespace: "default"}, &queue)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(queue.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Name":               Equal(deadLetterTopologyName),
+					"Type":               Equal("quorum"),
+					"Durable":            BeTrue(),
+					"DeadLetterExchange": Equal(deadLetterTopologyName + ".dlx"),
+					"DeliveryLimit":      PointTo(BeNumerically("==", 5)),
+				}))
+			})
+
+			By("creating the dead letter exchange", func() {
+				var exchange topology.Exchange
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-dead-letter-exchange", Namespace: "default"}, &exchange)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(exchange.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Name":    Equal(deadLetterTopologyName + ".dlx"),
+					"Type":    Equal("fanout"),
+					"Durable": BeTrue(),
+				}))
+			})
+
+			By("creating the dead letter queue", func() {
+				var queue topology.Queue
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-dead-letter-queue", Namespace: "default"}, &queue)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(queue.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Name":       Equal(deadLetterTopologyName + ".dlq"),
+					"Type":       Equal("quorum"),
+					"MessageTTL": PointTo(BeNumerically("==", 60000)),
+				}))
+			})
+
+			By("creating the binding from the dead letter exchange to the dead letter queue", func() {
+				var binding topology.Binding
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-dead-letter-binding", Namespace: "default"}, &binding)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(binding.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Source":          Equal(deadLetterTopologyName + ".dlx"),
+					"Destination":     Equal(deadLetterTopologyName + ".dlq"),
+					"DestinationType": Equal("queue"),
+				}))
+			})
+
+			By("not creating policies", func() {
+				var policy topology.Policy
+				err := client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-policy", Namespace: "default"}, &policy)
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			})
+
+			By("setting the status condition 'Ready' to 'true' once all resources are ready", func() {
+				EventuallyWithOffset(1, fetchConditions, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+				Expect(deadLetterTopology.Status.Resources).To(ConsistOf(
+					topologyv1alpha1.ChildResourceStatus{Kind: "Exchange", Name: deadLetterTopologyName + "-dead-letter-exchange", Ready: corev1.ConditionTrue},
+					topologyv1alpha1.ChildResourceStatus{Kind: "Queue", Name: deadLetterTopologyName + "-dead-letter-queue", Ready: corev1.ConditionTrue},
+					topologyv1alpha1.ChildResourceStatus{Kind: "Binding", Name: deadLetterTopologyName + "-dead-letter-binding", Ready: corev1.ConditionTrue},
+					topologyv1alpha1.ChildResourceStatus{Kind: "Queue", Name: deadLetterTopologyName + "-queue", Ready: corev1.ConditionTrue},
+				))
+			})
+		})
+	})
+
+	When("mode is 'policy'", func() {
+		BeforeEach(func() {
+			deadLetterTopologyName = "dead-letter-policy"
+			mode = topologyv1alpha1.DeadLetterModePolicy
+		})
+
+		It("declares dead lettering settings in policies", func() {
+			Expect(client.Create(ctx, &deadLetterTopology)).To(Succeed())
+
+			By("not setting dead lettering queue arguments", func() {
+				var queue topology.Queue
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-queue", Namespace: "default"}, &queue)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(queue.Spec.DeadLetterExchange).To(BeEmpty())
+				Expect(queue.Spec.DeliveryLimit).To(BeNil())
+			})
+
+			By("creating a policy for the work queue", func() {
+				var policy topology.Policy
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-policy", Namespace: "default"}, &policy)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(policy.Spec.Pattern).To(Equal("^dead-letter-policy$"))
+				Expect(policy.Spec.Definition.Raw).To(MatchJSON(`{"dead-letter-exchange":"dead-letter-policy.dlx","delivery-limit":5}`))
+			})
+
+			By("creating a policy for the dead letter queue", func() {
+				var policy topology.Policy
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-dead-letter-queue-policy", Namespace: "default"}, &policy)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(policy.Spec.Pattern).To(Equal(`^dead-letter-policy\.dlq$`))
+				Expect(policy.Spec.Definition.Raw).To(MatchJSON(`{"message-ttl":60000}`))
+			})
+
+			By("setting the status condition 'Ready' to 'true'", func() {
+				EventuallyWithOffset(1, fetchConditions, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+				Expect(deadLetterTopology.Status.Resources).To(HaveLen(6))
+			})
+
+			By("deleting the dead letter queue policy when the TTL is removed", func() {
+				deadLetterTopology.Spec.DeadLetterQueueTTL = nil
+				Expect(client.Update(ctx, &deadLetterTopology)).To(Succeed())
+				EventuallyWithOffset(1, func() bool {
+					var policy topology.Policy
+					err := client.Get(ctx, types.NamespacedName{Name: deadLetterTopologyName + "-dead-letter-queue-policy", Namespace: "default"}, &policy)
+					return apierrors.IsNotFound(err)
+				}, 10*time.Second, 1*time.Second).Should(BeTrue())
+			})
+		})
+	})
+
+	When("a generated resource fails to reconcile", func() {
+		BeforeEach(func() {
+			deadLetterTopologyName = "dead-letter-not-ready"
+		})
+
+		JustBeforeEach(func() {
+			fakeRabbitMQClient.DeclareBindingReturns(&http.Response{
+				Status:     "500 Internal Server Error",
+				StatusCode: http.StatusInternalServerError,
+			}, errors.New("some error"))
+		})
+
+		It("sets the status condition 'Ready' to 'false' with the failing resource", func() {
+			Expect(client.Create(ctx, &deadLetterTopology)).To(Succeed())
+
+			EventuallyWithOffset(1, func() []topologyv1alpha1.ChildResourceStatus {
+				fetchConditions()
+				return deadLetterTopology.Status.Resources
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Kind":  Equal("Binding"),
+				"Ready": Equal(corev1.ConditionFalse),
+			})))
+			Expect(deadLetterTopology.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Reason":  Equal("FailedCreateOrUpdate"),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("Binding 'dead-letter-not-ready-dead-letter-binding' is not ready"),
+			})))
+		})
+	})
+})
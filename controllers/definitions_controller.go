@@ -0,0 +1,454 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	"github.com/rabbitmq/messaging-topology-operator/internal"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+// DefinitionsReconciler reconciles a Definitions object
+type DefinitionsReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	RabbitmqClientFactory   rabbitmqclient.Factory
+	KubernetesClusterDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=definitions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=definitions/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=definitions/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+func (r *DefinitionsReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	definitions := &topology.Definitions{}
+	if err := r.Get(ctx, req.NamespacedName, definitions); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	systemCertPool, err := extractSystemCertPool(ctx, r.Recorder, definitions)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	credsProvider, tlsEnabled, err := rabbitmqclient.ParseReference(ctx, r.Client, definitions.Spec.RabbitmqClusterReference, definitions.Namespace, r.KubernetesClusterDomain)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, definitions, &definitions.Status.Conditions, err)
+	}
+
+	rabbitClient, err := r.RabbitmqClientFactory(credsProvider, tlsEnabled, systemCertPool)
+	if err != nil {
+		logger.Error(err, failedGenerateRabbitClient)
+		return reconcile.Result{}, err
+	}
+
+	if !definitions.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("Deleting")
+		return ctrl.Result{}, r.deleteDefinitions(ctx, rabbitClient, definitions)
+	}
+
+	if err := addFinalizerIfNeeded(ctx, r.Client, definitions); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	spec, err := json.Marshal(definitions.Spec)
+	if err != nil {
+		logger.Error(err, failedMarshalSpec)
+	}
+
+	logger.Info("Start reconciling",
+		"spec", string(spec))
+
+	if err := r.importDefinitions(ctx, rabbitClient, definitions); err != nil {
+		// Set Condition 'Ready' to false with message
+		definitions.Status.Conditions = []topology.Condition{
+			topology.NotReady(err.Error(), definitions.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, definitions)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", definitions.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	definitions.Status.Conditions = []topology.Condition{topology.Ready(definitions.Status.Conditions)}
+	definitions.Status.ObservedGeneration = definitions.GetGeneration()
+	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, definitions)
+	}); writerErr != nil {
+		logger.Error(writerErr, failedStatusUpdate, "status", definitions.Status)
+	}
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+// importDefinitions imports vhosts, exchanges, queues, bindings and policies from the definitions document
+// objects which already exist are left untouched; status lists created, already existing and failed objects
+func (r *DefinitionsReconciler) importDefinitions(ctx context.Context, client rabbitmqclient.Client, definitions *topology.Definitions) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	document, err := r.getDefinitionsDocument(ctx, definitions)
+	if err != nil {
+		msg := "failed to get definitions document"
+		r.Recorder.Event(definitions, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg)
+		return err
+	}
+
+	parsed, err := internal.ParseDefinitions(document, definitions.Spec.Vhost)
+	if err != nil {
+		msg := "failed to parse definitions document"
+		r.Recorder.Event(definitions, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg)
+		return err
+	}
+
+	if sections := parsed.UnsupportedSections(); len(sections) > 0 {
+		msg := fmt.Sprintf("skipped unsupported definitions sections: %s", strings.Join(sections, ", "))
+		r.Recorder.Event(definitions, corev1.EventTypeNormal, "SkippedImport", msg)
+		logger.Info(msg)
+	}
+
+	importer := newDefinitionsImporter(client, definitions.Status.Created)
+	for _, v := range parsed.Vhosts {
+		importer.importVhost(v)
+	}
+	for _, e := range parsed.Exchanges {
+		importer.importExchange(e)
+	}
+	for _, q := range parsed.Queues {
+		importer.importQueue(q)
+	}
+	for _, b := range parsed.Bindings {
+		importer.importBinding(b)
+	}
+	for _, p := range parsed.Policies {
+		importer.importPolicy(p)
+	}
+	definitions.Status.Created, definitions.Status.AlreadyExisted, definitions.Status.Failed = importer.result()
+
+	if len(definitions.Status.Failed) > 0 {
+		err := fmt.Errorf("failed to import %d objects", len(definitions.Status.Failed))
+		r.Recorder.Event(definitions, corev1.EventTypeWarning, "FailedCreateOrUpdate", err.Error())
+		logger.Error(err, "failed to import definitions", "failed", definitions.Status.Failed)
+		return err
+	}
+
+	logger.Info("Successfully imported definitions",
+		"created", len(definitions.Status.Created), "alreadyExisted", len(definitions.Status.AlreadyExisted))
+	r.Recorder.Event(definitions, corev1.EventTypeNormal, "SuccessfulCreateOrUpdate", "successfully imported definitions")
+	return nil
+}
+
+// getDefinitionsDocument returns the definitions document from the referenced ConfigMap or Secret
+func (r *DefinitionsReconciler) getDefinitionsDocument(ctx context.Context, definitions *topology.Definitions) ([]byte, error) {
+	key := definitions.Spec.Key
+	if definitions.Spec.ConfigMap != nil {
+		configMap := &corev1.ConfigMap{}
+		if err := r.Get(ctx, types.NamespacedName{Name: definitions.Spec.ConfigMap.Name, Namespace: definitions.Namespace}, configMap); err != nil {
+			return nil, err
+		}
+		if data, ok := configMap.Data[key]; ok {
+			return []byte(data), nil
+		}
+		if data, ok := configMap.BinaryData[key]; ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf("could not find key '%s' in configmap %s", key, configMap.Name)
+	}
+
+	if definitions.Spec.Secret != nil {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: definitions.Spec.Secret.Name, Namespace: definitions.Namespace}, secret); err != nil {
+			return nil, err
+		}
+		if data, ok := secret.Data[key]; ok {
+			return data, nil
+		}
+		return nil, fmt.Errorf("could not find key '%s' in secret %s", key, secret.Name)
+	}
+
+	return nil, errors.New("no configmap or secret provided")
+}
+
+// deleteDefinitions removes objects created by the import when spec.deletionPolicy is set to 'delete'
+// objects are deleted in the reverse order of the import; if server responds with '404' Not Found, the object is considered deleted
+func (r *DefinitionsReconciler) deleteDefinitions(ctx context.Context, client rabbitmqclient.Client, definitions *topology.Definitions) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if definitions.Spec.DeletionPolicy != "delete" {
+		logger.Info("deletion policy is 'retain'; imported objects are not deleted")
+		r.Recorder.Event(definitions, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted definitions")
+		return removeFinalizer(ctx, r.Client, definitions)
+	}
+
+	for _, objectType := range []string{
+		internal.DefinitionsTypePolicy,
+		internal.DefinitionsTypeBinding,
+		internal.DefinitionsTypeQueue,
+		internal.DefinitionsTypeExchange,
+		internal.DefinitionsTypeVhost,
+	} {
+		for _, object := range definitions.Status.Created {
+			if object.Type != objectType {
+				continue
+			}
+			err := validateResponseForDeletion(deleteDefinitionsObject(client, object))
+			if errors.Is(err, NotFound) {
+				logger.Info("cannot find imported object in rabbitmq server; already deleted", "object", object)
+			} else if err != nil {
+				msg := fmt.Sprintf("failed to delete imported %s", object.Type)
+				r.Recorder.Event(definitions, corev1.EventTypeWarning, "FailedDelete", msg)
+				logger.Error(err, msg, "object", object)
+				return err
+			}
+		}
+	}
+
+	r.Recorder.Event(definitions, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted definitions")
+	return removeFinalizer(ctx, r.Client, definitions)
+}
+
+func deleteDefinitionsObject(client rabbitmqclient.Client, object topology.DefinitionsObject) (*http.Response, error) {
+	switch object.Type {
+	case internal.DefinitionsTypeVhost:
+		return client.DeleteVhost(object.Name)
+	case internal.DefinitionsTypeExchange:
+		return client.DeleteExchange(object.Vhost, object.Name)
+	case internal.DefinitionsTypeQueue:
+		return client.DeleteQueue(object.Vhost, object.Name)
+	case internal.DefinitionsTypeBinding:
+		return client.DeleteBinding(object.Vhost, rabbithole.BindingInfo{
+			Source:          object.Source,
+			Vhost:           object.Vhost,
+			Destination:     object.Destination,
+			DestinationType: object.DestinationType,
+			PropertiesKey:   object.PropertiesKey,
+		})
+	case internal.DefinitionsTypePolicy:
+		return client.DeletePolicy(object.Vhost, object.Name)
+	}
+	return nil, fmt.Errorf("unknown object type '%s'", object.Type)
+}
+
+// definitionsImporter imports objects one at a time and records the outcome for each of them
+type definitionsImporter struct {
+	client            rabbitmqclient.Client
+	previouslyCreated map[string]topology.DefinitionsObject
+	seen              map[string]bool
+	created           []topology.DefinitionsObject
+	alreadyExisted    []topology.DefinitionsObject
+	failed            []topology.DefinitionsObject
+}
+
+func newDefinitionsImporter(client rabbitmqclient.Client, previouslyCreated []topology.DefinitionsObject) *definitionsImporter {
+	importer := &definitionsImporter{
+		client:            client,
+		previouslyCreated: make(map[string]topology.DefinitionsObject),
+		seen:              make(map[string]bool),
+	}
+	for _, object := range previouslyCreated {
+		importer.previouslyCreated[internal.DefinitionsObjectKey(object)] = object
+	}
+	return importer
+}
+
+// record checks whether an object exists and declares it when it does not
+// objects which exist and were created by a previous import are still reported as created
+func (i *definitionsImporter) record(object *topology.DefinitionsObject, exists func() (bool, error), declare func() error) {
+	found, err := exists()
+	if err != nil {
+		object.Message = err.Error()
+		i.failed = append(i.failed, *object)
+		return
+	}
+
+	if found {
+		key := internal.DefinitionsObjectKey(*object)
+		i.seen[key] = true
+		if _, ok := i.previouslyCreated[key]; ok {
+			i.created = append(i.created, *object)
+		} else {
+			i.alreadyExisted = append(i.alreadyExisted, *object)
+		}
+		return
+	}
+
+	if err := declare(); err != nil {
+		object.Message = err.Error()
+		i.failed = append(i.failed, *object)
+		return
+	}
+	i.seen[internal.DefinitionsObjectKey(*object)] = true
+	i.created = append(i.created, *object)
+}
+
+// result returns created, already existing and failed objects
+// objects created by a previous import which are no longer in the definitions document stay in the created list,
+// so that they can still be removed when the Definitions object is deleted
+func (i *definitionsImporter) result() ([]topology.DefinitionsObject, []topology.DefinitionsObject, []topology.DefinitionsObject) {
+	var removed []string
+	for key := range i.previouslyCreated {
+		if !i.seen[key] {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	created := i.created
+	for _, key := range removed {
+		created = append(created, i.previouslyCreated[key])
+	}
+	return created, i.alreadyExisted, i.failed
+}
+
+func (i *definitionsImporter) importVhost(v rabbithole.VhostInfo) {
+	object := internal.VhostDefinitionsObject(v)
+	i.record(&object, func() (bool, error) {
+		_, err := i.client.GetVhost(v.Name)
+		return found(err)
+	}, func() error {
+		return validateResponse(i.client.PutVhost(v.Name, internal.GenerateVhostSettingsFromDefinition(v)))
+	})
+}
+
+func (i *definitionsImporter) importExchange(e rabbithole.ExchangeInfo) {
+	object := internal.ExchangeDefinitionsObject(e)
+	i.record(&object, func() (bool, error) {
+		_, err := i.client.GetExchange(e.Vhost, e.Name)
+		return found(err)
+	}, func() error {
+		return validateResponse(i.client.DeclareExchange(e.Vhost, e.Name, internal.GenerateExchangeSettingsFromDefinition(e)))
+	})
+}
+
+func (i *definitionsImporter) importQueue(q rabbithole.QueueInfo) {
+	object := internal.QueueDefinitionsObject(q)
+	i.record(&object, func() (bool, error) {
+		_, err := i.client.GetQueue(q.Vhost, q.Name)
+		return found(err)
+	}, func() error {
+		return validateResponse(i.client.DeclareQueue(q.Vhost, q.Name, internal.GenerateQueueSettingsFromDefinition(q)))
+	})
+}
+
+func (i *definitionsImporter) importBinding(b rabbithole.BindingInfo) {
+	object := internal.BindingDefinitionsObject(b)
+	// the properties key of a binding is computed by RabbitMQ and is only known once the binding exists
+	findBinding := func() (bool, error) {
+		existing, err := i.findBinding(b)
+		if err != nil || existing == nil {
+			return false, err
+		}
+		object.PropertiesKey = existing.PropertiesKey
+		return true, nil
+	}
+	i.record(&object, findBinding, func() error {
+		if err := validateResponse(i.client.DeclareBinding(b.Vhost, b)); err != nil {
+			return err
+		}
+		_, err := findBinding()
+		return err
+	})
+}
+
+func (i *definitionsImporter) findBinding(b rabbithole.BindingInfo) (*rabbithole.BindingInfo, error) {
+	var bindingInfos []rabbithole.BindingInfo
+	var err error
+	if b.DestinationType == "queue" {
+		bindingInfos, err = i.client.ListQueueBindingsBetween(b.Vhost, b.Source, b.Destination)
+	} else {
+		bindingInfos, err = i.client.ListExchangeBindingsBetween(b.Vhost, b.Source, b.Destination)
+	}
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range bindingInfos {
+		if existing.RoutingKey == b.RoutingKey && argumentsEqual(existing.Arguments, b.Arguments) {
+			return &existing, nil
+		}
+	}
+	return nil, nil
+}
+
+func (i *definitionsImporter) importPolicy(p rabbithole.Policy) {
+	object := internal.PolicyDefinitionsObject(p)
+	i.record(&object, func() (bool, error) {
+		_, err := i.client.GetPolicy(p.Vhost, p.Name)
+		return found(err)
+	}, func() error {
+		return validateResponse(i.client.PutPolicy(p.Vhost, p.Name, p))
+	})
+}
+
+// found converts the error returned by a rabbithole GET request into whether the object exists
+func found(err error) (bool, error) {
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func isNotFound(err error) bool {
+	var errResponse rabbithole.ErrorResponse
+	return errors.As(err, &errResponse) && errResponse.StatusCode == http.StatusNotFound
+}
+
+// argumentsEqual compares binding arguments, treating nil and empty arguments as equal
+func argumentsEqual(a, b map[string]interface{}) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func (r *DefinitionsReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesClusterDomain = domainName
+}
+
+func (r *DefinitionsReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topology.Definitions{}).
+		Complete(r)
+}
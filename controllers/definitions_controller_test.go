@@ -0,0 +1,245 @@
+package controllers_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("definitions-controller", func() {
+	const document = `{
+  "users": [{"name": "a-user", "password_hash": "hash", "tags": ""}],
+  "exchanges": [{"name": "an-exchange", "vhost": "/", "type": "topic", "durable": true, "auto_delete": false, "internal": false, "arguments": {}}],
+  "queues": [{"name": "a-queue", "vhost": "/", "durable": true, "auto_delete": false, "arguments": {}}]
+}`
+
+	var (
+		definitions     topology.Definitions
+		definitionsName string
+		deletionPolicy  string
+		notFound        = rabbithole.ErrorResponse{StatusCode: http.StatusNotFound, Message: "Object Not Found"}
+	)
+
+	JustBeforeEach(func() {
+		configMap := corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      definitionsName,
+				Namespace: "default",
+			},
+			Data: map[string]string{
+				"definitions.json": document,
+			},
+		}
+		Expect(client.Create(ctx, &configMap)).To(Succeed())
+
+		definitions = topology.Definitions{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      definitionsName,
+				Namespace: "default",
+			},
+			Spec: topology.DefinitionsSpec{
+				ConfigMap:      &corev1.LocalObjectReference{Name: definitionsName},
+				DeletionPolicy: deletionPolicy,
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "example-rabbit",
+				},
+			},
+		}
+	})
+
+	BeforeEach(func() {
+		deletionPolicy = "retain"
+		fakeRabbitMQClient.GetExchangeReturns(&rabbithole.DetailedExchangeInfo{Name: "an-exchange"}, nil)
+		fakeRabbitMQClient.GetQueueReturns(nil, notFound)
+		fakeRabbitMQClient.DeclareQueueReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+	})
+
+	getDefinitions := func() *topology.Definitions {
+		_ = client.Get(
+			ctx,
+			types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace},
+			&definitions,
+		)
+		return &definitions
+	}
+
+	Context("creation", func() {
+		When("success", func() {
+			BeforeEach(func() {
+				definitionsName = "test-definitions-success"
+			})
+
+			It("imports missing objects and reports the outcome in status", func() {
+				Expect(client.Create(ctx, &definitions)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					return getDefinitions().Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+
+				Expect(definitions.Status.Created).To(ConsistOf(topology.DefinitionsObject{Type: "queue", Vhost: "/", Name: "a-queue"}))
+				Expect(definitions.Status.AlreadyExisted).To(ConsistOf(topology.DefinitionsObject{Type: "exchange", Vhost: "/", Name: "an-exchange"}))
+				Expect(definitions.Status.Failed).To(BeEmpty())
+
+				Expect(fakeRabbitMQClient.DeclareExchangeCallCount()).To(Equal(0))
+				Expect(fakeRabbitMQClient.DeclareQueueCallCount()).To(BeNumerically(">=", 1))
+				vhost, name, settings := fakeRabbitMQClient.DeclareQueueArgsForCall(0)
+				Expect(vhost).To(Equal("/"))
+				Expect(name).To(Equal("a-queue"))
+				Expect(settings.Durable).To(BeTrue())
+				Expect(observedEvents()).To(ContainElement("Normal SkippedImport skipped unsupported definitions sections: users"))
+			})
+		})
+
+		When("the RabbitMQ Client fails to declare an object", func() {
+			BeforeEach(func() {
+				definitionsName = "test-definitions-declare-error"
+				fakeRabbitMQClient.DeclareQueueReturns(nil, errors.New("a go failure"))
+			})
+
+			It("lists the object as failed and sets the status condition", func() {
+				Expect(client.Create(ctx, &definitions)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					return getDefinitions().Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Reason":  Equal("FailedCreateOrUpdate"),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": Equal("failed to import 1 objects"),
+				})))
+
+				Expect(definitions.Status.Failed).To(ConsistOf(topology.DefinitionsObject{
+					Type:    "queue",
+					Vhost:   "/",
+					Name:    "a-queue",
+					Message: "a go failure",
+				}))
+				Expect(definitions.Status.AlreadyExisted).To(HaveLen(1))
+			})
+		})
+
+		When("the definitions document cannot be found", func() {
+			BeforeEach(func() {
+				definitionsName = "test-definitions-missing-configmap"
+			})
+
+			It("sets the status condition", func() {
+				definitions.Spec.ConfigMap.Name = "a-configmap-which-does-not-exist"
+				Expect(client.Create(ctx, &definitions)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					return getDefinitions().Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Reason":  Equal("FailedCreateOrUpdate"),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": ContainSubstring("not found"),
+				})))
+			})
+		})
+	})
+
+	Context("deletion", func() {
+		JustBeforeEach(func() {
+			Expect(client.Create(ctx, &definitions)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				return getDefinitions().Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+
+		When("deletionPolicy is 'retain'", func() {
+			BeforeEach(func() {
+				definitionsName = "delete-definitions-retain"
+			})
+
+			It("does not delete imported objects", func() {
+				Expect(client.Delete(ctx, &definitions)).To(Succeed())
+				Eventually(func() bool {
+					err := client.Get(ctx, types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace}, &topology.Definitions{})
+					return apierrors.IsNotFound(err)
+				}, 5).Should(BeTrue())
+				Expect(fakeRabbitMQClient.DeleteQueueCallCount()).To(Equal(0))
+				Expect(fakeRabbitMQClient.DeleteExchangeCallCount()).To(Equal(0))
+				Expect(observedEvents()).To(ContainElement("Normal SuccessfulDelete successfully deleted definitions"))
+			})
+		})
+
+		When("deletionPolicy is 'delete'", func() {
+			BeforeEach(func() {
+				definitionsName = "delete-definitions-delete"
+				deletionPolicy = "delete"
+				fakeRabbitMQClient.DeleteQueueReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+			})
+
+			It("only deletes objects created by the import", func() {
+				Expect(client.Delete(ctx, &definitions)).To(Succeed())
+				Eventually(func() bool {
+					err := client.Get(ctx, types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace}, &topology.Definitions{})
+					return apierrors.IsNotFound(err)
+				}, 5).Should(BeTrue())
+				Expect(fakeRabbitMQClient.DeleteQueueCallCount()).To(Equal(1))
+				vhost, name, _ := fakeRabbitMQClient.DeleteQueueArgsForCall(0)
+				Expect(vhost).To(Equal("/"))
+				Expect(name).To(Equal("a-queue"))
+				Expect(fakeRabbitMQClient.DeleteExchangeCallCount()).To(Equal(0))
+				Expect(observedEvents()).To(ContainElement("Normal SuccessfulDelete successfully deleted definitions"))
+			})
+		})
+
+		When("deleting an imported object fails", func() {
+			BeforeEach(func() {
+				definitionsName = "delete-definitions-error"
+				deletionPolicy = "delete"
+				fakeRabbitMQClient.DeleteQueueReturns(nil, errors.New("some error"))
+			})
+
+			It("publishes a 'warning' event", func() {
+				Expect(client.Delete(ctx, &definitions)).To(Succeed())
+				Consistently(func() bool {
+					err := client.Get(ctx, types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace}, &topology.Definitions{})
+					return apierrors.IsNotFound(err)
+				}, 5).Should(BeFalse())
+				Expect(observedEvents()).To(ContainElement("Warning FailedDelete failed to delete imported queue"))
+			})
+		})
+	})
+
+	Context("finalizer", func() {
+		BeforeEach(func() {
+			definitionsName = "definitions-finalizer-test"
+		})
+
+		It("sets the correct deletion finalizer to the object", func() {
+			Expect(client.Create(ctx, &definitions)).To(Succeed())
+			Eventually(func() []string {
+				var fetched topology.Definitions
+				err := client.Get(ctx, types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace}, &fetched)
+				if err != nil {
+					return []string{}
+				}
+				return fetched.ObjectMeta.Finalizers
+			}, 5).Should(ConsistOf("deletion.finalizers.definitions.rabbitmq.com"))
+		})
+	})
+})
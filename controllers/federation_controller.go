@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/rabbitmq/messaging-topology-operator/internal"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
@@ -12,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,6 +26,19 @@ import (
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 )
 
+// federationStatusPollInterval is how often the status of federation links is refreshed in federation status
+// the federation upstream is not set again when polling, unless its definition changed
+const federationStatusPollInterval = 30 * time.Second
+
+// federationUpstreamPermissions are the permissions of the user provisioned in the upstream cluster
+// federation links declare exchanges and queues prefixed with 'federation: ' in the upstream, bind them to the federated exchanges,
+// and consume from them or from the federated queues
+var federationUpstreamPermissions = topology.VhostPermissions{
+	Configure: "^federation: ",
+	Write:     "^federation: ",
+	Read:      ".*",
+}
+
 // FederationReconciler reconciles a Federation object
 type FederationReconciler struct {
 	client.Client
@@ -30,11 +47,17 @@ type FederationReconciler struct {
 	Recorder                record.EventRecorder
 	RabbitmqClientFactory   rabbitmqclient.Factory
 	KubernetesClusterDomain string
+	declared                declaredDefinitions
 }
 
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=federations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=federations/finalizers,verbs=update
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=federations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=users,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=permissions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *FederationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := ctrl.LoggerFrom(ctx)
@@ -79,18 +102,23 @@ func (r *FederationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	if err := r.setFederation(ctx, rabbitClient, federation); err != nil {
 		// Set Condition 'Ready' to false with message
-		federation.Status.Conditions = []topology.Condition{
-			topology.NotReady(err.Error(), federation.Status.Conditions),
-		}
+		federation.Status.Conditions = notReadyConditions(err.Error(), federation.Status.Conditions)
 		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
 			return r.Status().Update(ctx, federation)
 		}); writerErr != nil {
 			logger.Error(writerErr, failedStatusUpdate, "status", federation.Status)
 		}
+		if errors.Is(err, errClusterEndpointCredentialsNotReady) {
+			// reconciled again once the credentials secret of the upstream user is created
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 
-	federation.Status.Conditions = []topology.Condition{topology.Ready(federation.Status.Conditions)}
+	federation.Status.Conditions = []topology.Condition{
+		topology.Ready(federation.Status.Conditions),
+		r.updateFederationLinks(ctx, rabbitClient, federation),
+	}
 	federation.Status.ObservedGeneration = federation.GetGeneration()
 	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
 		return r.Status().Update(ctx, federation)
@@ -99,32 +127,81 @@ func (r *FederationReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 	logger.Info("Finished reconciling")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: federationStatusPollInterval}, nil
 }
 
+// updateFederationLinks sets links in federation status from the federation links reported by RabbitMQ
+// returns condition 'Running' which is false when any link is down, and unknown when the upstream is not used by any link
+func (r *FederationReconciler) updateFederationLinks(ctx context.Context, client rabbitmqclient.Client, federation *topology.Federation) topology.Condition {
+	logger := ctrl.LoggerFrom(ctx)
+
+	links, err := client.ListFederationLinksIn(federation.Spec.Vhost)
+	if err != nil {
+		logger.Error(err, "failed to list federation links", "federation", federation.Spec.Name)
+		return topology.NotRunning("FailedStatusQuery", err.Error(), federation.Status.Conditions)
+	}
+
+	federation.Status.Links = internal.GenerateFederationLinks(links, federation.Spec.Name)
+	if len(federation.Status.Links) == 0 {
+		// set again on next reconcile, in case it was deleted from RabbitMQ
+		r.declared.forget(federation.UID)
+		return topology.RunningUnknown("NoLinks", "federation upstream is not used by any link", federation.Status.Conditions)
+	}
+
+	down := internal.FederationLinksDown(federation.Status.Links)
+	if len(down) == 0 {
+		return topology.Running(federation.Status.Conditions)
+	}
+	var msgs []string
+	for _, link := range down {
+		msg := fmt.Sprintf("%s '%s' is in status '%s'", link.Type, link.Name, link.Status)
+		if link.Error != "" {
+			msg = fmt.Sprintf("%s: %s", msg, link.Error)
+		}
+		msgs = append(msgs, msg)
+	}
+	return topology.NotRunning("LinkDown", strings.Join(msgs, "; "), federation.Status.Conditions)
+}
+
+// setFederation sets the federation upstream in RabbitMQ, unless its definition did not change since it was last set
 func (r *FederationReconciler) setFederation(ctx context.Context, client rabbitmqclient.Client, federation *topology.Federation) error {
 	logger := ctrl.LoggerFrom(ctx)
 
 	uri, err := r.getUri(ctx, federation)
+	if errors.Is(err, errClusterEndpointCredentialsNotReady) {
+		logger.Info("Waiting for the credentials of the upstream user", "federation", federation.Spec.Name)
+		return err
+	}
 	if err != nil {
-		msg := "failed to parse federation uri secret"
+		msg := "failed to get federation upstream uri"
 		r.Recorder.Event(federation, corev1.EventTypeWarning, "FailedUpdate", msg)
-		logger.Error(err, msg, "uri secret", federation.Spec.UriSecret.Name)
+		logger.Error(err, msg)
 		return err
 	}
 
-	if err := validateResponse(client.PutFederationUpstream(federation.Spec.Vhost, federation.Spec.Name, internal.GenerateFederationDefinition(federation, uri))); err != nil {
+	definition := internal.GenerateFederationDefinition(federation, uri)
+	if !r.declared.changed(federation.UID, definition) {
+		return nil
+	}
+
+	if err := validateResponse(client.PutFederationUpstream(federation.Spec.Vhost, federation.Spec.Name, definition)); err != nil {
 		msg := "failed to set federation upstream parameter"
 		r.Recorder.Event(federation, corev1.EventTypeWarning, "FailedUpdate", msg)
 		logger.Error(err, msg, "federation", federation.Spec.Name)
 		return err
 	}
+	r.declared.record(federation.UID, definition)
 
 	logger.Info("Successfully set federation Upstream parameter", "federation", federation.Spec.Name)
 	r.Recorder.Event(federation, corev1.EventTypeNormal, "SuccessfulUpdate", "Successfully set federation Upstream parameter")
 	return nil
 }
+
+// getUri returns the upstream uri from spec.uriSecret, or generates it for spec.upstreamCluster
 func (r *FederationReconciler) getUri(ctx context.Context, federation *topology.Federation) (string, error) {
+	if federation.Spec.UpstreamCluster != nil {
+		return clusterEndpointURI(ctx, r.Client, r.Scheme, federation, "-upstream", federation.Spec.UpstreamCluster, federationUpstreamPermissions, r.KubernetesClusterDomain)
+	}
 	if federation.Spec.UriSecret == nil {
 		return "", fmt.Errorf("no uri secret provided")
 	}
@@ -156,6 +233,7 @@ func (r *FederationReconciler) deleteFederation(ctx context.Context, client rabb
 		return err
 	}
 	r.Recorder.Event(federation, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted federation upstream parameter")
+	r.declared.forget(federation.UID)
 	return removeFinalizer(ctx, r.Client, federation)
 }
 
@@ -166,5 +244,8 @@ func (r *FederationReconciler) SetInternalDomainName(domainName string) {
 func (r *FederationReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&topology.Federation{}).
+		Owns(&topology.User{}).
+		Owns(&topology.Permission{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(clusterEndpointOwners(mgr.GetClient(), "Federation"))).
 		Complete(r)
 }
@@ -0,0 +1,246 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// MessagingApplicationReconciler reconciles a MessagingApplication, and the vhost, exchanges, queues, bindings, user and permission it comprises of
+type MessagingApplicationReconciler struct {
+	client.Client
+	Log                      logr.Logger
+	Scheme                   *runtime.Scheme
+	Recorder                 record.EventRecorder
+	RabbitmqClientFactory    rabbitmqclient.Factory
+	KubernetesInternalDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=vhosts,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=exchanges,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=queues,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=bindings,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=users,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=permissions,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=messagingapplications,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=messagingapplications/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=messagingapplications/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;create;patch
+
+func (r *MessagingApplicationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	application := &topologyv1alpha1.MessagingApplication{}
+	if err := r.Get(ctx, req.NamespacedName, application); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	rmqClusterRef, err := childRabbitmqClusterReference(ctx, r.Client, application.Spec.RabbitmqClusterReference, application.Namespace)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, application, &application.Status.Conditions, err)
+	}
+
+	logger.Info("Start reconciling")
+
+	// Each MessagingApplication generates a vhost, then its exchanges, queues and user, then its bindings and the permission of its user
+	// A stage is only created once all resources of the previous stages are ready
+	managedResourceBuilder := managedresource.Builder{
+		ObjectOwner: application,
+		Scheme:      r.Scheme,
+	}
+	stages := messagingApplicationStages(&managedResourceBuilder, &application.Spec, rmqClusterRef)
+
+	desired := make(map[string]bool)
+	for _, stage := range stages {
+		for _, builder := range stage {
+			resource, err := builder.Build()
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			desired[childResourceKey(resource)] = true
+		}
+	}
+	if err := r.pruneChildResources(ctx, application, desired); err != nil {
+		if writerErr := r.SetReconcileSuccess(ctx, application, topology.NotReady("FailedPruneChildResource", application.Status.Conditions)); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", application.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	var resources []topologyv1alpha1.ChildResourceStatus
+	var userCredentials *corev1.LocalObjectReference
+	previousStagesReady := true
+	for _, stage := range stages {
+		stageReady := true
+		for _, builder := range stage {
+			resource, err := builder.Build()
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
+			if !previousStagesReady {
+				resources = append(resources, topologyv1alpha1.ChildResourceStatus{
+					Kind:    builder.ResourceType(),
+					Name:    resource.GetName(),
+					Ready:   corev1.ConditionUnknown,
+					Message: "waiting for the resources it depends on to be ready",
+				})
+				continue
+			}
+
+			err = clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+				var apiError error
+				_, apiError = controllerutil.CreateOrUpdate(ctx, r.Client, resource, func() error {
+					return builder.Update(resource)
+				})
+				return apiError
+			})
+			if err != nil {
+				msg := fmt.Sprintf("FailedReconcile%s", builder.ResourceType())
+				if writerErr := r.SetReconcileSuccess(ctx, application, topology.NotReady(msg, application.Status.Conditions)); writerErr != nil {
+					logger.Error(writerErr, failedStatusUpdate, "status", application.Status)
+				}
+				return ctrl.Result{}, err
+			}
+
+			readiness := childResourceReadiness(resource)
+			if readiness.Ready != corev1.ConditionTrue {
+				stageReady = false
+			}
+			resources = append(resources, readiness)
+
+			if user, ok := resource.(*topology.User); ok {
+				userCredentials = user.Status.Credentials
+			}
+		}
+		previousStagesReady = previousStagesReady && stageReady
+	}
+
+	application.Status.Resources = resources
+	application.Status.UserCredentials = userCredentials
+	if err := r.SetReconcileSuccess(ctx, application, aggregateReadiness(resources, application.Status.Conditions)); err != nil {
+		logger.Error(err, failedStatusUpdate)
+	}
+
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+// messagingApplicationStages returns the builders of the resources of a MessagingApplication, grouped by dependency order:
+// the vhost; the exchanges, queues and user declared in the vhost; the bindings between exchanges and queues, and the permission of the user
+func messagingApplicationStages(builder *managedresource.Builder, spec *topologyv1alpha1.MessagingApplicationSpec,
+	rmqClusterRef *topology.RabbitmqClusterReference) [][]managedresource.ResourceBuilder {
+	var declarations []managedresource.ResourceBuilder
+	for i := range spec.Exchanges {
+		declarations = append(declarations, builder.ApplicationExchange(&spec.Exchanges[i], spec.Vhost, rmqClusterRef))
+	}
+	for i := range spec.Queues {
+		declarations = append(declarations, builder.ApplicationQueue(&spec.Queues[i], spec.Vhost, rmqClusterRef))
+	}
+	var dependents []managedresource.ResourceBuilder
+	for i := range spec.Bindings {
+		dependents = append(dependents, builder.ApplicationBinding(&spec.Bindings[i], spec.Vhost, rmqClusterRef))
+	}
+	if spec.User != nil {
+		declarations = append(declarations, builder.ApplicationUser(spec.User, rmqClusterRef))
+		dependents = append(dependents, builder.ApplicationPermission(spec.User, spec.Vhost, rmqClusterRef))
+	}
+
+	return [][]managedresource.ResourceBuilder{
+		{builder.ApplicationVhost(spec, rmqClusterRef)},
+		declarations,
+		dependents,
+	}
+}
+
+// pruneChildResources deletes the resources generated by the MessagingApplication which are no longer in its spec
+// dependent resources, such as bindings, are deleted before the resources they depend on
+func (r *MessagingApplicationReconciler) pruneChildResources(ctx context.Context, application *topologyv1alpha1.MessagingApplication, desired map[string]bool) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	lists := []client.ObjectList{
+		&topology.BindingList{},
+		&topology.PermissionList{},
+		&topology.ExchangeList{},
+		&topology.QueueList{},
+		&topology.UserList{},
+		&topology.VhostList{},
+	}
+	for _, list := range lists {
+		if err := r.List(ctx, list, client.InNamespace(application.Namespace),
+			client.MatchingLabels{managedresource.AnnotationMessagingApplication: application.Name}); err != nil {
+			return err
+		}
+		err := meta.EachListItem(list, func(item runtime.Object) error {
+			resource := item.(client.Object)
+			if !metav1.IsControlledBy(resource, application) || desired[childResourceKey(resource)] {
+				return nil
+			}
+			if err := r.Delete(ctx, resource); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			logger.Info("Deleted resource removed from spec", "resource", childResourceKey(resource))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// childResourceKey identifies a generated resource by its type and name
+func childResourceKey(resource client.Object) string {
+	return fmt.Sprintf("%T/%s", resource, resource.GetName())
+}
+
+func (r *MessagingApplicationReconciler) SetReconcileSuccess(ctx context.Context, application *topologyv1alpha1.MessagingApplication, condition topology.Condition) error {
+	application.Status.Conditions = []topology.Condition{condition}
+	application.Status.ObservedGeneration = application.GetGeneration()
+	return clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, application)
+	})
+}
+
+func (r *MessagingApplicationReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesInternalDomain = domainName
+}
+
+func (r *MessagingApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topologyv1alpha1.MessagingApplication{}).
+		Owns(&topology.Vhost{}).
+		Owns(&topology.Exchange{}).
+		Owns(&topology.Queue{}).
+		Owns(&topology.Binding{}).
+		Owns(&topology.User{}).
+		Owns(&topology.Permission{}).
+		Complete(r)
+}
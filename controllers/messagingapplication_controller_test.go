@@ -0,0 +1,196 @@
+package controllers_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("messaging-application-controller", func() {
+
+	var application topologyv1alpha1.MessagingApplication
+	var applicationName string
+
+	fetchApplication := func() topologyv1alpha1.MessagingApplicationStatus {
+		_ = client.Get(
+			ctx,
+			types.NamespacedName{Name: applicationName, Namespace: "default"},
+			&application,
+		)
+		return application.Status
+	}
+
+	JustBeforeEach(func() {
+		fakeRabbitMQClient.PutVhostReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareExchangeReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareQueueReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareBindingReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeleteQueueReturns(&http.Response{
+			Status:     "204 No Content",
+			StatusCode: http.StatusNoContent,
+		}, nil)
+		fakeRabbitMQClient.DeleteBindingReturns(&http.Response{
+			Status:     "204 No Content",
+			StatusCode: http.StatusNoContent,
+		}, nil)
+		application = topologyv1alpha1.MessagingApplication{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      applicationName,
+				Namespace: "default",
+			},
+			Spec: topologyv1alpha1.MessagingApplicationSpec{
+				Vhost: applicationName,
+				Exchanges: []topologyv1alpha1.ApplicationExchange{
+					{Name: "orders", Type: "topic", Durable: true},
+				},
+				Queues: []topologyv1alpha1.ApplicationQueue{
+					{Name: "created", Type: "quorum", Durable: true},
+					{Name: "cancelled", Type: "quorum", Durable: true},
+				},
+				Bindings: []topologyv1alpha1.ApplicationBinding{
+					{Source: "orders", Destination: "created", DestinationType: "queue", RoutingKey: "order.created"},
+					{Source: "orders", Destination: "cancelled", DestinationType: "queue", RoutingKey: "order.cancelled"},
+				},
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "example-rabbit",
+				},
+			},
+		}
+	})
+
+	When("creating a messaging application", func() {
+		BeforeEach(func() {
+			applicationName = "application-creation"
+		})
+
+		It("creates its vhost, exchanges, queues and bindings", func() {
+			Expect(client.Create(ctx, &application)).To(Succeed())
+
+			By("creating the vhost", func() {
+				var vhost topology.Vhost
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: applicationName + "-vhost", Namespace: "default"}, &vhost)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(vhost.Spec.Name).To(Equal(applicationName))
+			})
+
+			By("creating the exchanges and queues in the vhost", func() {
+				var exchange topology.Exchange
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: applicationName + "-exchange-orders", Namespace: "default"}, &exchange)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(exchange.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Name":  Equal("orders"),
+					"Vhost": Equal(applicationName),
+					"Type":  Equal("topic"),
+				}))
+
+				var queue topology.Queue
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: applicationName + "-queue-created", Namespace: "default"}, &queue)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(queue.Spec.Vhost).To(Equal(applicationName))
+			})
+
+			By("setting the status condition 'Ready' to 'true' once all resources are ready", func() {
+				EventuallyWithOffset(1, func() []topology.Condition {
+					return fetchApplication().Conditions
+				}, 20*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+				Expect(application.Status.Resources).To(HaveLen(6))
+				Expect(application.Status.Resources).To(HaveEach(MatchFields(IgnoreExtras, Fields{
+					"Ready": Equal(corev1.ConditionTrue),
+				})))
+			})
+		})
+	})
+
+	When("an item is removed from the lists", func() {
+		BeforeEach(func() {
+			applicationName = "application-pruning"
+		})
+
+		It("deletes its generated resource", func() {
+			Expect(client.Create(ctx, &application)).To(Succeed())
+			Eventually(func() []topologyv1alpha1.ChildResourceStatus {
+				return fetchApplication().Resources
+			}, 20*time.Second, 1*time.Second).Should(HaveLen(6))
+
+			application.Spec.Queues = application.Spec.Queues[:1]
+			application.Spec.Bindings = application.Spec.Bindings[:1]
+			Expect(client.Update(ctx, &application)).To(Succeed())
+
+			Eventually(func() bool {
+				var queue topology.Queue
+				err := client.Get(ctx, types.NamespacedName{Name: applicationName + "-queue-cancelled", Namespace: "default"}, &queue)
+				return apierrors.IsNotFound(err)
+			}, 10*time.Second, 1*time.Second).Should(BeTrue())
+			Eventually(func() []topologyv1alpha1.ChildResourceStatus {
+				return fetchApplication().Resources
+			}, 10*time.Second, 1*time.Second).Should(HaveLen(4))
+
+			var queue topology.Queue
+			Expect(client.Get(ctx, types.NamespacedName{Name: applicationName + "-queue-created", Namespace: "default"}, &queue)).To(Succeed())
+		})
+	})
+
+	When("the vhost fails to reconcile", func() {
+		BeforeEach(func() {
+			applicationName = "application-not-ready"
+		})
+
+		JustBeforeEach(func() {
+			fakeRabbitMQClient.PutVhostReturns(&http.Response{
+				Status:     "500 Internal Server Error",
+				StatusCode: http.StatusInternalServerError,
+			}, errors.New("some error"))
+		})
+
+		It("does not create the resources which depend on it", func() {
+			Expect(client.Create(ctx, &application)).To(Succeed())
+
+			Eventually(func() []topology.Condition {
+				return fetchApplication().Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("Vhost 'application-not-ready-vhost' is not ready"),
+			})))
+			Expect(application.Status.Resources).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Kind":  Equal("Exchange"),
+				"Ready": Equal(corev1.ConditionUnknown),
+			})))
+
+			Consistently(func() bool {
+				var exchange topology.Exchange
+				err := client.Get(ctx, types.NamespacedName{Name: applicationName + "-exchange-orders", Namespace: "default"}, &exchange)
+				return apierrors.IsNotFound(err)
+			}, 3*time.Second, 1*time.Second).Should(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,162 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/rabbitmq/messaging-topology-operator/internal"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+// OperatorPolicyReconciler reconciles an OperatorPolicy object
+type OperatorPolicyReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	RabbitmqClientFactory   rabbitmqclient.Factory
+	KubernetesClusterDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=operatorpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=operatorpolicies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=operatorpolicies/status,verbs=get;update;patch
+
+func (r *OperatorPolicyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	operatorPolicy := &topology.OperatorPolicy{}
+
+	if err := r.Get(ctx, req.NamespacedName, operatorPolicy); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	systemCertPool, err := extractSystemCertPool(ctx, r.Recorder, operatorPolicy)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	credsProvider, tlsEnabled, err := rabbitmqclient.ParseReference(ctx, r.Client, operatorPolicy.Spec.RabbitmqClusterReference, operatorPolicy.Namespace, r.KubernetesClusterDomain)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, operatorPolicy, &operatorPolicy.Status.Conditions, err)
+	}
+
+	rabbitClient, err := r.RabbitmqClientFactory(credsProvider, tlsEnabled, systemCertPool)
+	if err != nil {
+		logger.Error(err, failedGenerateRabbitClient)
+		return reconcile.Result{}, err
+	}
+
+	if !operatorPolicy.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("Deleting")
+		return ctrl.Result{}, r.deleteOperatorPolicy(ctx, rabbitClient, operatorPolicy)
+	}
+
+	if err := addFinalizerIfNeeded(ctx, r.Client, operatorPolicy); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	spec, err := json.Marshal(operatorPolicy.Spec)
+	if err != nil {
+		logger.Error(err, failedMarshalSpec)
+	}
+
+	logger.Info("Start reconciling",
+		"spec", string(spec))
+
+	if err := r.putOperatorPolicy(ctx, rabbitClient, operatorPolicy); err != nil {
+		// Set Condition 'Ready' to false with message
+		operatorPolicy.Status.Conditions = []topology.Condition{
+			topology.NotReady(err.Error(), operatorPolicy.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, operatorPolicy)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", operatorPolicy.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	operatorPolicy.Status.Conditions = []topology.Condition{topology.Ready(operatorPolicy.Status.Conditions)}
+	operatorPolicy.Status.ObservedGeneration = operatorPolicy.GetGeneration()
+	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, operatorPolicy)
+	}); writerErr != nil {
+		logger.Error(writerErr, failedStatusUpdate, "status", operatorPolicy.Status)
+	}
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+// creates or updates a given operator policy using rabbithole client.PutOperatorPolicy
+func (r *OperatorPolicyReconciler) putOperatorPolicy(ctx context.Context, client rabbitmqclient.Client, operatorPolicy *topology.OperatorPolicy) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	generateOperatorPolicy, err := internal.GenerateOperatorPolicy(operatorPolicy)
+	if err != nil {
+		msg := "failed to generate OperatorPolicy"
+		r.Recorder.Event(operatorPolicy, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg)
+		return err
+	}
+
+	if err = validateResponse(client.PutOperatorPolicy(operatorPolicy.Spec.Vhost, operatorPolicy.Spec.Name, *generateOperatorPolicy)); err != nil {
+		msg := "failed to create OperatorPolicy"
+		r.Recorder.Event(operatorPolicy, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg, "operatorPolicy", operatorPolicy.Spec.Name)
+		return err
+	}
+	logger.Info("Successfully created operator policy", "operatorPolicy", operatorPolicy.Spec.Name)
+	r.Recorder.Event(operatorPolicy, corev1.EventTypeNormal, "SuccessfulCreateOrUpdate", "Successfully created/updated operator policy")
+	return nil
+}
+
+// deletes operator policy from rabbitmq server
+// if server responds with '404' Not Found, it logs and does not requeue on error
+func (r *OperatorPolicyReconciler) deleteOperatorPolicy(ctx context.Context, client rabbitmqclient.Client, operatorPolicy *topology.OperatorPolicy) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	err := validateResponseForDeletion(client.DeleteOperatorPolicy(operatorPolicy.Spec.Vhost, operatorPolicy.Spec.Name))
+	if errors.Is(err, NotFound) {
+		logger.Info("cannot find operator policy in rabbitmq server; already deleted", "operatorPolicy", operatorPolicy.Spec.Name)
+	} else if err != nil {
+		msg := "failed to delete operator policy"
+		r.Recorder.Event(operatorPolicy, corev1.EventTypeWarning, "FailedDelete", msg)
+		logger.Error(err, msg, "operatorPolicy", operatorPolicy.Spec.Name)
+		return err
+	}
+	r.Recorder.Event(operatorPolicy, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted operator policy")
+	return removeFinalizer(ctx, r.Client, operatorPolicy)
+}
+
+func (r *OperatorPolicyReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesClusterDomain = domainName
+}
+
+func (r *OperatorPolicyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topology.OperatorPolicy{}).
+		Complete(r)
+}
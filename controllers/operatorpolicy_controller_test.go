@@ -0,0 +1,345 @@
+package controllers_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("operator-policy-controller", func() {
+	var operatorPolicy topology.OperatorPolicy
+	var operatorPolicyName string
+
+	When("validating RabbitMQ Client failures", func() {
+		JustBeforeEach(func() {
+			operatorPolicy = topology.OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operatorPolicyName,
+					Namespace: "default",
+				},
+				Spec: topology.OperatorPolicySpec{
+					Definition: &runtime.RawExtension{
+						Raw: []byte(`{"max-length":10}`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+		})
+
+		Context("creation", func() {
+			When("the RabbitMQ Client returns a HTTP error response", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "test-http-error"
+					fakeRabbitMQClient.PutOperatorPolicyReturns(&http.Response{
+						Status:     "418 I'm a teapot",
+						StatusCode: 418,
+					}, errors.New("a failure"))
+				})
+
+				It("sets the status condition", func() {
+					Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+							&operatorPolicy,
+						)
+
+						return operatorPolicy.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(topology.ConditionType("Ready")),
+						"Reason":  Equal("FailedCreateOrUpdate"),
+						"Status":  Equal(corev1.ConditionFalse),
+						"Message": ContainSubstring("a failure"),
+					})))
+				})
+			})
+
+			When("the RabbitMQ Client returns a Go error response", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "test-go-error"
+					fakeRabbitMQClient.PutOperatorPolicyReturns(nil, errors.New("a go failure"))
+				})
+
+				It("sets the status condition to indicate a failure to reconcile", func() {
+					Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+							&operatorPolicy,
+						)
+
+						return operatorPolicy.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(topology.ConditionType("Ready")),
+						"Reason":  Equal("FailedCreateOrUpdate"),
+						"Status":  Equal(corev1.ConditionFalse),
+						"Message": ContainSubstring("a go failure"),
+					})))
+				})
+			})
+
+			When("success", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "test-create-success"
+					fakeRabbitMQClient.PutOperatorPolicyReturns(&http.Response{
+						Status:     "201 Created",
+						StatusCode: http.StatusCreated,
+					}, nil)
+				})
+
+				It("sets the status condition 'Ready' to 'true' ", func() {
+					Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+							&operatorPolicy,
+						)
+
+						return operatorPolicy.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(topology.ConditionType("Ready")),
+						"Reason": Equal("SuccessfulCreateOrUpdate"),
+						"Status": Equal(corev1.ConditionTrue),
+					})))
+				})
+			})
+		})
+
+		Context("deletion", func() {
+			JustBeforeEach(func() {
+				fakeRabbitMQClient.PutOperatorPolicyReturns(&http.Response{
+					Status:     "201 Created",
+					StatusCode: http.StatusCreated,
+				}, nil)
+				Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					_ = client.Get(
+						ctx,
+						types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+						&operatorPolicy,
+					)
+
+					return operatorPolicy.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+			})
+
+			When("the RabbitMQ Client returns a HTTP error response", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "delete-operator-policy-http-error"
+					fakeRabbitMQClient.DeleteOperatorPolicyReturns(&http.Response{
+						Status:     "502 Bad Gateway",
+						StatusCode: http.StatusBadGateway,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("Hello World")),
+					}, nil)
+				})
+
+				It("publishes a 'warning' event", func() {
+					Expect(client.Delete(ctx, &operatorPolicy)).To(Succeed())
+					Consistently(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &topology.OperatorPolicy{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeFalse())
+					Expect(observedEvents()).To(ContainElement("Warning FailedDelete failed to delete operator policy"))
+				})
+			})
+
+			When("the RabbitMQ Client returns a Go error response", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "delete-go-error"
+					fakeRabbitMQClient.DeleteOperatorPolicyReturns(nil, errors.New("some error"))
+				})
+
+				It("publishes a 'warning' event", func() {
+					Expect(client.Delete(ctx, &operatorPolicy)).To(Succeed())
+					Consistently(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &topology.OperatorPolicy{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeFalse())
+					Expect(observedEvents()).To(ContainElement("Warning FailedDelete failed to delete operator policy"))
+				})
+			})
+
+			When("the RabbitMQ Client successfully deletes an operator policy", func() {
+				BeforeEach(func() {
+					operatorPolicyName = "delete-operator-policy-success"
+					fakeRabbitMQClient.DeleteOperatorPolicyReturns(&http.Response{
+						Status:     "204 No Content",
+						StatusCode: http.StatusNoContent,
+					}, nil)
+				})
+
+				It("publishes a normal event", func() {
+					Expect(client.Delete(ctx, &operatorPolicy)).To(Succeed())
+					Eventually(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &topology.OperatorPolicy{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeTrue())
+					Expect(observedEvents()).To(SatisfyAll(
+						Not(ContainElement("Warning FailedDelete failed to delete operator policy")),
+						ContainElement("Normal SuccessfulDelete successfully deleted operator policy"),
+					))
+				})
+			})
+		})
+
+		Context("finalizer", func() {
+			BeforeEach(func() {
+				operatorPolicyName = "finalizer-test"
+			})
+
+			It("sets the correct deletion finalizer to the object", func() {
+				Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+				Eventually(func() []string {
+					var fetched topology.OperatorPolicy
+					err := client.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &fetched)
+					if err != nil {
+						return []string{}
+					}
+					return fetched.ObjectMeta.Finalizers
+				}, 5).Should(ConsistOf("deletion.finalizers.operatorpolicies.rabbitmq.com"))
+			})
+		})
+	})
+
+	When("an operator policy references a cluster from a prohibited namespace", func() {
+		JustBeforeEach(func() {
+			operatorPolicyName = "test-operator-policy-prohibited"
+			operatorPolicy = topology.OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operatorPolicyName,
+					Namespace: "prohibited",
+				},
+				Spec: topology.OperatorPolicySpec{
+					Definition: &runtime.RawExtension{
+						Raw: []byte(`{"max-length":10}`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "example-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+		})
+		It("should throw an error about a cluster being prohibited", func() {
+			Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+					&operatorPolicy,
+				)
+
+				return operatorPolicy.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Reason":  Equal("FailedCreateOrUpdate"),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("not allowed to reference"),
+			})))
+		})
+	})
+
+	When("an operator policy references a cluster from an allowed namespace", func() {
+		JustBeforeEach(func() {
+			operatorPolicyName = "test-operator-policy-allowed"
+			operatorPolicy = topology.OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operatorPolicyName,
+					Namespace: "allowed",
+				},
+				Spec: topology.OperatorPolicySpec{
+					Definition: &runtime.RawExtension{
+						Raw: []byte(`{"max-length":10}`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "example-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutOperatorPolicyReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+		It("should be created", func() {
+			Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+					&operatorPolicy,
+				)
+
+				return operatorPolicy.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+	})
+
+	When("an operator policy references a cluster that allows all namespaces", func() {
+		JustBeforeEach(func() {
+			operatorPolicyName = "test-operator-policy-allowed-when-allow-all"
+			operatorPolicy = topology.OperatorPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      operatorPolicyName,
+					Namespace: "prohibited",
+				},
+				Spec: topology.OperatorPolicySpec{
+					Definition: &runtime.RawExtension{
+						Raw: []byte(`{"max-length":10}`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "allow-all-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutOperatorPolicyReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+		It("should be created", func() {
+			Expect(client.Create(ctx, &operatorPolicy)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace},
+					&operatorPolicy,
+				)
+
+				return operatorPolicy.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+	})
+})
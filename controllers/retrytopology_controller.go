@@ -0,0 +1,252 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// retryBindingDeletionPollInterval is how often a removed retry queue is checked for its binding to be deleted
+const retryBindingDeletionPollInterval = 5 * time.Second
+
+// RetryTopologyReconciler reconciles a RetryTopology, and the exchanges, queues and bindings it comprises of
+type RetryTopologyReconciler struct {
+	client.Client
+	Log                      logr.Logger
+	Scheme                   *runtime.Scheme
+	Recorder                 record.EventRecorder
+	RabbitmqClientFactory    rabbitmqclient.Factory
+	KubernetesInternalDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=exchanges,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=queues,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=bindings,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=retrytopologies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=retrytopologies/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=retrytopologies/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;create;patch
+
+func (r *RetryTopologyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	retryTopology := &topologyv1alpha1.RetryTopology{}
+	if err := r.Get(ctx, req.NamespacedName, retryTopology); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	rmqClusterRef, err := childRabbitmqClusterReference(ctx, r.Client, retryTopology.Spec.RabbitmqClusterReference, retryTopology.Namespace)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, retryTopology, &retryTopology.Status.Conditions, err)
+	}
+
+	logger.Info("Start reconciling")
+
+	// Each RetryTopology generates a work exchange, a work queue and its binding, a retry exchange,
+	// and for each delay, a retry queue and its binding to the retry exchange
+	managedResourceBuilder := managedresource.Builder{
+		ObjectOwner: retryTopology,
+		Scheme:      r.Scheme,
+	}
+	spec := &retryTopology.Spec
+	builders := []managedresource.ResourceBuilder{
+		managedResourceBuilder.RetryWorkExchange(spec, rmqClusterRef),
+		managedResourceBuilder.RetryWorkQueue(spec, rmqClusterRef),
+		managedResourceBuilder.RetryWorkBinding(spec, rmqClusterRef),
+		managedResourceBuilder.RetryExchange(spec, rmqClusterRef),
+	}
+	var tiers []topologyv1alpha1.RetryTier
+	delays := make(map[int64]bool)
+	for _, delay := range spec.Delays {
+		delayMilliseconds := delay.Milliseconds()
+		delays[delayMilliseconds] = true
+		builders = append(
+			builders,
+			managedResourceBuilder.RetryQueue(spec, delayMilliseconds, rmqClusterRef),
+			managedResourceBuilder.RetryBinding(spec, delayMilliseconds, rmqClusterRef),
+		)
+		tiers = append(tiers, retryTier(spec, delayMilliseconds, nil))
+	}
+
+	for _, builder := range builders {
+		resource, err := builder.Build()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		err = clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			var apiError error
+			_, apiError = controllerutil.CreateOrUpdate(ctx, r.Client, resource, func() error {
+				return builder.Update(resource)
+			})
+			return apiError
+		})
+		if err != nil {
+			msg := fmt.Sprintf("FailedReconcile%s", builder.ResourceType())
+			if writerErr := r.SetReconcileSuccess(ctx, retryTopology, topology.NotReady(msg, retryTopology.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", retryTopology.Status)
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	drainingTiers, requeueAfter, err := r.drainRemovedRetryQueues(ctx, retryTopology, &managedResourceBuilder, delays, rmqClusterRef)
+	if err != nil {
+		if writerErr := r.SetReconcileSuccess(ctx, retryTopology, topology.NotReady("FailedDrainRetryQueue", retryTopology.Status.Conditions)); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", retryTopology.Status)
+		}
+		return ctrl.Result{}, err
+	}
+	tiers = append(tiers, drainingTiers...)
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].Delay.Duration < tiers[j].Delay.Duration
+	})
+
+	retryTopology.Status.Tiers = tiers
+	if err := r.SetReconcileSuccess(ctx, retryTopology, topology.Ready(retryTopology.Status.Conditions)); err != nil {
+		logger.Error(err, failedStatusUpdate)
+	}
+
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// drainRemovedRetryQueues removes retry queues whose delay is no longer in spec.delays, without dropping their messages:
+// the retry queue is first unbound from the retry exchange, then deleted once its delay has elapsed,
+// since by then all of its messages have expired and have been dead lettered back to the work queue.
+// It returns the retry queues which are still draining, and when to check them again.
+func (r *RetryTopologyReconciler) drainRemovedRetryQueues(ctx context.Context, retryTopology *topologyv1alpha1.RetryTopology, builder *managedresource.Builder,
+	delays map[int64]bool, rmqClusterRef *topology.RabbitmqClusterReference) ([]topologyv1alpha1.RetryTier, time.Duration, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	queues := &topology.QueueList{}
+	if err := r.List(ctx, queues, client.InNamespace(retryTopology.Namespace),
+		client.MatchingLabels{managedresource.AnnotationRetryTopology: retryTopology.Name}); err != nil {
+		return nil, 0, err
+	}
+
+	var drainingTiers []topologyv1alpha1.RetryTier
+	var requeueAfter time.Duration
+	requeue := func(after time.Duration) {
+		if requeueAfter == 0 || after < requeueAfter {
+			requeueAfter = after
+		}
+	}
+
+	for i := range queues.Items {
+		queue := &queues.Items[i]
+		delayLabel, ok := queue.Labels[managedresource.AnnotationRetryDelay]
+		if !ok || !metav1.IsControlledBy(queue, retryTopology) {
+			continue
+		}
+		delayMilliseconds, err := strconv.ParseInt(delayLabel, 10, 64)
+		if err != nil || delays[delayMilliseconds] {
+			continue
+		}
+
+		binding, err := builder.RetryBinding(&retryTopology.Spec, delayMilliseconds, rmqClusterRef).Build()
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := r.Delete(ctx, binding); client.IgnoreNotFound(err) != nil {
+			return nil, 0, err
+		}
+		// the retry queue may receive messages until its binding is deleted from RabbitMQ
+		if err := r.Get(ctx, client.ObjectKeyFromObject(binding), binding); !k8serrors.IsNotFound(err) {
+			if err != nil {
+				return nil, 0, err
+			}
+			drainingTiers = append(drainingTiers, retryTier(&retryTopology.Spec, delayMilliseconds, nil))
+			requeue(retryBindingDeletionPollInterval)
+			continue
+		}
+
+		drainingSince, err := time.Parse(time.RFC3339, queue.Annotations[managedresource.AnnotationRetryDrainingSince])
+		if err != nil {
+			drainingSince = time.Now()
+			patchBase := client.MergeFrom(queue.DeepCopy())
+			if queue.Annotations == nil {
+				queue.Annotations = map[string]string{}
+			}
+			queue.Annotations[managedresource.AnnotationRetryDrainingSince] = drainingSince.UTC().Format(time.RFC3339)
+			if err := r.Patch(ctx, queue, patchBase); err != nil {
+				return nil, 0, err
+			}
+			logger.Info("Draining retry queue", "queue", queue.Spec.Name)
+		}
+
+		remaining := time.Duration(delayMilliseconds)*time.Millisecond - time.Since(drainingSince)
+		if remaining > 0 {
+			since := metav1.NewTime(drainingSince)
+			drainingTiers = append(drainingTiers, retryTier(&retryTopology.Spec, delayMilliseconds, &since))
+			requeue(remaining)
+			continue
+		}
+
+		if err := r.Delete(ctx, queue); client.IgnoreNotFound(err) != nil {
+			return nil, 0, err
+		}
+		logger.Info("Deleted drained retry queue", "queue", queue.Spec.Name)
+	}
+
+	return drainingTiers, requeueAfter, nil
+}
+
+func retryTier(spec *topologyv1alpha1.RetryTopologySpec, delayMilliseconds int64, drainingSince *metav1.Time) topologyv1alpha1.RetryTier {
+	return topologyv1alpha1.RetryTier{
+		Delay:         metav1.Duration{Duration: time.Duration(delayMilliseconds) * time.Millisecond},
+		Queue:         spec.RetryQueueName(delayMilliseconds),
+		RoutingKey:    topologyv1alpha1.RetryRoutingKey(delayMilliseconds),
+		DrainingSince: drainingSince,
+	}
+}
+
+func (r *RetryTopologyReconciler) SetReconcileSuccess(ctx context.Context, retryTopology *topologyv1alpha1.RetryTopology, condition topology.Condition) error {
+	retryTopology.Status.Conditions = []topology.Condition{condition}
+	retryTopology.Status.ObservedGeneration = retryTopology.GetGeneration()
+	return clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, retryTopology)
+	})
+}
+
+func (r *RetryTopologyReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesInternalDomain = domainName
+}
+
+func (r *RetryTopologyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topologyv1alpha1.RetryTopology{}).
+		Owns(&topology.Exchange{}).
+		Owns(&topology.Binding{}).
+		Owns(&topology.Queue{}).
+		Complete(r)
+}
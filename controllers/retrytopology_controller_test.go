@@ -0,0 +1,181 @@
+package controllers_test
+
+import (
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("retry-topology-controller", func() {
+
+	var retryTopology topologyv1alpha1.RetryTopology
+	var retryTopologyName string
+
+	fetchRetryTopology := func() topologyv1alpha1.RetryTopologyStatus {
+		_ = client.Get(
+			ctx,
+			types.NamespacedName{Name: retryTopologyName, Namespace: "default"},
+			&retryTopology,
+		)
+		return retryTopology.Status
+	}
+
+	JustBeforeEach(func() {
+		fakeRabbitMQClient.DeclareExchangeReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareQueueReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareBindingReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeleteQueueReturns(&http.Response{
+			Status:     "204 No Content",
+			StatusCode: http.StatusNoContent,
+		}, nil)
+		fakeRabbitMQClient.DeleteBindingReturns(&http.Response{
+			Status:     "204 No Content",
+			StatusCode: http.StatusNoContent,
+		}, nil)
+		retryTopology = topologyv1alpha1.RetryTopology{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      retryTopologyName,
+				Namespace: "default",
+			},
+			Spec: topologyv1alpha1.RetryTopologySpec{
+				Name:   retryTopologyName,
+				Vhost:  "/",
+				Type:   "quorum",
+				Delays: []metav1.Duration{{Duration: 3 * time.Second}, {Duration: time.Minute}},
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "example-rabbit",
+				},
+			},
+		}
+	})
+
+	When("creating a retry topology", func() {
+		BeforeEach(func() {
+			retryTopologyName = "retry-creation"
+		})
+
+		It("creates the work queue and a retry queue per delay", func() {
+			Expect(client.Create(ctx, &retryTopology)).To(Succeed())
+
+			By("creating the work exchange, queue and binding", func() {
+				var exchange topology.Exchange
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-exchange", Namespace: "default"}, &exchange)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(exchange.Spec.Type).To(Equal("fanout"))
+
+				var queue topology.Queue
+				Expect(client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-queue", Namespace: "default"}, &queue)).To(Succeed())
+				Expect(queue.Spec.Name).To(Equal(retryTopologyName))
+
+				var binding topology.Binding
+				Expect(client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-binding", Namespace: "default"}, &binding)).To(Succeed())
+			})
+
+			By("creating a retry queue per delay, dead lettering to the work exchange", func() {
+				var queue topology.Queue
+				EventuallyWithOffset(1, func() error {
+					return client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-60000", Namespace: "default"}, &queue)
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(queue.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Name":               Equal(retryTopologyName + ".retry.60000"),
+					"Type":               Equal("quorum"),
+					"MessageTTL":         PointTo(BeNumerically("==", 60000)),
+					"DeadLetterExchange": Equal(retryTopologyName),
+				}))
+
+				var binding topology.Binding
+				Expect(client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-60000-binding", Namespace: "default"}, &binding)).To(Succeed())
+				Expect(binding.Spec).To(MatchFields(IgnoreExtras, Fields{
+					"Source":      Equal(retryTopologyName + ".retry"),
+					"Destination": Equal(retryTopologyName + ".retry.60000"),
+					"RoutingKey":  Equal("60000"),
+				}))
+			})
+
+			By("listing the retry tiers in status", func() {
+				EventuallyWithOffset(1, func() []topologyv1alpha1.RetryTier {
+					return fetchRetryTopology().Tiers
+				}, 10*time.Second, 1*time.Second).Should(Equal([]topologyv1alpha1.RetryTier{
+					{Delay: metav1.Duration{Duration: 3 * time.Second}, Queue: retryTopologyName + ".retry.3000", RoutingKey: "3000"},
+					{Delay: metav1.Duration{Duration: time.Minute}, Queue: retryTopologyName + ".retry.60000", RoutingKey: "60000"},
+				}))
+				Expect(retryTopology.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+			})
+		})
+	})
+
+	When("a delay is removed", func() {
+		BeforeEach(func() {
+			retryTopologyName = "retry-removal"
+		})
+
+		It("unbinds the retry queue, then deletes it once its delay has elapsed", func() {
+			Expect(client.Create(ctx, &retryTopology)).To(Succeed())
+			Eventually(func() []topologyv1alpha1.RetryTier {
+				return fetchRetryTopology().Tiers
+			}, 10*time.Second, 1*time.Second).Should(HaveLen(2))
+
+			retryTopology.Spec.Delays = []metav1.Duration{{Duration: time.Minute}}
+			Expect(client.Update(ctx, &retryTopology)).To(Succeed())
+
+			By("deleting the binding of the retry queue", func() {
+				Eventually(func() bool {
+					var binding topology.Binding
+					err := client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-3000-binding", Namespace: "default"}, &binding)
+					return apierrors.IsNotFound(err)
+				}, 10*time.Second, 1*time.Second).Should(BeTrue())
+			})
+
+			By("reporting the retry queue as draining", func() {
+				Eventually(func() []topologyv1alpha1.RetryTier {
+					return fetchRetryTopology().Tiers
+				}, 10*time.Second, 500*time.Millisecond).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Queue":         Equal(retryTopologyName + ".retry.3000"),
+					"DrainingSince": Not(BeNil()),
+				})))
+			})
+
+			By("deleting the retry queue once drained", func() {
+				Eventually(func() bool {
+					var queue topology.Queue
+					err := client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-3000", Namespace: "default"}, &queue)
+					return apierrors.IsNotFound(err)
+				}, 15*time.Second, 1*time.Second).Should(BeTrue())
+				Eventually(func() []topologyv1alpha1.RetryTier {
+					return fetchRetryTopology().Tiers
+				}, 10*time.Second, 1*time.Second).Should(HaveLen(1))
+			})
+
+			By("keeping the retry queues of other delays", func() {
+				var queue topology.Queue
+				Expect(client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-60000", Namespace: "default"}, &queue)).To(Succeed())
+				Expect(queue.DeletionTimestamp).To(BeNil())
+				var binding topology.Binding
+				Expect(client.Get(ctx, types.NamespacedName{Name: retryTopologyName + "-retry-60000-binding", Namespace: "default"}, &binding)).To(Succeed())
+			})
+		})
+	})
+})
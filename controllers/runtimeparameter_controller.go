@@ -0,0 +1,279 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rabbitmq/messaging-topology-operator/internal"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+// RuntimeParameterReconciler reconciles a RuntimeParameter object
+type RuntimeParameterReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	RabbitmqClientFactory   rabbitmqclient.Factory
+	KubernetesClusterDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=runtimeparameters,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=runtimeparameters/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=runtimeparameters/status,verbs=get;update;patch
+
+func (r *RuntimeParameterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	parameter := &topology.RuntimeParameter{}
+	if err := r.Get(ctx, req.NamespacedName, parameter); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	systemCertPool, err := extractSystemCertPool(ctx, r.Recorder, parameter)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	credsProvider, tlsEnabled, err := rabbitmqclient.ParseReference(ctx, r.Client, parameter.Spec.RabbitmqClusterReference, parameter.Namespace, r.KubernetesClusterDomain)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, parameter, &parameter.Status.Conditions, err)
+	}
+
+	rabbitClient, err := r.RabbitmqClientFactory(credsProvider, tlsEnabled, systemCertPool)
+	if err != nil {
+		logger.Error(err, failedGenerateRabbitClient)
+		return reconcile.Result{}, err
+	}
+
+	owner, err := r.findOwner(ctx, parameter)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	conflicted := owner != nil
+
+	if !parameter.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("Deleting")
+		if conflicted {
+			// the runtime parameter in RabbitMQ belongs to another RuntimeParameter object; leave it in place
+			logger.Info("runtime parameter is managed by another object; no need to delete it", "owner", client.ObjectKeyFromObject(owner))
+			r.Recorder.Event(parameter, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted runtime parameter")
+			return ctrl.Result{}, removeFinalizer(ctx, r.Client, parameter)
+		}
+		return ctrl.Result{}, r.deleteRuntimeParameter(ctx, rabbitClient, parameter)
+	}
+
+	if err := addFinalizerIfNeeded(ctx, r.Client, parameter); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if conflicted {
+		msg := fmt.Sprintf("runtime parameter '%s' is already managed by RuntimeParameter '%s'", parameter.Spec.Name, client.ObjectKeyFromObject(owner))
+		r.Recorder.Event(parameter, corev1.EventTypeWarning, "Conflict", msg)
+		logger.Info(msg)
+		parameter.Status.Conditions = []topology.Condition{
+			topology.NotReady(msg, parameter.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, parameter)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", parameter.Status)
+		}
+		// no requeue; this object is reconciled again when the owning object changes or gets deleted
+		return ctrl.Result{}, nil
+	}
+
+	spec, err := json.Marshal(parameter.Spec)
+	if err != nil {
+		logger.Error(err, failedMarshalSpec)
+	}
+
+	logger.Info("Start reconciling",
+		"spec", string(spec))
+
+	if err := r.putRuntimeParameter(ctx, rabbitClient, parameter); err != nil {
+		// Set Condition 'Ready' to false with message
+		parameter.Status.Conditions = []topology.Condition{
+			topology.NotReady(err.Error(), parameter.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, parameter)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", parameter.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	parameter.Status.Conditions = []topology.Condition{topology.Ready(parameter.Status.Conditions)}
+	parameter.Status.ObservedGeneration = parameter.GetGeneration()
+	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, parameter)
+	}); writerErr != nil {
+		logger.Error(writerErr, failedStatusUpdate, "status", parameter.Status)
+	}
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+// findOwner returns the RuntimeParameter object that manages the same runtime parameter as the given object
+// when the given object is not the one managing it; returns nil otherwise
+// the oldest object targeting a runtime parameter manages it; objects created at the same time are ordered by namespace and name
+func (r *RuntimeParameterReconciler) findOwner(ctx context.Context, parameter *topology.RuntimeParameter) (*topology.RuntimeParameter, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	conflicts, err := r.listConflicts(ctx, parameter)
+	if err != nil {
+		logger.Error(err, "failed to list runtime parameters")
+		return nil, err
+	}
+
+	for i := range conflicts {
+		if managesBefore(&conflicts[i], parameter) {
+			return &conflicts[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// listConflicts returns all other RuntimeParameter objects which target the same runtime parameter as the given object
+func (r *RuntimeParameterReconciler) listConflicts(ctx context.Context, parameter *topology.RuntimeParameter) ([]topology.RuntimeParameter, error) {
+	var parameters topology.RuntimeParameterList
+	if err := r.List(ctx, &parameters); err != nil {
+		return nil, err
+	}
+
+	key := internal.RuntimeParameterKey(parameter)
+	var conflicts []topology.RuntimeParameter
+	for _, p := range parameters.Items {
+		if p.Namespace == parameter.Namespace && p.Name == parameter.Name {
+			continue
+		}
+		if internal.RuntimeParameterKey(&p) == key {
+			conflicts = append(conflicts, p)
+		}
+	}
+	return conflicts, nil
+}
+
+// managesBefore returns true if object a takes precedence over object b for managing the same runtime parameter
+func managesBefore(a, b *topology.RuntimeParameter) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// creates or updates a given runtime parameter using rabbithole client.PutRuntimeParameter
+// global runtime parameters are set with client.PutGlobalParameter
+func (r *RuntimeParameterReconciler) putRuntimeParameter(ctx context.Context, client rabbitmqclient.Client, parameter *topology.RuntimeParameter) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	value, err := internal.GenerateRuntimeParameterValue(parameter)
+	if err != nil {
+		msg := "failed to generate runtime parameter value"
+		r.Recorder.Event(parameter, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg)
+		return err
+	}
+
+	if parameter.Spec.Component == "" {
+		err = validateResponse(client.PutGlobalParameter(parameter.Spec.Name, value))
+	} else {
+		err = validateResponse(client.PutRuntimeParameter(parameter.Spec.Component, internal.RuntimeParameterVhost(parameter), parameter.Spec.Name, value))
+	}
+	if err != nil {
+		msg := "failed to create runtime parameter"
+		r.Recorder.Event(parameter, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg, "component", parameter.Spec.Component, "parameter", parameter.Spec.Name)
+		return err
+	}
+
+	logger.Info("Successfully created runtime parameter", "component", parameter.Spec.Component, "parameter", parameter.Spec.Name)
+	r.Recorder.Event(parameter, corev1.EventTypeNormal, "SuccessfulCreateOrUpdate", "successfully created/updated runtime parameter")
+	return nil
+}
+
+// deletes runtime parameter from rabbitmq server
+// if server responds with '404' Not Found, it logs and does not requeue on error
+func (r *RuntimeParameterReconciler) deleteRuntimeParameter(ctx context.Context, client rabbitmqclient.Client, parameter *topology.RuntimeParameter) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	var err error
+	if parameter.Spec.Component == "" {
+		err = validateResponseForDeletion(client.DeleteGlobalParameter(parameter.Spec.Name))
+	} else {
+		err = validateResponseForDeletion(client.DeleteRuntimeParameter(parameter.Spec.Component, internal.RuntimeParameterVhost(parameter), parameter.Spec.Name))
+	}
+	if errors.Is(err, NotFound) {
+		logger.Info("cannot find runtime parameter in rabbitmq server; already deleted", "component", parameter.Spec.Component, "parameter", parameter.Spec.Name)
+	} else if err != nil {
+		msg := "failed to delete runtime parameter"
+		r.Recorder.Event(parameter, corev1.EventTypeWarning, "FailedDelete", msg)
+		logger.Error(err, msg, "component", parameter.Spec.Component, "parameter", parameter.Spec.Name)
+		return err
+	}
+	r.Recorder.Event(parameter, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted runtime parameter")
+	return removeFinalizer(ctx, r.Client, parameter)
+}
+
+// enqueueConflicts maps a RuntimeParameter to all other RuntimeParameter objects targeting the same runtime parameter,
+// so that a conflicting object takes over once the object managing the runtime parameter is deleted
+func (r *RuntimeParameterReconciler) enqueueConflicts(object client.Object) []reconcile.Request {
+	parameter, ok := object.(*topology.RuntimeParameter)
+	if !ok {
+		return nil
+	}
+
+	ctx := context.Background()
+	conflicts, err := r.listConflicts(ctx, parameter)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, "failed to list runtime parameters")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, p := range conflicts {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: p.Name, Namespace: p.Namespace}})
+	}
+	return requests
+}
+
+func (r *RuntimeParameterReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesClusterDomain = domainName
+}
+
+func (r *RuntimeParameterReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topology.RuntimeParameter{}).
+		Watches(&source.Kind{Type: &topology.RuntimeParameter{}}, handler.EnqueueRequestsFromMapFunc(r.enqueueConflicts)).
+		Complete(r)
+}
@@ -0,0 +1,454 @@
+package controllers_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("runtime-parameter-controller", func() {
+	var parameter topology.RuntimeParameter
+	var parameterName string
+
+	When("validating RabbitMQ Client failures", func() {
+		JustBeforeEach(func() {
+			parameter = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      parameterName,
+					Namespace: "default",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      parameterName,
+					Component: "federation-upstream-set",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-1"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+		})
+
+		Context("creation", func() {
+			When("the RabbitMQ Client returns a HTTP error response", func() {
+				BeforeEach(func() {
+					parameterName = "test-parameter-http-error"
+					fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+						Status:     "418 I'm a teapot",
+						StatusCode: 418,
+					}, errors.New("a failure"))
+				})
+
+				It("sets the status condition", func() {
+					Expect(client.Create(ctx, &parameter)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+							&parameter,
+						)
+
+						return parameter.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(topology.ConditionType("Ready")),
+						"Reason":  Equal("FailedCreateOrUpdate"),
+						"Status":  Equal(corev1.ConditionFalse),
+						"Message": ContainSubstring("a failure"),
+					})))
+				})
+			})
+
+			When("the RabbitMQ Client returns a Go error response", func() {
+				BeforeEach(func() {
+					parameterName = "test-parameter-go-error"
+					fakeRabbitMQClient.PutRuntimeParameterReturns(nil, errors.New("a go failure"))
+				})
+
+				It("sets the status condition to indicate a failure to reconcile", func() {
+					Expect(client.Create(ctx, &parameter)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+							&parameter,
+						)
+
+						return parameter.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(topology.ConditionType("Ready")),
+						"Reason":  Equal("FailedCreateOrUpdate"),
+						"Status":  Equal(corev1.ConditionFalse),
+						"Message": ContainSubstring("a go failure"),
+					})))
+				})
+			})
+
+			When("success", func() {
+				BeforeEach(func() {
+					parameterName = "test-parameter-create-success"
+					fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+						Status:     "201 Created",
+						StatusCode: http.StatusCreated,
+					}, nil)
+				})
+
+				It("sets the status condition 'Ready' to 'true' ", func() {
+					Expect(client.Create(ctx, &parameter)).To(Succeed())
+					EventuallyWithOffset(1, func() []topology.Condition {
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+							&parameter,
+						)
+
+						return parameter.Status.Conditions
+					}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(topology.ConditionType("Ready")),
+						"Reason": Equal("SuccessfulCreateOrUpdate"),
+						"Status": Equal(corev1.ConditionTrue),
+					})))
+				})
+			})
+		})
+
+		Context("deletion", func() {
+			JustBeforeEach(func() {
+				fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+					Status:     "201 Created",
+					StatusCode: http.StatusCreated,
+				}, nil)
+				Expect(client.Create(ctx, &parameter)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					_ = client.Get(
+						ctx,
+						types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+						&parameter,
+					)
+
+					return parameter.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(topology.ConditionType("Ready")),
+					"Reason": Equal("SuccessfulCreateOrUpdate"),
+					"Status": Equal(corev1.ConditionTrue),
+				})))
+			})
+
+			When("the RabbitMQ Client returns a HTTP error response", func() {
+				BeforeEach(func() {
+					parameterName = "delete-runtime-parameter-http-error"
+					fakeRabbitMQClient.DeleteRuntimeParameterReturns(&http.Response{
+						Status:     "502 Bad Gateway",
+						StatusCode: http.StatusBadGateway,
+						Body:       ioutil.NopCloser(bytes.NewBufferString("Hello World")),
+					}, nil)
+				})
+
+				It("publishes a 'warning' event", func() {
+					Expect(client.Delete(ctx, &parameter)).To(Succeed())
+					Consistently(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, &topology.RuntimeParameter{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeFalse())
+					Expect(observedEvents()).To(ContainElement("Warning FailedDelete failed to delete runtime parameter"))
+				})
+			})
+
+			When("the RabbitMQ Client returns a Go error response", func() {
+				BeforeEach(func() {
+					parameterName = "delete-parameter-go-error"
+					fakeRabbitMQClient.DeleteRuntimeParameterReturns(nil, errors.New("some error"))
+				})
+
+				It("publishes a 'warning' event", func() {
+					Expect(client.Delete(ctx, &parameter)).To(Succeed())
+					Consistently(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, &topology.RuntimeParameter{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeFalse())
+					Expect(observedEvents()).To(ContainElement("Warning FailedDelete failed to delete runtime parameter"))
+				})
+			})
+
+			When("the RabbitMQ Client successfully deletes a runtime parameter", func() {
+				BeforeEach(func() {
+					parameterName = "delete-runtime-parameter-success"
+					fakeRabbitMQClient.DeleteRuntimeParameterReturns(&http.Response{
+						Status:     "204 No Content",
+						StatusCode: http.StatusNoContent,
+					}, nil)
+				})
+
+				It("publishes a normal event", func() {
+					Expect(client.Delete(ctx, &parameter)).To(Succeed())
+					Eventually(func() bool {
+						err := client.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, &topology.RuntimeParameter{})
+						return apierrors.IsNotFound(err)
+					}, 5).Should(BeTrue())
+					Expect(observedEvents()).To(SatisfyAll(
+						Not(ContainElement("Warning FailedDelete failed to delete runtime parameter")),
+						ContainElement("Normal SuccessfulDelete successfully deleted runtime parameter"),
+					))
+				})
+			})
+		})
+
+		Context("finalizer", func() {
+			BeforeEach(func() {
+				parameterName = "parameter-finalizer-test"
+			})
+
+			It("sets the correct deletion finalizer to the object", func() {
+				Expect(client.Create(ctx, &parameter)).To(Succeed())
+				Eventually(func() []string {
+					var fetched topology.RuntimeParameter
+					err := client.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, &fetched)
+					if err != nil {
+						return []string{}
+					}
+					return fetched.ObjectMeta.Finalizers
+				}, 5).Should(ConsistOf("deletion.finalizers.runtimeparameters.rabbitmq.com"))
+			})
+		})
+	})
+
+	When("two runtime parameters target the same runtime parameter", func() {
+		var conflicting topology.RuntimeParameter
+
+		JustBeforeEach(func() {
+			parameterName = "test-runtime-parameter-owner"
+			parameter = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      parameterName,
+					Namespace: "default",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      "a-conflicting-set",
+					Component: "federation-upstream-set",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-1"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			conflicting = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-runtime-parameter-conflicting",
+					Namespace: "default",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      "a-conflicting-set",
+					Component: "federation-upstream-set",
+					Vhost:     "/",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-2"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+			fakeRabbitMQClient.DeleteRuntimeParameterReturns(&http.Response{
+				Status:     "204 No Content",
+				StatusCode: http.StatusNoContent,
+			}, nil)
+		})
+
+		It("only lets the oldest object manage the runtime parameter", func() {
+			Expect(client.Create(ctx, &parameter)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+					&parameter,
+				)
+
+				return parameter.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+
+			// creation timestamps have a one second resolution
+			time.Sleep(time.Second)
+			Expect(client.Create(ctx, &conflicting)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: conflicting.Name, Namespace: conflicting.Namespace},
+					&conflicting,
+				)
+
+				return conflicting.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Reason":  Equal("FailedCreateOrUpdate"),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("is already managed by RuntimeParameter 'default/test-runtime-parameter-owner'"),
+			})))
+			Expect(observedEvents()).To(ContainElement(ContainSubstring("Warning Conflict")))
+
+			By("taking over once the owning object is deleted")
+			Expect(client.Delete(ctx, &parameter)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: conflicting.Name, Namespace: conflicting.Namespace},
+					&conflicting,
+				)
+
+				return conflicting.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+	})
+
+	When("a runtime parameter references a cluster from a prohibited namespace", func() {
+		JustBeforeEach(func() {
+			parameterName = "test-runtime-parameter-prohibited"
+			parameter = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      parameterName,
+					Namespace: "prohibited",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      parameterName,
+					Component: "federation-upstream-set",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-1"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "example-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+		})
+		It("should throw an error about a cluster being prohibited", func() {
+			Expect(client.Create(ctx, &parameter)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+					&parameter,
+				)
+
+				return parameter.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Reason":  Equal("FailedCreateOrUpdate"),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("not allowed to reference"),
+			})))
+		})
+	})
+
+	When("a runtime parameter references a cluster from an allowed namespace", func() {
+		JustBeforeEach(func() {
+			parameterName = "test-runtime-parameter-allowed"
+			parameter = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      parameterName,
+					Namespace: "allowed",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      parameterName,
+					Component: "federation-upstream-set",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-1"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "example-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+		It("should be created", func() {
+			Expect(client.Create(ctx, &parameter)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+					&parameter,
+				)
+
+				return parameter.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+	})
+
+	When("a runtime parameter references a cluster that allows all namespaces", func() {
+		JustBeforeEach(func() {
+			parameterName = "test-runtime-parameter-allowed-when-allow-all"
+			parameter = topology.RuntimeParameter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      parameterName,
+					Namespace: "prohibited",
+				},
+				Spec: topology.RuntimeParameterSpec{
+					Name:      parameterName,
+					Component: "federation-upstream-set",
+					Value: &runtime.RawExtension{
+						Raw: []byte(`[{"upstream":"upstream-1"}]`),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name:      "allow-all-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutRuntimeParameterReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+		It("should be created", func() {
+			Expect(client.Create(ctx, &parameter)).To(Succeed())
+			EventuallyWithOffset(1, func() []topology.Condition {
+				_ = client.Get(
+					ctx,
+					types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace},
+					&parameter,
+				)
+
+				return parameter.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+		})
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"time"
 
 	"github.com/rabbitmq/messaging-topology-operator/internal"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
@@ -12,7 +14,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,6 +26,13 @@ import (
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 )
 
+// shovelStatusPollInterval is how often the live state of a declared shovel is refreshed in its status
+// the shovel is not declared again when polling, unless its definition changed
+const shovelStatusPollInterval = 30 * time.Second
+
+// serverNamedQueuePattern matches the names of server-named queues
+const serverNamedQueuePattern = "^amq\\.gen-"
+
 // ShovelReconciler reconciles a Shovel object
 type ShovelReconciler struct {
 	client.Client
@@ -30,11 +41,17 @@ type ShovelReconciler struct {
 	Recorder                record.EventRecorder
 	RabbitmqClientFactory   rabbitmqclient.Factory
 	KubernetesClusterDomain string
+	declared                declaredDefinitions
 }
 
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=shovels,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=shovels/finalizers,verbs=update
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=shovels/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=users,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=permissions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 func (r *ShovelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := ctrl.LoggerFrom(ctx)
@@ -78,18 +95,23 @@ func (r *ShovelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 
 	if err := r.declareShovel(ctx, rabbitClient, shovel); err != nil {
 		// Set Condition 'Ready' to false with message
-		shovel.Status.Conditions = []topology.Condition{
-			topology.NotReady(err.Error(), shovel.Status.Conditions),
-		}
+		shovel.Status.Conditions = notReadyConditions(err.Error(), shovel.Status.Conditions)
 		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
 			return r.Status().Update(ctx, shovel)
 		}); writerErr != nil {
 			logger.Error(writerErr, failedStatusUpdate, "status", shovel.Status)
 		}
+		if errors.Is(err, errClusterEndpointCredentialsNotReady) {
+			// reconciled again once the credentials secret of the source or destination user is created
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 
-	shovel.Status.Conditions = []topology.Condition{topology.Ready(shovel.Status.Conditions)}
+	shovel.Status.Conditions = []topology.Condition{
+		topology.Ready(shovel.Status.Conditions),
+		r.updateShovelState(ctx, rabbitClient, shovel),
+	}
 	shovel.Status.ObservedGeneration = shovel.GetGeneration()
 	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
 		return r.Status().Update(ctx, shovel)
@@ -98,51 +120,170 @@ func (r *ShovelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	}
 	logger.Info("Finished reconciling")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: shovelStatusPollInterval}, nil
 }
 
+// updateShovelState sets state, lastError and node in shovel status from the shovel status reported by RabbitMQ
+// returns condition 'Running' which is true only when the shovel is running
+func (r *ShovelReconciler) updateShovelState(ctx context.Context, client rabbitmqclient.Client, shovel *topology.Shovel) topology.Condition {
+	logger := ctrl.LoggerFrom(ctx)
+
+	status, err := client.GetShovelStatus(shovel.Spec.Vhost, shovel.Spec.Name)
+	if err != nil {
+		logger.Error(err, "failed to get shovel status", "shovel", shovel.Spec.Name)
+		return topology.NotRunning("FailedStatusQuery", err.Error(), shovel.Status.Conditions)
+	}
+	if status == nil {
+		shovel.Status.State = ""
+		shovel.Status.Node = ""
+		// declared again on next reconcile, in case it was deleted from RabbitMQ
+		r.declared.forget(shovel.UID)
+		return topology.NotRunning("NotReported", "shovel status is not reported by RabbitMQ", shovel.Status.Conditions)
+	}
+
+	shovel.Status.State = status.State
+	shovel.Status.Node = status.Node
+	if status.Reason != "" {
+		shovel.Status.LastError = status.Reason
+	}
+
+	switch status.State {
+	case "running":
+		shovel.Status.LastError = ""
+		return topology.Running(shovel.Status.Conditions)
+	case "starting":
+		return topology.NotRunning("Starting", "shovel is starting", shovel.Status.Conditions)
+	case "terminated":
+		return topology.NotRunning("Terminated", status.Reason, shovel.Status.Conditions)
+	default:
+		return topology.NotRunning("UnknownState", fmt.Sprintf("shovel state is '%s'", status.State), shovel.Status.Conditions)
+	}
+}
+
+// declareShovel declares the shovel in RabbitMQ, unless its definition did not change since it was last declared
 func (r *ShovelReconciler) declareShovel(ctx context.Context, client rabbitmqclient.Client, shovel *topology.Shovel) error {
 	logger := ctrl.LoggerFrom(ctx)
 
 	srcUri, destUri, err := r.getUris(ctx, shovel)
+	if errors.Is(err, errClusterEndpointCredentialsNotReady) {
+		logger.Info("Waiting for the credentials of the source and destination users", "shovel", shovel.Spec.Name)
+		return err
+	}
 	if err != nil {
-		msg := "failed to parse shovel uri secret"
+		msg := "failed to get shovel uris"
 		r.Recorder.Event(shovel, corev1.EventTypeWarning, "FailedUpdate", msg)
-		logger.Error(err, msg, "uri secret", shovel.Spec.UriSecret.Name)
+		logger.Error(err, msg)
 		return err
 	}
 
-	if err := validateResponse(client.DeclareShovel(shovel.Spec.Vhost, shovel.Spec.Name, internal.GenerateShovelDefinition(shovel, srcUri, destUri))); err != nil {
+	definition := internal.GenerateShovelDefinition(shovel, srcUri, destUri)
+	if !r.declared.changed(shovel.UID, definition) {
+		return nil
+	}
+
+	if err := validateResponse(client.DeclareShovel(shovel.Spec.Vhost, shovel.Spec.Name, definition)); err != nil {
 		msg := "failed to declare shovel"
 		r.Recorder.Event(shovel, corev1.EventTypeWarning, "FailedUpdate", msg)
 		logger.Error(err, msg, "shovel", shovel.Spec.Name)
 		return err
 	}
+	r.declared.record(shovel.UID, definition)
 
 	logger.Info("Successfully declare shovel", "shovel", shovel.Spec.Name)
 	r.Recorder.Event(shovel, corev1.EventTypeNormal, "SuccessfulUpdate", "Successfully declare shovel")
 	return nil
 }
+
+// getUris returns the source and destination uris; each is generated for spec.srcCluster or spec.destCluster when set,
+// and read from spec.uriSecret otherwise
 func (r *ShovelReconciler) getUris(ctx context.Context, shovel *topology.Shovel) (string, string, error) {
-	if shovel.Spec.UriSecret == nil {
-		return "", "", fmt.Errorf("no uri secret provided")
+	var secret *corev1.Secret
+	if shovel.Spec.SourceCluster == nil || shovel.Spec.DestinationCluster == nil {
+		if shovel.Spec.UriSecret == nil {
+			return "", "", fmt.Errorf("no uri secret provided")
+		}
+		secret = &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: shovel.Spec.UriSecret.Name, Namespace: shovel.Namespace}, secret); err != nil {
+			return "", "", err
+		}
+	}
+
+	var srcUri string
+	if shovel.Spec.SourceCluster != nil {
+		uri, err := clusterEndpointURI(ctx, r.Client, r.Scheme, shovel, "-source", shovel.Spec.SourceCluster, shovelSourcePermissions(&shovel.Spec), r.KubernetesClusterDomain)
+		if err != nil {
+			return "", "", err
+		}
+		srcUri = uri
+	} else {
+		uri, ok := secret.Data["srcUri"]
+		if !ok {
+			return "", "", fmt.Errorf("could not find key 'srcUri' in secret %s", secret.Name)
+		}
+		srcUri = string(uri)
 	}
-	secret := &corev1.Secret{}
-	if err := r.Get(ctx, types.NamespacedName{Name: shovel.Spec.UriSecret.Name, Namespace: shovel.Namespace}, secret); err != nil {
-		return "", "", err
+
+	var destUri string
+	if shovel.Spec.DestinationCluster != nil {
+		uri, err := clusterEndpointURI(ctx, r.Client, r.Scheme, shovel, "-destination", shovel.Spec.DestinationCluster, shovelDestinationPermissions(&shovel.Spec), r.KubernetesClusterDomain)
+		if err != nil {
+			return "", "", err
+		}
+		destUri = uri
+	} else {
+		uri, ok := secret.Data["destUri"]
+		if !ok {
+			return "", "", fmt.Errorf("could not find key 'destUri' in secret %s", secret.Name)
+		}
+		destUri = string(uri)
 	}
 
-	srcUri, ok := secret.Data["srcUri"]
-	if !ok {
-		return "", "", fmt.Errorf("could not find key 'srcUri' in secret %s", secret.Name)
+	return srcUri, destUri, nil
+}
+
+// shovelSourcePermissions are the permissions of the user provisioned in the source cluster
+// a shovel consuming from srcQueue declares and consumes from that queue; a shovel consuming from srcExchange
+// declares a server-named queue, binds it to srcExchange and consumes from it
+func shovelSourcePermissions(spec *topology.ShovelSpec) topology.VhostPermissions {
+	if spec.SourceQueue != "" {
+		return topology.VhostPermissions{
+			Configure: exactNamePattern(spec.SourceQueue),
+			Read:      exactNamePattern(spec.SourceQueue),
+		}
 	}
+	if spec.SourceExchange != "" {
+		return topology.VhostPermissions{
+			Configure: serverNamedQueuePattern,
+			Write:     serverNamedQueuePattern,
+			Read:      fmt.Sprintf("^(%s|amq\\.gen-.*)$", regexp.QuoteMeta(spec.SourceExchange)),
+		}
+	}
+	return topology.VhostPermissions{}
+}
 
-	destUri, ok := secret.Data["destUri"]
-	if !ok {
-		return "", "", fmt.Errorf("could not find key 'srcUri' in secret %s", secret.Name)
+// shovelDestinationPermissions are the permissions of the user provisioned in the destination cluster
+// a shovel publishing to destExchange only publishes to that exchange; a shovel publishing to destQueue declares that queue
+// and publishes to it through the default exchange; otherwise messages are published with their original exchange
+func shovelDestinationPermissions(spec *topology.ShovelSpec) topology.VhostPermissions {
+	if spec.DestinationExchange != "" {
+		return topology.VhostPermissions{
+			Write: exactNamePattern(spec.DestinationExchange),
+		}
+	}
+	if spec.DestinationQueue != "" {
+		return topology.VhostPermissions{
+			Configure: exactNamePattern(spec.DestinationQueue),
+			Write:     exactNamePattern("amq.default"),
+		}
 	}
+	return topology.VhostPermissions{
+		Write: ".*",
+	}
+}
 
-	return string(srcUri), string(destUri), nil
+// exactNamePattern returns a permission pattern matching exactly the given resource name
+func exactNamePattern(name string) string {
+	return "^" + regexp.QuoteMeta(name) + "$"
 }
 
 // deletes shovel configuration from rabbitmq server
@@ -160,6 +301,7 @@ func (r *ShovelReconciler) deleteShovel(ctx context.Context, client rabbitmqclie
 		return err
 	}
 	r.Recorder.Event(shovel, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted shovel parameter")
+	r.declared.forget(shovel.UID)
 	return removeFinalizer(ctx, r.Client, shovel)
 }
 
@@ -170,5 +312,8 @@ func (r *ShovelReconciler) SetInternalDomainName(domainName string) {
 func (r *ShovelReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&topology.Shovel{}).
+		Owns(&topology.User{}).
+		Owns(&topology.Permission{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(clusterEndpointOwners(mgr.GetClient(), "Shovel"))).
 		Complete(r)
 }
@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// declaredDefinitions records a hash of the definition last declared in RabbitMQ for each object, keyed by its UID,
+// so that an object deleted and created again with the same name is declared again.
+// Objects whose status is polled, such as federations and shovels, are reconciled periodically;
+// the definition is only declared again when it changed, e.g. after an update of the spec or of a uri secret, or after a restart of the operator.
+type declaredDefinitions struct {
+	hashes sync.Map
+}
+
+// changed returns whether definition differs from the definition last declared for the object
+func (d *declaredDefinitions) changed(object types.UID, definition interface{}) bool {
+	hash, err := definitionHash(definition)
+	if err != nil {
+		return true
+	}
+	last, ok := d.hashes.Load(object)
+	return !ok || last != hash
+}
+
+// record records definition as declared for the object
+func (d *declaredDefinitions) record(object types.UID, definition interface{}) {
+	hash, err := definitionHash(definition)
+	if err != nil {
+		d.hashes.Delete(object)
+		return
+	}
+	d.hashes.Store(object, hash)
+}
+
+// forget removes the object, so that its definition is declared on next reconcile
+func (d *declaredDefinitions) forget(object types.UID) {
+	d.hashes.Delete(object)
+}
+
+func definitionHash(definition interface{}) ([sha256.Size]byte, error) {
+	content, err := json.Marshal(definition)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(content), nil
+}
+
+// notReadyConditions returns condition 'Ready' set to false with msg, and keeps the last 'Running' condition, if any,
+// as the object may still be running in RabbitMQ
+func notReadyConditions(msg string, lastConditions []topology.Condition) []topology.Condition {
+	conditions := []topology.Condition{topology.NotReady(msg, lastConditions)}
+	for _, condition := range lastConditions {
+		if condition.Type == topology.ConditionRunning {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
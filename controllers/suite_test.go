@@ -139,10 +139,11 @@ var _ = BeforeSuite(func() {
 			RabbitmqClientFactory: fakeRabbitMQClientFactory,
 		},
 		&controllers.UserReconciler{
-			Client:                mgr.GetClient(),
-			Scheme:                mgr.GetScheme(),
-			Recorder:              fakeRecorder,
-			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+			Client:                     mgr.GetClient(),
+			Scheme:                     mgr.GetScheme(),
+			Recorder:                   fakeRecorder,
+			RabbitmqClientFactory:      fakeRabbitMQClientFactory,
+			VaultCredentialsPathPrefix: "secret/data/rabbitmq/users",
 		},
 		&controllers.VhostReconciler{
 			Client:                mgr.GetClient(),
@@ -174,12 +175,60 @@ var _ = BeforeSuite(func() {
 			Recorder:              fakeRecorder,
 			RabbitmqClientFactory: fakeRabbitMQClientFactory,
 		},
+		&controllers.OperatorPolicyReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.TopicPermissionReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.RuntimeParameterReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.DefinitionsReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.DeadLetterTopologyReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.RetryTopologyReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
+		&controllers.MessagingApplicationReconciler{
+			Client:                mgr.GetClient(),
+			Scheme:                mgr.GetScheme(),
+			Recorder:              fakeRecorder,
+			RabbitmqClientFactory: fakeRabbitMQClientFactory,
+		},
 	}
 
 	for _, controller := range topologyControllers {
 		Expect(controller.SetupWithManager(mgr)).To(Succeed())
 	}
 
+	Expect((&controllers.SuperStreamConsumerReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: fakeRecorder,
+	}).SetupWithManager(mgr)).To(Succeed())
+
 	go func() {
 		err = mgr.Start(ctx)
 		Expect(err).ToNot(HaveOccurred())
@@ -211,6 +260,10 @@ var _ = BeforeSuite(func() {
 					Port: 15671,
 					Name: "management-tls",
 				},
+				{
+					Port: 5671,
+					Name: "amqps",
+				},
 			},
 		},
 	}
@@ -269,6 +322,10 @@ var _ = BeforeSuite(func() {
 					Port: 15671,
 					Name: "management-tls",
 				},
+				{
+					Port: 5672,
+					Name: "amqp",
+				},
 			},
 		},
 	}
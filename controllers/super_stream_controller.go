@@ -13,16 +13,18 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
-	rabbitmqv1beta1 "github.com/rabbitmq/cluster-operator/api/v1beta1"
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	clientretry "k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,6 +33,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const (
+	// partitionRemovalPollInterval is how often a removed partition is checked for its bindings or itself to be deleted
+	partitionRemovalPollInterval = 5 * time.Second
+	// partitionDrainPollInterval is how often a removed partition is checked for consumers
+	partitionDrainPollInterval = 30 * time.Second
+)
+
 // SuperStreamReconciler reconciles a RabbitMQ Super Stream, and any resources it comprises of
 type SuperStreamReconciler struct {
 	client.Client
@@ -42,8 +51,8 @@ type SuperStreamReconciler struct {
 }
 
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=exchanges,verbs=get;create;update;patch;delete
-// +kubebuilder:rbac:groups=rabbitmq.com,resources=queues,verbs=get;create;update;patch;delete
-// +kubebuilder:rbac:groups=rabbitmq.com,resources=bindings,verbs=get;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=queues,verbs=get;list;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=bindings,verbs=get;list;create;update;patch;delete
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreams,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreams/finalizers,verbs=update
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreams/status,verbs=get;update;patch
@@ -60,15 +69,15 @@ func (r *SuperStreamReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	rmqClusterRef, err := r.getRabbitmqClusterReference(ctx, superStream.Spec.RabbitmqClusterReference, superStream.Namespace)
+	rmqClusterRef, err := childRabbitmqClusterReference(ctx, r.Client, superStream.Spec.RabbitmqClusterReference, superStream.Namespace)
 	if err != nil {
 		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, superStream, &superStream.Status.Conditions, err)
 	}
 
 	logger.Info("Start reconciling")
 
-	if superStream.Spec.Partitions < len(superStream.Status.Partitions) {
-		// This would constitute a scale down, which may result in data loss.
+	if superStream.Spec.ScaleDown == nil && superStream.Spec.Partitions < len(superStream.Status.Partitions) {
+		// This would constitute a scale down, which may result in data loss, unless spec.scaleDown is set.
 		err := fmt.Errorf(
 			"SuperStreams cannot be scaled down: an attempt was made to scale from %d partitions to %d",
 			len(superStream.Status.Partitions),
@@ -112,12 +121,16 @@ func (r *SuperStreamReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	for index, routingKey := range routingKeys {
 		builders = append(
 			builders,
-			managedResourceBuilder.SuperStreamPartition(index, routingKey, superStream.Spec.Vhost, rmqClusterRef),
+			managedResourceBuilder.SuperStreamPartition(index, routingKey, superStream.Spec.Vhost, superStream.Spec.Stream, rmqClusterRef),
 			managedResourceBuilder.SuperStreamBinding(index, routingKey, superStream.Spec.Vhost, rmqClusterRef),
 		)
+		for _, bindingKey := range superStream.Spec.BindingKeys[routingKey] {
+			builders = append(builders, managedResourceBuilder.SuperStreamAdditionalBinding(index, routingKey, bindingKey, superStream.Spec.Vhost, rmqClusterRef))
+		}
 	}
 
 	var partitionQueueNames []string
+	bindingNames := make(map[string]bool)
 	for _, builder := range builders {
 		resource, err := builder.Build()
 		if err != nil {
@@ -139,13 +152,36 @@ func (r *SuperStreamReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return ctrl.Result{}, err
 		}
 
-		if builder.ResourceType() == "Partition" {
+		switch builder.ResourceType() {
+		case "Partition":
 			partition := resource.(*topology.Queue)
 			partitionQueueNames = append(partitionQueueNames, partition.Spec.Name)
+		case "Binding":
+			bindingNames[resource.GetName()] = true
+		}
+	}
+
+	if err := r.deleteRemovedBindingKeys(ctx, superStream, routingKeys, bindingNames); err != nil {
+		if writerErr := r.SetReconcileSuccess(ctx, superStream, topology.NotReady("FailedDeleteBinding", superStream.Status.Conditions)); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", superStream.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	var removals []topologyv1alpha1.SuperStreamPartitionRemoval
+	var requeueAfter time.Duration
+	if superStream.Spec.ScaleDown != nil {
+		removals, requeueAfter, err = r.removePartitions(ctx, superStream, routingKeys)
+		if err != nil {
+			if writerErr := r.SetReconcileSuccess(ctx, superStream, topology.NotReady("FailedRemovePartition", superStream.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", superStream.Status)
+			}
+			return ctrl.Result{}, err
 		}
 	}
 
 	superStream.Status.Partitions = partitionQueueNames
+	superStream.Status.RemovedPartitions = removals
 	if err := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
 		return r.Status().Update(ctx, superStream)
 	}); err != nil {
@@ -158,30 +194,219 @@ func (r *SuperStreamReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	logger.Info("Finished reconciling")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func (r *SuperStreamReconciler) getRabbitmqClusterReference(ctx context.Context, rmq topology.RabbitmqClusterReference, requestNamespace string) (*topology.RabbitmqClusterReference, error) {
-	var namespace string
-	if rmq.Namespace == "" {
-		namespace = requestNamespace
-	} else {
-		namespace = rmq.Namespace
+// deleteRemovedBindingKeys deletes the bindings of the partitions in routingKeys which are not in bindingNames,
+// i.e. the bindings of binding keys removed from spec.bindingKeys; bindings of removed partitions are deleted by removePartitions
+func (r *SuperStreamReconciler) deleteRemovedBindingKeys(ctx context.Context, superStream *topologyv1alpha1.SuperStream, routingKeys []string, bindingNames map[string]bool) error {
+	partitions := make(map[string]bool)
+	for _, routingKey := range routingKeys {
+		partitions[routingKey] = true
 	}
 
-	cluster := &rabbitmqv1beta1.RabbitmqCluster{}
-	if err := r.Get(ctx, types.NamespacedName{Name: rmq.Name, Namespace: namespace}, cluster); err != nil {
-		return nil, fmt.Errorf("failed to get cluster from reference: %s Error: %w", err, rabbitmqclient.NoSuchRabbitmqClusterError)
+	bindings := &topology.BindingList{}
+	if err := r.List(ctx, bindings, client.InNamespace(superStream.Namespace),
+		client.MatchingLabels{managedresource.AnnotationSuperStream: superStream.Name}); err != nil {
+		return err
 	}
 
-	if !rabbitmqclient.AllowedNamespace(rmq, requestNamespace, cluster) {
-		return nil, rabbitmqclient.ResourceNotAllowedError
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if bindingNames[binding.Name] || !partitions[binding.Labels[managedresource.AnnotationSuperStreamRoutingKey]] ||
+			!metav1.IsControlledBy(binding, superStream) || !binding.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, binding); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		ctrl.LoggerFrom(ctx).Info("Deleted binding of a removed binding key", "binding", binding.Name)
 	}
+	return nil
+}
 
-	return &topology.RabbitmqClusterReference{
-		Name:      rmq.Name,
-		Namespace: namespace,
-	}, nil
+// removePartitions removes the partitions whose routing key is no longer in routingKeys, one step at a time:
+// the partition is first unbound from the exchange so that it stops receiving messages, then, when spec.scaleDown.waitForDrain is set,
+// waits until its stream has no consumers or spec.scaleDown.drainTimeout has passed, and is finally deleted.
+// It returns the partitions which are still being removed, and when to check them again.
+func (r *SuperStreamReconciler) removePartitions(ctx context.Context, superStream *topologyv1alpha1.SuperStream, routingKeys []string) ([]topologyv1alpha1.SuperStreamPartitionRemoval, time.Duration, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	keep := make(map[string]bool)
+	for _, routingKey := range routingKeys {
+		keep[routingKey] = true
+	}
+
+	partitions := &topology.QueueList{}
+	if err := r.List(ctx, partitions, client.InNamespace(superStream.Namespace),
+		client.MatchingLabels{managedresource.AnnotationSuperStream: superStream.Name}); err != nil {
+		return nil, 0, err
+	}
+
+	var removals []topologyv1alpha1.SuperStreamPartitionRemoval
+	var requeueAfter time.Duration
+	requeue := func(after time.Duration) {
+		if requeueAfter == 0 || after < requeueAfter {
+			requeueAfter = after
+		}
+	}
+	var rabbitClient rabbitmqclient.Client
+
+	for i := range partitions.Items {
+		partition := &partitions.Items[i]
+		routingKey, ok := partition.Labels[managedresource.AnnotationSuperStreamRoutingKey]
+		if !ok || keep[routingKey] || !metav1.IsControlledBy(partition, superStream) {
+			continue
+		}
+		removal := topologyv1alpha1.SuperStreamPartitionRemoval{
+			Partition:  partition.Spec.Name,
+			RoutingKey: routingKey,
+		}
+
+		if !partition.DeletionTimestamp.IsZero() {
+			removal.Step = topologyv1alpha1.PartitionRemovalDeleting
+			removals = append(removals, removal)
+			requeue(partitionRemovalPollInterval)
+			continue
+		}
+
+		unbound, err := r.unbindPartition(ctx, superStream, routingKey)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !unbound {
+			removal.Step = topologyv1alpha1.PartitionRemovalUnbinding
+			removals = append(removals, removal)
+			requeue(partitionRemovalPollInterval)
+			continue
+		}
+
+		unboundSince, err := time.Parse(time.RFC3339, partition.Annotations[managedresource.AnnotationSuperStreamUnboundSince])
+		if err != nil {
+			unboundSince = time.Now()
+			patchBase := client.MergeFrom(partition.DeepCopy())
+			if partition.Annotations == nil {
+				partition.Annotations = map[string]string{}
+			}
+			partition.Annotations[managedresource.AnnotationSuperStreamUnboundSince] = unboundSince.UTC().Format(time.RFC3339)
+			if err := r.Patch(ctx, partition, patchBase); err != nil {
+				return nil, 0, err
+			}
+			logger.Info("Unbound partition", "partition", partition.Spec.Name)
+			r.Recorder.Event(superStream, corev1.EventTypeNormal, "PartitionUnbound",
+				fmt.Sprintf("Partition %s is no longer bound to the super stream exchange", partition.Spec.Name))
+		}
+		since := metav1.NewTime(unboundSince)
+		removal.UnboundSince = &since
+
+		if superStream.Spec.ScaleDown.WaitForDrain {
+			remaining := superStream.Spec.ScaleDown.DrainTimeoutDuration() - time.Since(unboundSince)
+			if remaining > 0 {
+				if rabbitClient == nil {
+					if rabbitClient, err = r.rabbitmqClient(ctx, superStream); err != nil {
+						return nil, 0, err
+					}
+				}
+				queueInfo, err := rabbitClient.GetQueue(superStream.Spec.Vhost, partition.Spec.Name)
+				if err != nil {
+					removal.Message = fmt.Sprintf("failed to get the consumers and messages of the partition: %s", err)
+				} else {
+					removal.Message = partitionDrainMessage(queueInfo)
+				}
+				if removal.Message != "" {
+					removal.Step = topologyv1alpha1.PartitionRemovalDraining
+					removals = append(removals, removal)
+					if remaining < partitionDrainPollInterval {
+						requeue(remaining)
+					} else {
+						requeue(partitionDrainPollInterval)
+					}
+					continue
+				}
+			} else {
+				r.Recorder.Event(superStream, corev1.EventTypeWarning, "PartitionDrainTimeout",
+					fmt.Sprintf("Partition %s was not drained within %s", partition.Spec.Name, superStream.Spec.ScaleDown.DrainTimeoutDuration()))
+			}
+		}
+
+		if err := r.Delete(ctx, partition); client.IgnoreNotFound(err) != nil {
+			return nil, 0, err
+		}
+		logger.Info("Deleted removed partition", "partition", partition.Spec.Name)
+		r.Recorder.Event(superStream, corev1.EventTypeNormal, "PartitionDeleted",
+			fmt.Sprintf("Partition %s was deleted", partition.Spec.Name))
+		removal.Step = topologyv1alpha1.PartitionRemovalDeleting
+		removal.Message = ""
+		removals = append(removals, removal)
+		requeue(partitionRemovalPollInterval)
+	}
+
+	return removals, requeueAfter, nil
+}
+
+// partitionDrainMessage describes what a removed partition is waiting for before it is deleted;
+// it is empty once the partition has neither consumers nor messages.
+// Consumed messages stay in a stream until they are removed by its retention,
+// so a partition with messages may still have data that its consumers did not read.
+func partitionDrainMessage(queueInfo *rabbithole.DetailedQueueInfo) string {
+	var pending []string
+	if queueInfo.Consumers > 0 {
+		pending = append(pending, fmt.Sprintf("%d consumers", queueInfo.Consumers))
+	}
+	if queueInfo.Messages > 0 {
+		pending = append(pending, fmt.Sprintf("%d messages", queueInfo.Messages))
+	}
+	return strings.Join(pending, ", ")
+}
+
+// unbindPartition deletes the bindings of a removed partition, and returns whether they are gone,
+// since the partition may receive messages until its bindings are deleted from RabbitMQ
+func (r *SuperStreamReconciler) unbindPartition(ctx context.Context, superStream *topologyv1alpha1.SuperStream, routingKey string) (bool, error) {
+	bindings := &topology.BindingList{}
+	if err := r.List(ctx, bindings, client.InNamespace(superStream.Namespace),
+		client.MatchingLabels{
+			managedresource.AnnotationSuperStream:           superStream.Name,
+			managedresource.AnnotationSuperStreamRoutingKey: routingKey,
+		}); err != nil {
+		return false, err
+	}
+
+	unbound := true
+	for i := range bindings.Items {
+		binding := &bindings.Items[i]
+		if !metav1.IsControlledBy(binding, superStream) {
+			continue
+		}
+		unbound = false
+		if !binding.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := r.Delete(ctx, binding); client.IgnoreNotFound(err) != nil {
+			return false, err
+		}
+		r.Recorder.Event(superStream, corev1.EventTypeNormal, "PartitionUnbinding",
+			fmt.Sprintf("Deleting binding %s of a removed partition", binding.Name))
+	}
+	return unbound, nil
+}
+
+func (r *SuperStreamReconciler) rabbitmqClient(ctx context.Context, superStream *topologyv1alpha1.SuperStream) (rabbitmqclient.Client, error) {
+	systemCertPool, err := extractSystemCertPool(ctx, r.Recorder, superStream)
+	if err != nil {
+		return nil, err
+	}
+
+	credsProvider, tlsEnabled, err := rabbitmqclient.ParseReference(ctx, r.Client, superStream.Spec.RabbitmqClusterReference, superStream.Namespace, r.KubernetesInternalDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	rabbitClient, err := r.RabbitmqClientFactory(credsProvider, tlsEnabled, systemCertPool)
+	if err != nil {
+		ctrl.LoggerFrom(ctx).Error(err, failedGenerateRabbitClient)
+		return nil, err
+	}
+	return rabbitClient, nil
 }
 
 func (r *SuperStreamReconciler) generateRoutingKeys(superStream *topologyv1alpha1.SuperStream) (routingKeys []string) {
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -13,8 +14,10 @@ import (
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var _ = Describe("super-stream-controller", func() {
@@ -555,6 +558,123 @@ var _ = Describe("super-stream-controller", func() {
 						})
 					})
 				})
+
+				When("the super stream is scaled down with spec.scaleDown set", func() {
+					BeforeEach(func() {
+						superStreamName = "safe-scale-down-super-stream"
+					})
+
+					It("unbinds the removed partitions, and deletes them once drained", func() {
+						fakeRabbitMQClient.GetQueueReturns(&rabbithole.DetailedQueueInfo{Consumers: 2}, nil)
+						_ = client.Get(
+							ctx,
+							types.NamespacedName{Name: superStreamName, Namespace: "default"},
+							&superStream,
+						)
+						superStream.Spec.Partitions = 1
+						superStream.Spec.ScaleDown = &topologyv1alpha1.SuperStreamScaleDown{WaitForDrain: true}
+						Expect(client.Update(ctx, &superStream)).To(Succeed())
+
+						By("deleting the bindings of the removed partitions", func() {
+							for i := 1; i < 3; i++ {
+								expectedBindingName := fmt.Sprintf("%s-binding-%d", superStreamName, i)
+								EventuallyWithOffset(1, func() bool {
+									var binding topology.Binding
+									err := client.Get(ctx, types.NamespacedName{Name: expectedBindingName, Namespace: "default"}, &binding)
+									return apierrors.IsNotFound(err)
+								}, 10*time.Second, 1*time.Second).Should(BeTrue())
+							}
+						})
+
+						By("reporting the removed partitions as draining while they have consumers", func() {
+							EventuallyWithOffset(1, func() []topologyv1alpha1.SuperStreamPartitionRemoval {
+								_ = client.Get(
+									ctx,
+									types.NamespacedName{Name: superStreamName, Namespace: "default"},
+									&superStream,
+								)
+								return superStream.Status.RemovedPartitions
+							}, 10*time.Second, 1*time.Second).Should(ConsistOf(
+								MatchFields(IgnoreExtras, Fields{
+									"Partition":    Equal(managedresource.RoutingKeyToPartitionName(superStreamName, "1")),
+									"RoutingKey":   Equal("1"),
+									"Step":         Equal(topologyv1alpha1.PartitionRemovalDraining),
+									"UnboundSince": Not(BeNil()),
+									"Message":      Equal("2 consumers"),
+								}),
+								MatchFields(IgnoreExtras, Fields{
+									"Partition": Equal(managedresource.RoutingKeyToPartitionName(superStreamName, "2")),
+									"Step":      Equal(topologyv1alpha1.PartitionRemovalDraining),
+								}),
+							))
+							Expect(superStream.Status.Partitions).To(ConsistOf(managedresource.RoutingKeyToPartitionName(superStreamName, "0")))
+							var partition topology.Queue
+							Expect(client.Get(ctx, types.NamespacedName{Name: superStreamName + "-partition-1", Namespace: "default"}, &partition)).To(Succeed())
+							Expect(observedEvents()).To(ContainElement(fmt.Sprintf("Normal PartitionUnbound Partition %s is no longer bound to the super stream exchange",
+								managedresource.RoutingKeyToPartitionName(superStreamName, "1"))))
+						})
+
+						By("reporting the removed partitions as draining while they have messages", func() {
+							fakeRabbitMQClient.GetQueueReturns(&rabbithole.DetailedQueueInfo{Consumers: 0, Messages: 5}, nil)
+							// trigger a reconcile rather than waiting for the next drain check
+							_ = client.Get(ctx, types.NamespacedName{Name: superStreamName, Namespace: "default"}, &superStream)
+							superStream.Annotations = map[string]string{"test": "consumers-detached"}
+							Expect(client.Update(ctx, &superStream)).To(Succeed())
+
+							EventuallyWithOffset(1, func() []topologyv1alpha1.SuperStreamPartitionRemoval {
+								_ = client.Get(
+									ctx,
+									types.NamespacedName{Name: superStreamName, Namespace: "default"},
+									&superStream,
+								)
+								return superStream.Status.RemovedPartitions
+							}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+								"Partition": Equal(managedresource.RoutingKeyToPartitionName(superStreamName, "1")),
+								"Step":      Equal(topologyv1alpha1.PartitionRemovalDraining),
+								"Message":   Equal("5 messages"),
+							})))
+							var partition topology.Queue
+							Expect(client.Get(ctx, types.NamespacedName{Name: superStreamName + "-partition-1", Namespace: "default"}, &partition)).To(Succeed())
+						})
+
+						By("deleting the removed partitions once they have no consumers and no messages", func() {
+							fakeRabbitMQClient.GetQueueReturns(&rabbithole.DetailedQueueInfo{Consumers: 0, Messages: 0}, nil)
+							// trigger a reconcile rather than waiting for the next drain check
+							_ = client.Get(ctx, types.NamespacedName{Name: superStreamName, Namespace: "default"}, &superStream)
+							superStream.Annotations = map[string]string{"test": "drained"}
+							Expect(client.Update(ctx, &superStream)).To(Succeed())
+
+							for i := 1; i < 3; i++ {
+								expectedQueueName := fmt.Sprintf("%s-partition-%d", superStreamName, i)
+								EventuallyWithOffset(1, func() bool {
+									var partition topology.Queue
+									err := client.Get(ctx, types.NamespacedName{Name: expectedQueueName, Namespace: "default"}, &partition)
+									return apierrors.IsNotFound(err)
+								}, 10*time.Second, 1*time.Second).Should(BeTrue())
+							}
+							EventuallyWithOffset(1, func() []topologyv1alpha1.SuperStreamPartitionRemoval {
+								_ = client.Get(
+									ctx,
+									types.NamespacedName{Name: superStreamName, Namespace: "default"},
+									&superStream,
+								)
+								return superStream.Status.RemovedPartitions
+							}, 10*time.Second, 1*time.Second).Should(BeEmpty())
+						})
+
+						By("keeping the remaining partition and its binding", func() {
+							var partition topology.Queue
+							Expect(client.Get(ctx, types.NamespacedName{Name: superStreamName + "-partition-0", Namespace: "default"}, &partition)).To(Succeed())
+							Expect(partition.DeletionTimestamp).To(BeNil())
+							var binding topology.Binding
+							Expect(client.Get(ctx, types.NamespacedName{Name: superStreamName + "-binding-0", Namespace: "default"}, &binding)).To(Succeed())
+							Expect(superStream.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(topology.ConditionType("Ready")),
+								"Status": Equal(corev1.ConditionTrue),
+							})))
+						})
+					})
+				})
 			})
 
 			When("routing keys are specifically set", func() {
@@ -672,6 +792,99 @@ var _ = Describe("super-stream-controller", func() {
 				})
 			})
 
+			When("stream settings and binding keys are set", func() {
+				BeforeEach(func() {
+					superStreamName = "binding-keys-stream"
+				})
+
+				It("applies the stream settings to the partitions, and binds them with their binding keys", func() {
+					superStream.Spec.RoutingKeys = []string{"emea", "amer", "apac"}
+					superStream.Spec.BindingKeys = map[string][]string{"emea": {"eu-west", "eu-north"}}
+					superStream.Spec.Stream = &topology.StreamSettings{MaxAge: "7D", MaxLengthBytes: 10000000}
+					Expect(client.Create(ctx, &superStream)).To(Succeed())
+
+					By("setting the stream settings of every partition", func() {
+						for i := 0; i < superStream.Spec.Partitions; i++ {
+							var partition topology.Queue
+							EventuallyWithOffset(1, func() error {
+								return client.Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-partition-%d", superStreamName, i), Namespace: "default"}, &partition)
+							}, 10*time.Second, 1*time.Second).Should(Succeed())
+							Expect(partition.Spec.Stream).To(Equal(&topology.StreamSettings{MaxAge: "7D", MaxLengthBytes: 10000000}))
+						}
+					})
+
+					By("creating a binding per binding key", func() {
+						EventuallyWithOffset(1, func() []string {
+							var bindings topology.BindingList
+							_ = client.List(ctx, &bindings, runtimeClient.InNamespace("default"), runtimeClient.MatchingLabels{
+								managedresource.AnnotationSuperStream:           superStreamName,
+								managedresource.AnnotationSuperStreamRoutingKey: "emea",
+							})
+							var routingKeys []string
+							for _, binding := range bindings.Items {
+								Expect(binding.Spec.Destination).To(Equal(superStreamName + "-emea"))
+								routingKeys = append(routingKeys, binding.Spec.RoutingKey)
+							}
+							return routingKeys
+						}, 10*time.Second, 1*time.Second).Should(ConsistOf("emea", "eu-west", "eu-north"))
+					})
+
+					By("deleting the binding of a removed binding key", func() {
+						_ = client.Get(ctx, types.NamespacedName{Name: superStreamName, Namespace: "default"}, &superStream)
+						superStream.Spec.BindingKeys = map[string][]string{"emea": {"eu-west"}}
+						Expect(client.Update(ctx, &superStream)).To(Succeed())
+						EventuallyWithOffset(1, func() []string {
+							var bindings topology.BindingList
+							_ = client.List(ctx, &bindings, runtimeClient.InNamespace("default"), runtimeClient.MatchingLabels{
+								managedresource.AnnotationSuperStream: superStreamName,
+							})
+							var routingKeys []string
+							for _, binding := range bindings.Items {
+								routingKeys = append(routingKeys, binding.Spec.RoutingKey)
+							}
+							return routingKeys
+						}, 10*time.Second, 1*time.Second).Should(ConsistOf("emea", "amer", "apac", "eu-west"))
+					})
+				})
+			})
+
+			When("the cluster is referenced through a connection secret", func() {
+				BeforeEach(func() {
+					superStreamName = "connection-secret"
+				})
+
+				It("passes the connection secret through to the underlying resources", func() {
+					superStream.Spec.RabbitmqClusterReference = topology.RabbitmqClusterReference{
+						ConnectionSecret: &corev1.LocalObjectReference{Name: "some-connection-secret"},
+					}
+					Expect(client.Create(ctx, &superStream)).To(Succeed())
+
+					expectedClusterReference := MatchAllFields(Fields{
+						"Name":             BeEmpty(),
+						"Namespace":        BeEmpty(),
+						"ConnectionSecret": PointTo(Equal(corev1.LocalObjectReference{Name: "some-connection-secret"})),
+					})
+
+					var exchange topology.Exchange
+					EventuallyWithOffset(1, func() error {
+						return client.Get(ctx, types.NamespacedName{Name: superStreamName + "-exchange", Namespace: "default"}, &exchange)
+					}, 10*time.Second, 1*time.Second).Should(Succeed())
+					Expect(exchange.Spec.RabbitmqClusterReference).To(expectedClusterReference)
+
+					var partition topology.Queue
+					EventuallyWithOffset(1, func() error {
+						return client.Get(ctx, types.NamespacedName{Name: superStreamName + "-partition-0", Namespace: "default"}, &partition)
+					}, 10*time.Second, 1*time.Second).Should(Succeed())
+					Expect(partition.Spec.RabbitmqClusterReference).To(expectedClusterReference)
+
+					var binding topology.Binding
+					EventuallyWithOffset(1, func() error {
+						return client.Get(ctx, types.NamespacedName{Name: superStreamName + "-binding-0", Namespace: "default"}, &binding)
+					}, 10*time.Second, 1*time.Second).Should(Succeed())
+					Expect(binding.Spec.RabbitmqClusterReference).To(expectedClusterReference)
+				})
+			})
+
 			When("the number of routing keys does not match the partition count", func() {
 				BeforeEach(func() {
 					superStreamName = "mismatch"
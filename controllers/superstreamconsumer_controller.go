@@ -0,0 +1,249 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// SuperStreamConsumerReconciler reconciles a SuperStreamConsumer, and the consumer pods it comprises of
+// Unlike other reconcilers, it does not connect to RabbitMQ
+type SuperStreamConsumerReconciler struct {
+	client.Client
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreams,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreamconsumers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreamconsumers/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=superstreamconsumers/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=events,verbs=get;create;patch
+
+func (r *SuperStreamConsumerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	consumer := &topologyv1alpha1.SuperStreamConsumer{}
+	if err := r.Get(ctx, req.NamespacedName, consumer); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	superStream := &topologyv1alpha1.SuperStream{}
+	if err := r.Get(ctx, types.NamespacedName{Name: consumer.Spec.SuperStreamReference.Name, Namespace: consumer.Namespace}, superStream); err != nil {
+		if k8serrors.IsNotFound(err) {
+			msg := fmt.Sprintf("SuperStream '%s' does not exist", consumer.Spec.SuperStreamReference.Name)
+			r.Recorder.Event(consumer, corev1.EventTypeWarning, "SuperStreamNotFound", msg)
+			if writerErr := r.SetReconcileSuccess(ctx, consumer, topology.NotReady(msg, consumer.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", consumer.Status)
+			}
+			// the SuperStream is watched; creating it triggers a new reconciliation
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	logger.Info("Start reconciling")
+
+	// Each SuperStreamConsumer generates a consumer pod per group of partitions of the SuperStream
+	managedResourceBuilder := managedresource.Builder{
+		ObjectOwner: consumer,
+		Scheme:      r.Scheme,
+	}
+	partitions := superStream.Status.Partitions
+	groups := managedresource.SuperStreamConsumerPartitionGroups(consumer, partitions)
+
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(consumer.Namespace),
+		client.MatchingLabels{managedresource.AnnotationSuperStreamConsumer: consumer.Name}); err != nil {
+		return ctrl.Result{}, err
+	}
+	existingPods := make(map[string]*corev1.Pod)
+	for i := range pods.Items {
+		if metav1.IsControlledBy(&pods.Items[i], consumer) {
+			existingPods[pods.Items[i].Name] = &pods.Items[i]
+		}
+	}
+
+	var podStatuses []topologyv1alpha1.SuperStreamConsumerPod
+	var outdatedPods []*corev1.Pod
+	allUpToDatePodsReady := true
+	for index, group := range groups {
+		builder := managedResourceBuilder.SuperStreamConsumerPod(index, superStream.Name, group, partitions, &consumer.Spec.PodTemplate)
+		resource, err := builder.Build()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		podStatus := topologyv1alpha1.SuperStreamConsumerPod{
+			Name:       resource.GetName(),
+			Partitions: group,
+			Ready:      corev1.ConditionUnknown,
+		}
+
+		pod, exists := existingPods[resource.GetName()]
+		delete(existingPods, resource.GetName())
+		switch {
+		case !exists:
+			if err := builder.Update(resource); err != nil {
+				return ctrl.Result{}, err
+			}
+			if err := r.Create(ctx, resource); err != nil {
+				msg := fmt.Sprintf("FailedReconcile%s", builder.ResourceType())
+				if writerErr := r.SetReconcileSuccess(ctx, consumer, topology.NotReady(msg, consumer.Status.Conditions)); writerErr != nil {
+					logger.Error(writerErr, failedStatusUpdate, "status", consumer.Status)
+				}
+				return ctrl.Result{}, err
+			}
+			logger.Info("Created consumer pod", "pod", resource.GetName(), "partitions", group)
+			allUpToDatePodsReady = false
+		case pod.DeletionTimestamp != nil:
+			// the pod is replaced once it is deleted
+			allUpToDatePodsReady = false
+		case pod.Status.Phase == corev1.PodFailed || pod.Status.Phase == corev1.PodSucceeded:
+			// consumer pods are not restarted once terminated, e.g. after an eviction; they are deleted and then replaced
+			if err := r.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+				if writerErr := r.SetReconcileSuccess(ctx, consumer, topology.NotReady("FailedDeleteConsumerPod", consumer.Status.Conditions)); writerErr != nil {
+					logger.Error(writerErr, failedStatusUpdate, "status", consumer.Status)
+				}
+				return ctrl.Result{}, err
+			}
+			logger.Info("Replacing terminated consumer pod", "pod", pod.Name, "phase", pod.Status.Phase)
+			allUpToDatePodsReady = false
+		default:
+			podSpecHash, err := builder.PodSpecHash()
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if pod.Annotations[managedresource.AnnotationConsumerPodSpecHash] != podSpecHash {
+				outdatedPods = append(outdatedPods, pod)
+			} else {
+				podStatus.Ready = podReadiness(pod)
+				if podStatus.Ready != corev1.ConditionTrue {
+					allUpToDatePodsReady = false
+				}
+			}
+		}
+		podStatuses = append(podStatuses, podStatus)
+	}
+
+	// pods whose partitions no longer exist in the SuperStream are deleted
+	for _, pod := range existingPods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+		if err := r.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			if writerErr := r.SetReconcileSuccess(ctx, consumer, topology.NotReady("FailedDeleteConsumerPod", consumer.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", consumer.Status)
+			}
+			return ctrl.Result{}, err
+		}
+		logger.Info("Deleted consumer pod", "pod", pod.Name)
+	}
+
+	// outdated pods are replaced one at a time, once all other pods are up to date and ready
+	if len(outdatedPods) > 0 && allUpToDatePodsReady {
+		pod := outdatedPods[0]
+		if err := r.Delete(ctx, pod); client.IgnoreNotFound(err) != nil {
+			if writerErr := r.SetReconcileSuccess(ctx, consumer, topology.NotReady("FailedDeleteConsumerPod", consumer.Status.Conditions)); writerErr != nil {
+				logger.Error(writerErr, failedStatusUpdate, "status", consumer.Status)
+			}
+			return ctrl.Result{}, err
+		}
+		logger.Info("Replacing outdated consumer pod", "pod", pod.Name)
+	}
+
+	consumer.Status.Pods = podStatuses
+	if err := r.SetReconcileSuccess(ctx, consumer, consumerReadiness(superStream, podStatuses, consumer.Status.Conditions)); err != nil {
+		logger.Error(err, failedStatusUpdate)
+	}
+
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+// podReadiness returns the status of the Ready condition of a pod
+func podReadiness(pod *corev1.Pod) corev1.ConditionStatus {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status
+		}
+	}
+	return corev1.ConditionUnknown
+}
+
+// consumerReadiness returns a Ready condition which is true when all consumer pods are up to date and ready
+func consumerReadiness(superStream *topologyv1alpha1.SuperStream, pods []topologyv1alpha1.SuperStreamConsumerPod, lastConditions []topology.Condition) topology.Condition {
+	if len(superStream.Status.Partitions) == 0 {
+		return topology.NotReady(fmt.Sprintf("SuperStream '%s' has no partitions yet", superStream.Name), lastConditions)
+	}
+	var notReady []string
+	for _, pod := range pods {
+		if pod.Ready != corev1.ConditionTrue {
+			notReady = append(notReady, pod.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		return topology.NotReady(fmt.Sprintf("consumer pods are not ready: %s", strings.Join(notReady, ", ")), lastConditions)
+	}
+	return topology.Ready(lastConditions)
+}
+
+func (r *SuperStreamConsumerReconciler) SetReconcileSuccess(ctx context.Context, consumer *topologyv1alpha1.SuperStreamConsumer, condition topology.Condition) error {
+	consumer.Status.Conditions = []topology.Condition{condition}
+	consumer.Status.ObservedGeneration = consumer.GetGeneration()
+	return clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, consumer)
+	})
+}
+
+// consumersOfSuperStream maps a SuperStream to the SuperStreamConsumers which reference it
+func (r *SuperStreamConsumerReconciler) consumersOfSuperStream(object client.Object) []reconcile.Request {
+	consumers := &topologyv1alpha1.SuperStreamConsumerList{}
+	if err := r.List(context.Background(), consumers, client.InNamespace(object.GetNamespace())); err != nil {
+		ctrl.Log.WithName(SuperStreamConsumerControllerName).Error(err, "failed to list SuperStreamConsumers", "superStream", object.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, consumer := range consumers.Items {
+		if consumer.Spec.SuperStreamReference.Name == object.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&consumer)})
+		}
+	}
+	return requests
+}
+
+func (r *SuperStreamConsumerReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topologyv1alpha1.SuperStreamConsumer{}).
+		Owns(&corev1.Pod{}).
+		Watches(&source.Kind{Type: &topologyv1alpha1.SuperStream{}}, handler.EnqueueRequestsFromMapFunc(r.consumersOfSuperStream)).
+		Complete(r)
+}
@@ -0,0 +1,193 @@
+package controllers_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("super-stream-consumer-controller", func() {
+
+	var superStream topologyv1alpha1.SuperStream
+	var consumer topologyv1alpha1.SuperStreamConsumer
+	var consumerName string
+
+	fetchPod := func(name string) (*corev1.Pod, error) {
+		var pod corev1.Pod
+		err := client.Get(ctx, types.NamespacedName{Name: name, Namespace: "default"}, &pod)
+		return &pod, err
+	}
+
+	podSpecHash := func(name string) string {
+		pod, err := fetchPod(name)
+		if err != nil {
+			return ""
+		}
+		return pod.Annotations[managedresource.AnnotationConsumerPodSpecHash]
+	}
+
+	JustBeforeEach(func() {
+		fakeRabbitMQClient.DeclareExchangeReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareQueueReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		fakeRabbitMQClient.DeclareBindingReturns(&http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+		}, nil)
+		superStream = topologyv1alpha1.SuperStream{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      consumerName + "-stream",
+				Namespace: "default",
+			},
+			Spec: topologyv1alpha1.SuperStreamSpec{
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "example-rabbit",
+				},
+				Partitions: 2,
+			},
+		}
+		consumer = topologyv1alpha1.SuperStreamConsumer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      consumerName,
+				Namespace: "default",
+			},
+			Spec: topologyv1alpha1.SuperStreamConsumerSpec{
+				SuperStreamReference: corev1.LocalObjectReference{Name: consumerName + "-stream"},
+				PartitionsPerPod:     1,
+				PodTemplate: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "consumer", Image: "orders-consumer:1.0"}},
+					},
+				},
+			},
+		}
+		Expect(client.Create(ctx, &superStream)).To(Succeed())
+		Eventually(func() []string {
+			_ = client.Get(ctx, types.NamespacedName{Name: superStream.Name, Namespace: "default"}, &superStream)
+			return superStream.Status.Partitions
+		}, 10*time.Second, 1*time.Second).Should(HaveLen(2))
+	})
+
+	When("creating a super stream consumer", func() {
+		BeforeEach(func() {
+			consumerName = "consumer-creation"
+		})
+
+		It("creates a consumer pod per partition", func() {
+			Expect(client.Create(ctx, &consumer)).To(Succeed())
+
+			for i := 0; i < 2; i++ {
+				partition := fmt.Sprintf("%s-stream-%d", consumerName, i)
+				var pod *corev1.Pod
+				Eventually(func() error {
+					var err error
+					pod, err = fetchPod(fmt.Sprintf("%s-%d", consumerName, i))
+					return err
+				}, 10*time.Second, 1*time.Second).Should(Succeed())
+				Expect(pod.Annotations).To(HaveKeyWithValue(managedresource.AnnotationSuperStreamPartition, partition))
+				Expect(pod.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "RABBITMQ_SUPER_STREAM_PARTITIONS", Value: partition}))
+				Expect(pod.OwnerReferences[0].Name).To(Equal(consumerName))
+			}
+
+			Eventually(func() []topologyv1alpha1.SuperStreamConsumerPod {
+				_ = client.Get(ctx, types.NamespacedName{Name: consumerName, Namespace: "default"}, &consumer)
+				return consumer.Status.Pods
+			}, 10*time.Second, 1*time.Second).Should(HaveLen(2))
+			Expect(consumer.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Status": Equal(corev1.ConditionFalse),
+			})))
+		})
+	})
+
+	When("the pod template is updated", func() {
+		BeforeEach(func() {
+			consumerName = "consumer-template-update"
+		})
+
+		It("replaces the consumer pods", func() {
+			Expect(client.Create(ctx, &consumer)).To(Succeed())
+			var originalHash string
+			Eventually(func() string {
+				originalHash = podSpecHash(consumerName + "-0")
+				return originalHash
+			}, 10*time.Second, 1*time.Second).ShouldNot(BeEmpty())
+
+			Expect(client.Get(ctx, types.NamespacedName{Name: consumerName, Namespace: "default"}, &consumer)).To(Succeed())
+			consumer.Spec.PodTemplate.Spec.Containers[0].Image = "orders-consumer:2.0"
+			Expect(client.Update(ctx, &consumer)).To(Succeed())
+
+			Eventually(func() string {
+				return podSpecHash(consumerName + "-0")
+			}, 10*time.Second, 1*time.Second).ShouldNot(Or(BeEmpty(), Equal(originalHash)))
+			pod, err := fetchPod(consumerName + "-0")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pod.Spec.Containers[0].Image).To(Equal("orders-consumer:2.0"))
+		})
+	})
+
+	When("a consumer pod terminates", func() {
+		BeforeEach(func() {
+			consumerName = "consumer-pod-failed"
+		})
+
+		It("replaces the consumer pod", func() {
+			Expect(client.Create(ctx, &consumer)).To(Succeed())
+			var pod *corev1.Pod
+			Eventually(func() error {
+				var err error
+				pod, err = fetchPod(consumerName + "-0")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+			originalUID := pod.UID
+
+			pod.Status.Phase = corev1.PodFailed
+			Expect(client.Status().Update(ctx, pod)).To(Succeed())
+
+			Eventually(func() types.UID {
+				pod, err := fetchPod(consumerName + "-0")
+				if err != nil {
+					return originalUID
+				}
+				return pod.UID
+			}, 10*time.Second, 1*time.Second).ShouldNot(Equal(originalUID))
+		})
+	})
+
+	When("the super stream is scaled up", func() {
+		BeforeEach(func() {
+			consumerName = "consumer-scale-up"
+		})
+
+		It("creates a consumer pod for the new partition", func() {
+			Expect(client.Create(ctx, &consumer)).To(Succeed())
+			Eventually(func() error {
+				_, err := fetchPod(consumerName + "-1")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+
+			superStream.Spec.Partitions = 3
+			Expect(client.Update(ctx, &superStream)).To(Succeed())
+
+			Eventually(func() error {
+				_, err := fetchPod(consumerName + "-2")
+				return err
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,224 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	k8sApiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/rabbitmq/messaging-topology-operator/internal"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	clientretry "k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+// TopicPermissionReconciler reconciles a TopicPermission object
+type TopicPermissionReconciler struct {
+	client.Client
+	Log                     logr.Logger
+	Scheme                  *runtime.Scheme
+	Recorder                record.EventRecorder
+	RabbitmqClientFactory   rabbitmqclient.Factory
+	KubernetesClusterDomain string
+}
+
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=topicpermissions,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=topicpermissions/finalizers,verbs=update
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=topicpermissions/status,verbs=get;update;patch
+
+func (r *TopicPermissionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	topicPermission := &topology.TopicPermission{}
+	if err := r.Get(ctx, req.NamespacedName, topicPermission); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	systemCertPool, err := extractSystemCertPool(ctx, r.Recorder, topicPermission)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	credsProvider, tlsEnabled, err := rabbitmqclient.ParseReference(ctx, r.Client, topicPermission.Spec.RabbitmqClusterReference, topicPermission.Namespace, r.KubernetesClusterDomain)
+	if err != nil {
+		return handleRMQReferenceParseError(ctx, r.Client, r.Recorder, topicPermission, &topicPermission.Status.Conditions, err)
+	}
+
+	rabbitClient, err := r.RabbitmqClientFactory(credsProvider, tlsEnabled, systemCertPool)
+	if err != nil {
+		logger.Error(err, failedGenerateRabbitClient)
+		return reconcile.Result{}, err
+	}
+
+	user := &topology.User{}
+	username := topicPermission.Spec.User
+	if topicPermission.Spec.UserReference != nil {
+
+		if user, err = r.getUserFromReference(ctx, topicPermission); err != nil {
+			return reconcile.Result{}, err
+		} else if user != nil {
+			// User exist
+			username = user.Status.Username
+		}
+	}
+
+	if !topicPermission.ObjectMeta.DeletionTimestamp.IsZero() {
+		logger.Info("Deleting")
+
+		if username == "" {
+			msg := "user already removed; no need to delete topic permission"
+			logger.Info(msg)
+			r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "UserNotExist", msg)
+		} else if err := r.revokeTopicPermissions(ctx, rabbitClient, topicPermission, username); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		r.Recorder.Event(topicPermission, corev1.EventTypeNormal, "SuccessfulDelete", "successfully deleted topic permission")
+		return ctrl.Result{}, removeFinalizer(ctx, r.Client, topicPermission)
+	}
+
+	// User not exist stop create or update operations
+	if username == "" {
+		msg := "failed create TopicPermission, missing User"
+
+		topicPermission.Status.Conditions = []topology.Condition{
+			topology.NotReady(msg, topicPermission.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, topicPermission)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", topicPermission.Status)
+		}
+
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "FailedCreateOrUpdate", msg)
+		logger.Error(err, msg)
+		return reconcile.Result{}, fmt.Errorf(msg)
+	}
+
+	if err := addFinalizerIfNeeded(ctx, r.Client, topicPermission); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// user != nil, not working because user has always a name set
+	if user.Name != "" {
+		if err := controllerutil.SetControllerReference(user, topicPermission, r.Scheme); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed set controller reference: %v", err)
+		}
+		if err := r.Client.Update(ctx, topicPermission); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to Update object with controller reference: %w", err)
+		}
+	}
+
+	spec, err := json.Marshal(topicPermission.Spec)
+	if err != nil {
+		logger.Error(err, failedMarshalSpec)
+	}
+
+	logger.Info("Start reconciling",
+		"spec", string(spec))
+
+	if err := r.updateTopicPermissions(ctx, rabbitClient, topicPermission, username); err != nil {
+		// Set Condition 'Ready' to false with message
+		topicPermission.Status.Conditions = []topology.Condition{
+			topology.NotReady(err.Error(), topicPermission.Status.Conditions),
+		}
+		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			return r.Status().Update(ctx, topicPermission)
+		}); writerErr != nil {
+			logger.Error(writerErr, failedStatusUpdate, "status", topicPermission.Status)
+		}
+		return ctrl.Result{}, err
+	}
+
+	topicPermission.Status.Conditions = []topology.Condition{topology.Ready(topicPermission.Status.Conditions)}
+	topicPermission.Status.ObservedGeneration = topicPermission.GetGeneration()
+	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, topicPermission)
+	}); writerErr != nil {
+		logger.Error(writerErr, failedStatusUpdate, "status", topicPermission.Status)
+	}
+	logger.Info("Finished reconciling")
+
+	return ctrl.Result{}, nil
+}
+
+func (r *TopicPermissionReconciler) getUserFromReference(ctx context.Context, topicPermission *topology.TopicPermission) (*topology.User, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	// get User from provided user reference
+	failureMsg := "failed to get User"
+	user := &topology.User{}
+	err := r.Get(ctx, types.NamespacedName{Name: topicPermission.Spec.UserReference.Name, Namespace: topicPermission.Namespace}, user)
+
+	if err != nil && k8sApiErrors.IsNotFound(err) {
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "NotExist", failureMsg)
+		return nil, nil
+	} else if err != nil {
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "FailedUpdate", failureMsg)
+		logger.Error(err, failureMsg, "userReference", topicPermission.Spec.UserReference.Name)
+		return nil, err
+	}
+
+	// get username from User status
+	if user.Status.Username == "" {
+		err := fmt.Errorf("this User does not have an username set in its status")
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "FailedUpdate", failureMsg)
+		logger.Error(err, failureMsg, "userReference", topicPermission.Spec.UserReference.Name)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *TopicPermissionReconciler) updateTopicPermissions(ctx context.Context, client rabbitmqclient.Client, topicPermission *topology.TopicPermission, user string) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if err := validateResponse(client.UpdateTopicPermissionsIn(topicPermission.Spec.Vhost, user, internal.GenerateTopicPermissions(topicPermission))); err != nil {
+		msg := "failed to set topic permission"
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "FailedUpdate", msg)
+		logger.Error(err, msg, "user", user, "vhost", topicPermission.Spec.Vhost)
+		return err
+	}
+
+	logger.Info("Successfully set topic permission", "user", user, "vhost", topicPermission.Spec.Vhost)
+	r.Recorder.Event(topicPermission, corev1.EventTypeNormal, "SuccessfulUpdate", "successfully set topic permission")
+	return nil
+}
+
+func (r *TopicPermissionReconciler) revokeTopicPermissions(ctx context.Context, client rabbitmqclient.Client, topicPermission *topology.TopicPermission, user string) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	err := validateResponseForDeletion(client.DeleteTopicPermissionsIn(topicPermission.Spec.Vhost, user, topicPermission.Spec.Permissions.Exchange))
+	if errors.Is(err, NotFound) {
+		logger.Info("cannot find user, vhost or exchange in rabbitmq server; no need to delete topic permission", "user", user, "vhost", topicPermission.Spec.Vhost, "exchange", topicPermission.Spec.Permissions.Exchange)
+	} else if err != nil {
+		msg := "failed to delete topic permission"
+		r.Recorder.Event(topicPermission, corev1.EventTypeWarning, "FailedDelete", msg)
+		logger.Error(err, msg, "user", user, "vhost", topicPermission.Spec.Vhost)
+		return err
+	}
+	return nil
+}
+
+func (r *TopicPermissionReconciler) SetInternalDomainName(domainName string) {
+	r.KubernetesClusterDomain = domainName
+}
+
+func (r *TopicPermissionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&topology.TopicPermission{}).
+		Complete(r)
+}
@@ -10,12 +10,18 @@ This product may include a number of subcomponents with separate copyright notic
 package controllers
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"sort"
+	"time"
 
 	"github.com/go-logr/logr"
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	rabbitmqv1beta1 "github.com/rabbitmq/cluster-operator/api/v1beta1"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	"github.com/rabbitmq/messaging-topology-operator/internal"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
@@ -27,9 +33,14 @@ import (
 	clientretry "k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 var apiGVStr = topology.GroupVersion.String()
@@ -47,12 +58,16 @@ type UserReconciler struct {
 	Recorder                record.EventRecorder
 	RabbitmqClientFactory   rabbitmqclient.Factory
 	KubernetesClusterDomain string
+	// Vault path below which the credentials of users can be read and written
+	VaultCredentialsPathPrefix string
 }
 
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=users,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=users/finalizers,verbs=update
 // +kubebuilder:rbac:groups=rabbitmq.com,resources=users/status,verbs=get;update;patch
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch
+// +kubebuilder:rbac:groups=rabbitmq.com,resources=rabbitmqclusters,verbs=get;list;watch
 
 func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	logger := ctrl.LoggerFrom(ctx)
@@ -101,7 +116,21 @@ func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	logger.Info("Start reconciling",
 		"spec", string(spec))
 
-	if user.Status.Credentials == nil || user.Status.Username == "" {
+	if vaultBackend := user.Spec.VaultCredentialsBackend(); vaultBackend != nil {
+		if user.Status.CredentialsVaultPath == "" {
+			logger.Info("User does not yet have credentials in Vault; generating", "user", user.Name, "path", vaultBackend.Path)
+			username, err := r.declareVaultCredentials(ctx, user, vaultBackend.Path)
+			if err != nil {
+				r.setNotReady(ctx, user, err)
+				return ctrl.Result{}, err
+			}
+			// the password was just generated; an annotation set at creation does not request a rotation
+			user.Status.LastPasswordRotationRequest = user.Annotations[topology.AnnotationRotatePassword]
+			if err := r.setUserStatus(ctx, user, username); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	} else if user.Status.Credentials == nil || user.Status.Username == "" {
 		username := ""
 		if user.Status.Credentials != nil && user.Status.Username == "" {
 			// Only run once for migration to set user.Status.Username on exsisting resources
@@ -115,6 +144,8 @@ func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 			if username, err = r.declareCredentials(ctx, user); err != nil {
 				return ctrl.Result{}, err
 			}
+			// the password was just generated; an annotation set at creation does not request a rotation
+			user.Status.LastPasswordRotationRequest = user.Annotations[topology.AnnotationRotatePassword]
 		}
 
 		if err := r.setUserStatus(ctx, user, username); err != nil {
@@ -122,19 +153,25 @@ func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
+	nextRotation, err := r.rotatePasswordIfDue(ctx, rabbitClient, user)
+	if err != nil {
+		r.setNotReady(ctx, user, err)
+		return ctrl.Result{}, err
+	}
+
+	// the connection details in the credentials Secret are not required to declare the user
+	bindingDataErr := r.declareBindingData(ctx, user)
+
 	if err := r.declareUser(ctx, rabbitClient, user); err != nil {
-		// Set Condition 'Ready' to false with message
-		user.Status.Conditions = []topology.Condition{
-			topology.NotReady(err.Error(), user.Status.Conditions),
-		}
-		if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
-			return r.Status().Update(ctx, user)
-		}); writerErr != nil {
-			logger.Error(writerErr, failedStatusUpdate, "status", user.Status)
-		}
+		r.setNotReady(ctx, user, err)
 		return ctrl.Result{}, err
 	}
 
+	if bindingDataErr != nil {
+		r.setNotReady(ctx, user, fmt.Errorf("failed to set connection details in credentials secret: %w", bindingDataErr))
+		return ctrl.Result{}, bindingDataErr
+	}
+
 	user.Status.Conditions = []topology.Condition{topology.Ready(user.Status.Conditions)}
 	user.Status.ObservedGeneration = user.GetGeneration()
 	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
@@ -145,7 +182,175 @@ func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 
 	logger.Info("Finished reconciling")
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: nextRotation}, nil
+}
+
+// setNotReady sets condition 'Ready' to false with the message of err
+func (r *UserReconciler) setNotReady(ctx context.Context, user *topology.User, err error) {
+	user.Status.Conditions = []topology.Condition{
+		topology.NotReady(err.Error(), user.Status.Conditions),
+	}
+	if writerErr := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		return r.Status().Update(ctx, user)
+	}); writerErr != nil {
+		ctrl.LoggerFrom(ctx).Error(writerErr, failedStatusUpdate, "status", user.Status)
+	}
+}
+
+// rotatePasswordIfDue rotates the user password when spec.passwordRotation.interval has elapsed since the last rotation,
+// or when the annotation 'rabbitmq.com/rotate-password' is set to a value other than at the last on-demand rotation.
+// It returns the time until the next scheduled rotation, or zero if there is no rotation interval.
+func (r *UserReconciler) rotatePasswordIfDue(ctx context.Context, rabbitClient rabbitmqclient.Client, user *topology.User) (time.Duration, error) {
+	rotationRequest, ok := user.Annotations[topology.AnnotationRotatePassword]
+	requested := ok && rotationRequest != user.Status.LastPasswordRotationRequest
+
+	lastRotation := user.CreationTimestamp.Time
+	if user.Status.LastPasswordRotation != nil {
+		lastRotation = user.Status.LastPasswordRotation.Time
+	}
+	due := user.Spec.PasswordRotation != nil && !time.Now().Before(user.Spec.PasswordRotation.Due(lastRotation))
+
+	if requested || due {
+		if err := r.rotatePassword(ctx, rabbitClient, user); err != nil {
+			return 0, err
+		}
+		now := metav1.Now()
+		lastRotation = now.Time
+		if err := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+			latest := &topology.User{}
+			if err := r.Get(ctx, client.ObjectKeyFromObject(user), latest); err != nil {
+				return err
+			}
+			latest.Status.LastPasswordRotation = &now
+			if requested {
+				latest.Status.LastPasswordRotationRequest = rotationRequest
+			}
+			if err := r.Status().Update(ctx, latest); err != nil {
+				return err
+			}
+			latest.DeepCopyInto(user)
+			return nil
+		}); err != nil {
+			msg := "failed to record password rotation in status"
+			r.Recorder.Event(user, corev1.EventTypeWarning, "FailedStatusUpdate", msg)
+			ctrl.LoggerFrom(ctx).Error(err, msg, "user", user.Name)
+			return 0, err
+		}
+	}
+
+	if user.Spec.PasswordRotation == nil {
+		return 0, nil
+	}
+	return time.Until(user.Spec.PasswordRotation.Due(lastRotation)), nil
+}
+
+// rotatePassword sets a new password for the user in RabbitMQ, then writes it to the credentials Secret of the user, or to Vault,
+// so that the stored password is always accepted by RabbitMQ once written
+func (r *UserReconciler) rotatePassword(ctx context.Context, rabbitClient rabbitmqclient.Client, user *topology.User) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	password, err := internal.RandomEncodedString(24)
+	if err != nil {
+		msg := "failed to generate random password"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedPasswordRotation", msg)
+		logger.Error(err, msg)
+		return err
+	}
+
+	userSettings := internal.GenerateUserSettingsFromCredentials(user.Status.Username, password, user.Spec.Tags)
+	if err := validateResponse(rabbitClient.PutUser(userSettings.Name, userSettings)); err != nil {
+		msg := "failed to update password in RabbitMQ"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedPasswordRotation", msg)
+		logger.Error(err, msg, "user", user.Name)
+		return err
+	}
+
+	if user.Status.CredentialsVaultPath != "" {
+		if err := r.writeVaultCredentials(user.Status.CredentialsVaultPath, user.Status.Username, password); err != nil {
+			msg := "failed to update password in Vault"
+			r.Recorder.Event(user, corev1.EventTypeWarning, "FailedPasswordRotation", msg)
+			logger.Error(err, msg, "user", user.Name)
+			return err
+		}
+	} else if err := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		credentials, err := r.getUserCredentials(ctx, user)
+		if err != nil {
+			return err
+		}
+		if credentials.Data == nil {
+			credentials.Data = map[string][]byte{}
+		}
+		credentials.Data["password"] = []byte(password)
+		return r.Update(ctx, credentials)
+	}); err != nil {
+		msg := "failed to update password in credentials secret"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedPasswordRotation", msg)
+		logger.Error(err, msg, "user", user.Name)
+		return err
+	}
+
+	logger.Info("Rotated user password", "user", user.Name)
+	r.Recorder.Event(user, corev1.EventTypeNormal, "SuccessfulPasswordRotation", "Successfully rotated user password")
+	return nil
+}
+
+// declareBindingData sets the connection details of the referenced cluster in the credentials Secret of the user,
+// so that the Secret conforms to the Provisioned Service spec, and keeps them up to date with the cluster and the user password
+func (r *UserReconciler) declareBindingData(ctx context.Context, user *topology.User) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if user.Status.CredentialsVaultPath != "" {
+		// there is no credentials Secret when credentials are stored in Vault
+		return nil
+	}
+
+	connection, err := rabbitmqclient.ParseAMQPConnection(ctx, r.Client, user.Spec.RabbitmqClusterReference, user.Namespace, r.KubernetesClusterDomain)
+	if err != nil {
+		msg := "failed to get connection details of the cluster"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+		logger.Error(err, msg, "user", user.Name)
+		return err
+	}
+
+	vhost := user.Spec.Vhost
+	if vhost == "" {
+		vhost = "/"
+	}
+
+	if err := clientretry.RetryOnConflict(clientretry.DefaultRetry, func() error {
+		credentials, err := r.getUserCredentials(ctx, user)
+		if err != nil {
+			return err
+		}
+		data, err := internal.GenerateUserBindingData(credentials, connection, vhost)
+		if err != nil {
+			return err
+		}
+
+		updated := false
+		for key, value := range data {
+			if !bytes.Equal(credentials.Data[key], value) {
+				credentials.Data[key] = value
+				updated = true
+			}
+		}
+		if _, ok := data["ca.crt"]; !ok {
+			if _, ok := credentials.Data["ca.crt"]; ok {
+				delete(credentials.Data, "ca.crt")
+				updated = true
+			}
+		}
+		if !updated {
+			return nil
+		}
+		return r.Update(ctx, credentials)
+	}); err != nil {
+		msg := "failed to set connection details in credentials secret"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+		logger.Error(err, msg, "user", user.Name)
+		return err
+	}
+	return nil
 }
 
 func (r *UserReconciler) declareCredentials(ctx context.Context, user *topology.User) (string, error) {
@@ -167,7 +372,8 @@ func (r *UserReconciler) declareCredentials(ctx context.Context, user *topology.
 		},
 		Type: corev1.SecretTypeOpaque,
 		// The format of the generated Secret conforms to the Provisioned Service
-		// type Spec. For more information, see https://k8s-service-bindings.github.io/spec/#provisioned-service.
+		// type Spec, once the connection details of the cluster are set by declareBindingData.
+		// For more information, see https://k8s-service-bindings.github.io/spec/#provisioned-service.
 		Data: map[string][]byte{
 			"username": []byte(username),
 			"password": []byte(password),
@@ -203,6 +409,53 @@ func (r *UserReconciler) declareCredentials(ctx context.Context, user *topology.
 	return username, nil
 }
 
+// declareVaultCredentials generates or imports the credentials of the user, and writes them to path in Vault
+func (r *UserReconciler) declareVaultCredentials(ctx context.Context, user *topology.User, path string) (string, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	username, password, err := r.generateCredentials(ctx, user)
+	if err != nil {
+		msg := "failed to generate credentials"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "CredentialGenerateFailure", msg)
+		logger.Error(err, msg)
+		return "", err
+	}
+	logger.Info("Credentials generated for User", "user", user.Name, "generatedUsername", username)
+
+	if err := r.writeVaultCredentials(path, username, password); err != nil {
+		msg := "failed to write credentials to Vault"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+		logger.Error(err, msg, "path", path)
+		return "", err
+	}
+
+	logger.Info("Successfully wrote credentials to Vault", "user", user.Name, "path", path)
+	r.Recorder.Event(user, corev1.EventTypeNormal, "SuccessfulDeclare", "Successfully wrote credentials to Vault")
+	return username, nil
+}
+
+func (r *UserReconciler) readVaultCredentials(path string) (string, string, error) {
+	if err := topology.ValidateVaultCredentialsPath(path, r.VaultCredentialsPathPrefix); err != nil {
+		return "", "", err
+	}
+	secretStoreClient, err := rabbitmqclient.SecretStoreClientProvider()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to create a client connection to secret store: %w", err)
+	}
+	return secretStoreClient.ReadCredentials(path)
+}
+
+func (r *UserReconciler) writeVaultCredentials(path, username, password string) error {
+	if err := topology.ValidateVaultCredentialsPath(path, r.VaultCredentialsPathPrefix); err != nil {
+		return err
+	}
+	secretStoreClient, err := rabbitmqclient.SecretStoreClientProvider()
+	if err != nil {
+		return fmt.Errorf("unable to create a client connection to secret store: %w", err)
+	}
+	return secretStoreClient.WriteCredentials(path, username, password)
+}
+
 func (r *UserReconciler) generateCredentials(ctx context.Context, user *topology.User) (string, string, error) {
 	logger := ctrl.LoggerFrom(ctx)
 
@@ -258,18 +511,21 @@ func (r *UserReconciler) importCredentials(ctx context.Context, secretName, secr
 func (r *UserReconciler) setUserStatus(ctx context.Context, user *topology.User, username string) error {
 	logger := ctrl.LoggerFrom(ctx)
 
-	credentials := &corev1.LocalObjectReference{
-		Name: user.Name + "-user-credentials",
+	if vaultBackend := user.Spec.VaultCredentialsBackend(); vaultBackend != nil {
+		user.Status.CredentialsVaultPath = vaultBackend.Path
+	} else {
+		user.Status.Credentials = &corev1.LocalObjectReference{
+			Name: user.Name + "-user-credentials",
+		}
 	}
-	user.Status.Credentials = credentials
 	user.Status.Username = username
 	if err := r.Status().Update(ctx, user); err != nil {
 		msg := "Failed to update secret status credentials"
 		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedStatusUpdate", msg)
-		logger.Error(err, msg, "user", user.Name, "secretRef", credentials)
+		logger.Error(err, msg, "user", user.Name, "secretRef", user.Status.Credentials, "vaultPath", user.Status.CredentialsVaultPath)
 		return err
 	}
-	logger.Info("Successfully updated secret status credentials", "user", user.Name, "secretRef", credentials)
+	logger.Info("Successfully updated secret status credentials", "user", user.Name, "secretRef", user.Status.Credentials, "vaultPath", user.Status.CredentialsVaultPath)
 	r.Recorder.Event(user, corev1.EventTypeNormal, "SuccessfulStatusUpdate", "Successfully updated user status")
 	return nil
 }
@@ -277,12 +533,46 @@ func (r *UserReconciler) setUserStatus(ctx context.Context, user *topology.User,
 func (r *UserReconciler) declareUser(ctx context.Context, client rabbitmqclient.Client, user *topology.User) error {
 	logger := ctrl.LoggerFrom(ctx)
 
+	userSettings, err := r.generateUserSettings(ctx, user)
+	if err != nil {
+		return err
+	}
+	logger.Info("Generated user settings", "user", user.Name, "settings", userSettings)
+
+	if err := validateResponse(client.PutUser(userSettings.Name, userSettings)); err != nil {
+		msg := "failed to declare user"
+		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+		logger.Error(err, msg, "user", user.Name)
+		return err
+	}
+
+	logger.Info("Successfully declared user", "user", user.Name)
+	r.Recorder.Event(user, corev1.EventTypeNormal, "SuccessfulDeclare", "Successfully declared user")
+	return r.declareUserLimits(ctx, client, user, userSettings.Name)
+}
+
+// generateUserSettings returns the settings of the user, with the credentials from its credentials Secret, or from Vault
+func (r *UserReconciler) generateUserSettings(ctx context.Context, user *topology.User) (rabbithole.UserSettings, error) {
+	logger := ctrl.LoggerFrom(ctx)
+
+	if user.Status.CredentialsVaultPath != "" {
+		username, password, err := r.readVaultCredentials(user.Status.CredentialsVaultPath)
+		if err != nil {
+			msg := "failed to retrieve user credentials from Vault"
+			r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+			logger.Error(err, msg, "user.status", user.Status)
+			return rabbithole.UserSettings{}, err
+		}
+		logger.Info("Retrieved credentials for user from Vault", "user", user.Name, "path", user.Status.CredentialsVaultPath)
+		return internal.GenerateUserSettingsFromCredentials(username, password, user.Spec.Tags), nil
+	}
+
 	credentials, err := r.getUserCredentials(ctx, user)
 	if err != nil {
 		msg := "failed to retrieve user credentials secret from status"
 		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
 		logger.Error(err, msg, "user.status", user.Status)
-		return err
+		return rabbithole.UserSettings{}, err
 	}
 	logger.Info("Retrieved credentials for user", "user", user.Name, "credentials", credentials.Name)
 
@@ -291,19 +581,55 @@ func (r *UserReconciler) declareUser(ctx context.Context, client rabbitmqclient.
 		msg := "failed to generate user settings from credential"
 		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
 		logger.Error(err, msg, "user.status", user.Status)
-		return err
+		return rabbithole.UserSettings{}, err
 	}
-	logger.Info("Generated user settings", "user", user.Name, "settings", userSettings)
+	return userSettings, nil
+}
 
-	if err := validateResponse(client.PutUser(userSettings.Name, userSettings)); err != nil {
-		msg := "failed to declare user"
+// sets limits from user.spec.limits using rabbithole client.PutUserLimits
+// limits set on the server but not in user.spec.limits are cleared using rabbithole client.DeleteUserLimits
+func (r *UserReconciler) declareUserLimits(ctx context.Context, client rabbitmqclient.Client, user *topology.User, username string) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	currentLimits, err := client.GetUserLimits(username)
+	if err != nil {
+		msg := "failed to get user limits"
 		r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
 		logger.Error(err, msg, "user", user.Name)
 		return err
 	}
 
-	logger.Info("Successfully declared user", "user", user.Name)
-	r.Recorder.Event(user, corev1.EventTypeNormal, "SuccessfulDeclare", "Successfully declared user")
+	desiredLimits := internal.GenerateUserLimits(user.Spec.Limits)
+	var removedLimits rabbithole.UserLimits
+	for _, info := range currentLimits {
+		for limit := range info.Value {
+			if _, ok := desiredLimits[limit]; !ok {
+				removedLimits = append(removedLimits, limit)
+			}
+		}
+	}
+	sort.Strings(removedLimits)
+
+	if len(desiredLimits) > 0 {
+		if err := validateResponse(client.PutUserLimits(username, desiredLimits)); err != nil {
+			msg := "failed to set user limits"
+			r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+			logger.Error(err, msg, "user", user.Name)
+			return err
+		}
+	}
+
+	if len(removedLimits) > 0 {
+		err := validateResponseForDeletion(client.DeleteUserLimits(username, removedLimits))
+		if errors.Is(err, NotFound) {
+			logger.Info("cannot find user limits in rabbitmq server; already cleared", "user", user.Name, "limits", removedLimits)
+		} else if err != nil {
+			msg := "failed to clear user limits"
+			r.Recorder.Event(user, corev1.EventTypeWarning, "FailedDeclare", msg)
+			logger.Error(err, msg, "user", user.Name, "limits", removedLimits)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -369,9 +695,90 @@ func (r *UserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&topology.User{}).
 		Owns(&corev1.Secret{}).
+		Watches(&source.Kind{Type: &rabbitmqv1beta1.RabbitmqCluster{}}, handler.EnqueueRequestsFromMapFunc(r.usersOfCluster),
+			builder.WithPredicates(clusterConnectionChanged())).
+		Watches(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(r.usersOfClusterService)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.usersOfClusterTLSSecret)).
 		Complete(r)
 }
 
+// usersOfCluster maps a RabbitmqCluster to the Users referencing it by name,
+// so that the connection details in their credentials Secrets follow the TLS settings of the cluster
+func (r *UserReconciler) usersOfCluster(object client.Object) []reconcile.Request {
+	users := &topology.UserList{}
+	if err := r.List(context.Background(), users); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, user := range users.Items {
+		ref := user.Spec.RabbitmqClusterReference
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = user.Namespace
+		}
+		if ref.ConnectionSecret == nil && ref.Name == object.GetName() && namespace == object.GetNamespace() {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: user.Name, Namespace: user.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// clusterConnectionChanged filters out updates of a RabbitmqCluster which change neither its spec, e.g. its TLS settings,
+// nor the Service in its status, so that Users are not reconciled on every status update of the cluster
+func clusterConnectionChanged() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*rabbitmqv1beta1.RabbitmqCluster)
+			if !ok {
+				return true
+			}
+			newCluster, ok := e.ObjectNew.(*rabbitmqv1beta1.RabbitmqCluster)
+			if !ok {
+				return true
+			}
+			return oldCluster.GetGeneration() != newCluster.GetGeneration() ||
+				!reflect.DeepEqual(clusterServiceReference(oldCluster), clusterServiceReference(newCluster))
+		},
+	}
+}
+
+func clusterServiceReference(cluster *rabbitmqv1beta1.RabbitmqCluster) *rabbitmqv1beta1.RabbitmqClusterServiceReference {
+	if cluster.Status.DefaultUser == nil {
+		return nil
+	}
+	return cluster.Status.DefaultUser.ServiceReference
+}
+
+// usersOfClusterService maps the Service of a RabbitmqCluster to the Users referencing the cluster
+func (r *UserReconciler) usersOfClusterService(object client.Object) []reconcile.Request {
+	owner := metav1.GetControllerOf(object)
+	if owner == nil || owner.Kind != "RabbitmqCluster" {
+		return nil
+	}
+	cluster := &rabbitmqv1beta1.RabbitmqCluster{}
+	cluster.Name = owner.Name
+	cluster.Namespace = object.GetNamespace()
+	return r.usersOfCluster(cluster)
+}
+
+// usersOfClusterTLSSecret maps the TLS or CA Secret of a RabbitmqCluster to the Users referencing the cluster
+func (r *UserReconciler) usersOfClusterTLSSecret(object client.Object) []reconcile.Request {
+	clusters := &rabbitmqv1beta1.RabbitmqClusterList{}
+	if err := r.List(context.Background(), clusters, client.InNamespace(object.GetNamespace())); err != nil {
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if cluster.Spec.TLS.SecretName == object.GetName() || cluster.Spec.TLS.CaSecretName == object.GetName() {
+			requests = append(requests, r.usersOfCluster(cluster)...)
+		}
+	}
+	return requests
+}
+
 func addResourceToIndex(rawObj client.Object) []string {
 	switch resourceObject := rawObj.(type) {
 	case *corev1.Secret:
@@ -3,18 +3,25 @@ package controllers_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"time"
 
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	rabbitmqv1beta1 "github.com/rabbitmq/cluster-operator/api/v1beta1"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient/rabbitmqclientfakes"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 )
 
 var _ = Describe("UserController", func() {
@@ -280,6 +287,108 @@ var _ = Describe("UserController", func() {
 		})
 	})
 
+	When("user limits are configured", func() {
+		JustBeforeEach(func() {
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userName,
+					Namespace: "default",
+				},
+				Spec: topology.UserSpec{
+					Limits: &topology.UserLimits{
+						MaxConnections: pointer.Int32Ptr(5),
+						MaxChannels:    pointer.Int32Ptr(20),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+
+		When("the RabbitMQ Client successfully sets the limits", func() {
+			BeforeEach(func() {
+				userName = "test-user-limits-success"
+				fakeRabbitMQClient.PutUserLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+			})
+
+			It("sets the limits on the user", func() {
+				Expect(client.Create(ctx, &user)).To(Succeed())
+				Eventually(func() int {
+					return fakeRabbitMQClient.PutUserLimitsCallCount()
+				}, 10*time.Second, 1*time.Second).Should(BeNumerically(">=", 1))
+				_, limits := fakeRabbitMQClient.PutUserLimitsArgsForCall(fakeRabbitMQClient.PutUserLimitsCallCount() - 1)
+				Expect(limits).To(Equal(rabbithole.UserLimitsValues{"max-connections": 5, "max-channels": 20}))
+			})
+		})
+
+		When("a limit is removed from the spec", func() {
+			BeforeEach(func() {
+				userName = "test-user-limits-removed"
+				fakeRabbitMQClient.PutUserLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+				fakeRabbitMQClient.DeleteUserLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+				fakeRabbitMQClient.GetUserLimitsReturns([]rabbithole.UserLimitsInfo{{
+					Value: rabbithole.UserLimitsValues{"max-connections": 5, "max-channels": 20},
+				}}, nil)
+			})
+
+			AfterEach(func() {
+				fakeRabbitMQClient.GetUserLimitsReturns(nil, nil)
+			})
+
+			It("clears the limit on the server", func() {
+				user.Spec.Limits.MaxChannels = nil
+				Expect(client.Create(ctx, &user)).To(Succeed())
+				Eventually(func() int {
+					return fakeRabbitMQClient.DeleteUserLimitsCallCount()
+				}, 10*time.Second, 1*time.Second).Should(BeNumerically(">=", 1))
+				_, limits := fakeRabbitMQClient.DeleteUserLimitsArgsForCall(fakeRabbitMQClient.DeleteUserLimitsCallCount() - 1)
+				Expect(limits).To(Equal(rabbithole.UserLimits{"max-channels"}))
+			})
+		})
+
+		When("the RabbitMQ Client returns a HTTP error response when setting limits", func() {
+			BeforeEach(func() {
+				userName = "test-user-limits-http-error"
+				fakeRabbitMQClient.PutUserLimitsReturns(&http.Response{
+					Status:     "418 I'm a teapot",
+					StatusCode: 418,
+				}, errors.New("a limits failure"))
+			})
+
+			It("sets the status condition to indicate a failure to reconcile", func() {
+				Expect(client.Create(ctx, &user)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					_ = client.Get(
+						ctx,
+						types.NamespacedName{Name: user.Name, Namespace: user.Namespace},
+						&user,
+					)
+
+					return user.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Reason":  Equal("FailedCreateOrUpdate"),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": ContainSubstring("a limits failure"),
+				})))
+			})
+		})
+	})
+
 	When("a user references a cluster from a prohibited namespace", func() {
 		JustBeforeEach(func() {
 			userName = "test-user-prohibited"
@@ -390,4 +499,403 @@ var _ = Describe("UserController", func() {
 			})))
 		})
 	})
+
+	When("a password rotation is requested", func() {
+		JustBeforeEach(func() {
+			userName = "test-user-password-rotation"
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userName,
+					Namespace: "default",
+				},
+				Spec: topology.UserSpec{
+					PasswordRotation: &topology.PasswordRotation{Interval: metav1.Duration{Duration: 2160 * time.Hour}},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+
+		It("generates a new password, and records the rotation in status", func() {
+			Expect(client.Create(ctx, &user)).To(Succeed())
+
+			var credentials corev1.Secret
+			Eventually(func() error {
+				return client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+			initialPassword := string(credentials.Data["password"])
+
+			By("not rotating the password before the rotation interval has elapsed", func() {
+				Consistently(func() string {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+					return string(credentials.Data["password"])
+				}, 3*time.Second, 1*time.Second).Should(Equal(initialPassword))
+			})
+
+			By("rotating the password when the rotate-password annotation is set", func() {
+				Expect(client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)).To(Succeed())
+				user.Annotations = map[string]string{topology.AnnotationRotatePassword: "2026-10-17"}
+				Expect(client.Update(ctx, &user)).To(Succeed())
+
+				Eventually(func() string {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+					return string(credentials.Data["password"])
+				}, 10*time.Second, 1*time.Second).ShouldNot(Equal(initialPassword))
+				Expect(credentials.Data["password"]).NotTo(BeEmpty())
+			})
+
+			By("recording the rotation in status", func() {
+				Eventually(func() topology.UserStatus {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+					return user.Status
+				}, 10*time.Second, 1*time.Second).Should(MatchFields(IgnoreExtras, Fields{
+					"LastPasswordRotation":        Not(BeNil()),
+					"LastPasswordRotationRequest": Equal("2026-10-17"),
+				}))
+			})
+
+			By("rotating the password once per annotation value", func() {
+				rotatedPassword := string(credentials.Data["password"])
+				Consistently(func() string {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+					return string(credentials.Data["password"])
+				}, 3*time.Second, 1*time.Second).Should(Equal(rotatedPassword))
+			})
+		})
+	})
+
+	When("a password rotation cannot be applied in RabbitMQ", func() {
+		JustBeforeEach(func() {
+			userName = "test-user-failed-password-rotation"
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        userName,
+					Namespace:   "default",
+					Annotations: map[string]string{topology.AnnotationRotatePassword: "initial"},
+				},
+				Spec: topology.UserSpec{
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+
+		It("keeps the password in the credentials secret, and does not record the rotation", func() {
+			Expect(client.Create(ctx, &user)).To(Succeed())
+
+			var credentials corev1.Secret
+			Eventually(func() error {
+				return client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+			}, 10*time.Second, 1*time.Second).Should(Succeed())
+			initialPassword := string(credentials.Data["password"])
+
+			By("not rotating the password for an annotation set at creation", func() {
+				Eventually(func() string {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+					return user.Status.LastPasswordRotationRequest
+				}, 10*time.Second, 1*time.Second).Should(Equal("initial"))
+				Expect(user.Status.LastPasswordRotation).To(BeNil())
+			})
+
+			By("keeping the password when RabbitMQ rejects the new one", func() {
+				fakeRabbitMQClient.PutUserReturns(nil, errors.New("some HTTP error"))
+				Expect(client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)).To(Succeed())
+				user.Annotations = map[string]string{topology.AnnotationRotatePassword: "2026-10-17"}
+				Expect(client.Update(ctx, &user)).To(Succeed())
+
+				Eventually(func() []topology.Condition {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+					return user.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": ContainSubstring("some HTTP error"),
+				})))
+				Expect(user.Status.LastPasswordRotation).To(BeNil())
+				Expect(user.Status.LastPasswordRotationRequest).To(Equal("initial"))
+				Expect(client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)).To(Succeed())
+				Expect(string(credentials.Data["password"])).To(Equal(initialPassword))
+			})
+		})
+	})
+
+	When("user credentials are stored in Vault", func() {
+		var fakeSecretStoreClient *rabbitmqclientfakes.FakeSecretStoreClient
+
+		BeforeEach(func() {
+			userName = "test-user-vault-credentials"
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userName,
+					Namespace: "default",
+				},
+				Spec: topology.UserSpec{
+					CredentialsBackend: &topology.UserCredentialsBackend{
+						Vault: &topology.VaultCredentialsBackend{Path: "secret/data/rabbitmq/users/test-user-vault-credentials"},
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+
+			fakeSecretStoreClient = &rabbitmqclientfakes.FakeSecretStoreClient{}
+			fakeSecretStoreClient.WriteCredentialsCalls(func(_, username, password string) error {
+				fakeSecretStoreClient.ReadCredentialsReturns(username, password, nil)
+				return nil
+			})
+			rabbitmqclient.SecretStoreClientProvider = func() (rabbitmqclient.SecretStoreClient, error) {
+				return fakeSecretStoreClient, nil
+			}
+		})
+
+		AfterEach(func() {
+			rabbitmqclient.SecretStoreClientProvider = rabbitmqclient.GetSecretStoreClient
+		})
+
+		It("writes the generated credentials to Vault instead of a Secret", func() {
+			Expect(client.Create(ctx, &user)).To(Succeed())
+			Eventually(func() []topology.Condition {
+				_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+				return user.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Ready")),
+				"Reason": Equal("SuccessfulCreateOrUpdate"),
+				"Status": Equal(corev1.ConditionTrue),
+			})))
+
+			Expect(user.Status.CredentialsVaultPath).To(Equal("secret/data/rabbitmq/users/test-user-vault-credentials"))
+			Expect(user.Status.Credentials).To(BeNil())
+			Expect(user.Status.Username).NotTo(BeEmpty())
+
+			Expect(fakeSecretStoreClient.WriteCredentialsCallCount()).To(Equal(1))
+			path, username, password := fakeSecretStoreClient.WriteCredentialsArgsForCall(0)
+			Expect(path).To(Equal("secret/data/rabbitmq/users/test-user-vault-credentials"))
+			Expect(username).To(Equal(user.Status.Username))
+			Expect(password).NotTo(BeEmpty())
+
+			var credentials corev1.Secret
+			err := client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(fakeSecretStoreClient.ReadCredentialsArgsForCall(0)).To(Equal("secret/data/rabbitmq/users/test-user-vault-credentials"))
+		})
+
+		When("the Vault path is not below the configured prefix", func() {
+			BeforeEach(func() {
+				userName = "test-user-vault-credentials-forbidden"
+				user.Name = userName
+				user.Spec.CredentialsBackend.Vault.Path = "secret/data/rabbitmq/default-user"
+			})
+
+			It("does not write the credentials to Vault", func() {
+				Expect(client.Create(ctx, &user)).To(Succeed())
+				Eventually(func() []topology.Condition {
+					_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+					return user.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": ContainSubstring("must be below 'secret/data/rabbitmq/users'"),
+				})))
+				Expect(fakeSecretStoreClient.WriteCredentialsCallCount()).To(Equal(0))
+				Expect(user.Status.CredentialsVaultPath).To(BeEmpty())
+			})
+		})
+	})
+
+	When("a user references a cluster whose connection details change", func() {
+		var cluster rabbitmqv1beta1.RabbitmqCluster
+
+		BeforeEach(func() {
+			Expect(client.Create(ctx, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "binding-rabbit",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Port: 15672, Name: "management"},
+						{Port: 15671, Name: "management-tls"},
+						{Port: 5672, Name: "amqp"},
+						{Port: 5671, Name: "amqps"},
+					},
+				},
+			})).To(Succeed())
+			Expect(client.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "binding-rabbit-ca",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"ca.crt": []byte("a-ca-certificate"),
+				},
+			})).To(Succeed())
+			cluster = rabbitmqv1beta1.RabbitmqCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "binding-rabbit",
+					Namespace: "default",
+				},
+			}
+			Expect(client.Create(ctx, &cluster)).To(Succeed())
+			cluster.Status = rabbitmqv1beta1.RabbitmqClusterStatus{
+				Binding: &corev1.LocalObjectReference{
+					Name: "allow-all-rabbit-user-credentials",
+				},
+				DefaultUser: &rabbitmqv1beta1.RabbitmqClusterDefaultUser{
+					ServiceReference: &rabbitmqv1beta1.RabbitmqClusterServiceReference{
+						Name:      "binding-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			cluster.Status.SetConditions([]runtime.Object{})
+			Expect(client.Status().Update(ctx, &cluster)).To(Succeed())
+
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+			userName = "test-user-service-binding"
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userName,
+					Namespace: "default",
+				},
+				Spec: topology.UserSpec{
+					Vhost: "a-vhost",
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "binding-rabbit",
+					},
+				},
+			}
+		})
+
+		It("sets the connection details of the cluster in the credentials secret, and keeps them in sync", func() {
+			Expect(client.Create(ctx, &user)).To(Succeed())
+
+			var credentials corev1.Secret
+			fetchCredentials := func() map[string][]byte {
+				_ = client.Get(ctx, types.NamespacedName{Name: userName + "-user-credentials", Namespace: "default"}, &credentials)
+				return credentials.Data
+			}
+
+			By("setting the Provisioned Service entries", func() {
+				Eventually(fetchCredentials, 10*time.Second, 1*time.Second).Should(HaveKey("uri"))
+				Expect(credentials.Data).To(MatchAllKeys(Keys{
+					"username": Not(BeEmpty()),
+					"password": Not(BeEmpty()),
+					"type":     BeEquivalentTo("rabbitmq"),
+					"provider": BeEquivalentTo("rabbitmq"),
+					"host":     BeEquivalentTo("binding-rabbit.default.svc"),
+					"port":     BeEquivalentTo("5672"),
+					"vhost":    BeEquivalentTo("a-vhost"),
+					"uri": BeEquivalentTo(fmt.Sprintf("amqp://%s:%s@binding-rabbit.default.svc:5672/a-vhost",
+						credentials.Data["username"], credentials.Data["password"])),
+				}))
+			})
+
+			By("updating the entries when TLS is enabled on the cluster", func() {
+				Expect(client.Get(ctx, types.NamespacedName{Name: "binding-rabbit", Namespace: "default"}, &cluster)).To(Succeed())
+				cluster.Spec.TLS = rabbitmqv1beta1.TLSSpec{SecretName: "binding-rabbit-tls", CaSecretName: "binding-rabbit-ca"}
+				Expect(client.Update(ctx, &cluster)).To(Succeed())
+
+				Eventually(func() string {
+					return string(fetchCredentials()["port"])
+				}, 10*time.Second, 1*time.Second).Should(Equal("5671"))
+				Expect(string(credentials.Data["uri"])).To(HavePrefix("amqps://"))
+				Expect(string(credentials.Data["ca.crt"])).To(Equal("a-ca-certificate"))
+			})
+		})
+	})
+
+	When("the connection details of the referenced cluster cannot be found", func() {
+		BeforeEach(func() {
+			Expect(client.Create(ctx, &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-amqp-rabbit",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Port: 15672, Name: "management"},
+					},
+				},
+			})).To(Succeed())
+			cluster := rabbitmqv1beta1.RabbitmqCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "no-amqp-rabbit",
+					Namespace: "default",
+				},
+			}
+			Expect(client.Create(ctx, &cluster)).To(Succeed())
+			cluster.Status = rabbitmqv1beta1.RabbitmqClusterStatus{
+				Binding: &corev1.LocalObjectReference{
+					Name: "allow-all-rabbit-user-credentials",
+				},
+				DefaultUser: &rabbitmqv1beta1.RabbitmqClusterDefaultUser{
+					ServiceReference: &rabbitmqv1beta1.RabbitmqClusterServiceReference{
+						Name:      "no-amqp-rabbit",
+						Namespace: "default",
+					},
+				},
+			}
+			cluster.Status.SetConditions([]runtime.Object{})
+			Expect(client.Status().Update(ctx, &cluster)).To(Succeed())
+
+			fakeRabbitMQClient.PutUserReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+			userName = "test-user-no-amqp-port"
+			user = topology.User{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      userName,
+					Namespace: "default",
+				},
+				Spec: topology.UserSpec{
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "no-amqp-rabbit",
+					},
+				},
+			}
+		})
+
+		It("declares the user, and sets the status condition to indicate the missing connection details", func() {
+			Expect(client.Create(ctx, &user)).To(Succeed())
+
+			Eventually(func() []topology.Condition {
+				_ = client.Get(ctx, types.NamespacedName{Name: userName, Namespace: "default"}, &user)
+				return user.Status.Conditions
+			}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":    Equal(topology.ConditionType("Ready")),
+				"Status":  Equal(corev1.ConditionFalse),
+				"Message": ContainSubstring("failed to find 'amqp' port"),
+			})))
+
+			declaredUsernames := func() []string {
+				var usernames []string
+				for i := 0; i < fakeRabbitMQClient.PutUserCallCount(); i++ {
+					username, _ := fakeRabbitMQClient.PutUserArgsForCall(i)
+					usernames = append(usernames, username)
+				}
+				return usernames
+			}
+			Expect(declaredUsernames()).To(ContainElement(user.Status.Username))
+		})
+	})
 })
@@ -18,8 +18,11 @@ import (
 	"strings"
 	"time"
 
+	rabbitmqv1beta1 "github.com/rabbitmq/cluster-operator/api/v1beta1"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	clientretry "k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -82,10 +85,13 @@ func removeFinalizer(ctx context.Context, client client.Client, obj client.Objec
 // deletionFinalizer returns generated deletion finalizer
 // finalizers follow the format of deletion.finalizers.kind-plural-form.rabbitmq.com
 // for example: deletion.finalizers.bindings.rabbitmq.com and deletion.finalizers.policies.rabbitmq.com
+// kinds ending in 'y' such as Policy and OperatorPolicy are pluralized with 'ies'
 func deletionFinalizer(kind string) string {
 	var plural string
-	if kind == "Policy" {
-		plural = "policies"
+	if strings.HasSuffix(kind, "y") {
+		plural = strings.ToLower(strings.TrimSuffix(kind, "y")) + "ies"
+	} else if strings.HasSuffix(kind, "s") {
+		plural = strings.ToLower(kind)
 	} else {
 		plural = strings.ToLower(kind) + "s"
 	}
@@ -127,3 +133,94 @@ func handleRMQReferenceParseError(ctx context.Context, client client.Client, eve
 	return reconcile.Result{}, err
 
 }
+
+// childResourceReadiness returns the readiness of a generated resource from its Ready condition
+// a resource whose latest generation has not been reconciled yet has an Unknown readiness
+func childResourceReadiness(resource client.Object) topologyv1alpha1.ChildResourceStatus {
+	var kind string
+	var conditions []topology.Condition
+	var observedGeneration int64
+	switch r := resource.(type) {
+	case *topology.Queue:
+		kind, conditions, observedGeneration = "Queue", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.Exchange:
+		kind, conditions, observedGeneration = "Exchange", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.Binding:
+		kind, conditions, observedGeneration = "Binding", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.Policy:
+		kind, conditions, observedGeneration = "Policy", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.Vhost:
+		kind, conditions, observedGeneration = "Vhost", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.User:
+		kind, conditions, observedGeneration = "User", r.Status.Conditions, r.Status.ObservedGeneration
+	case *topology.Permission:
+		kind, conditions, observedGeneration = "Permission", r.Status.Conditions, r.Status.ObservedGeneration
+	}
+
+	readiness := topologyv1alpha1.ChildResourceStatus{
+		Kind:  kind,
+		Name:  resource.GetName(),
+		Ready: corev1.ConditionUnknown,
+	}
+	for _, condition := range conditions {
+		if condition.Type != "Ready" {
+			continue
+		}
+		readiness.Message = condition.Message
+		if condition.Status == corev1.ConditionFalse || observedGeneration == resource.GetGeneration() {
+			readiness.Ready = condition.Status
+		}
+	}
+	return readiness
+}
+
+// aggregateReadiness returns a Ready condition which is true when all generated resources are ready
+func aggregateReadiness(resources []topologyv1alpha1.ChildResourceStatus, lastConditions []topology.Condition) topology.Condition {
+	var notReady []string
+	for _, resource := range resources {
+		if resource.Ready == corev1.ConditionTrue {
+			continue
+		}
+		msg := fmt.Sprintf("%s '%s' is not ready", resource.Kind, resource.Name)
+		if resource.Ready == corev1.ConditionFalse && resource.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, resource.Message)
+		}
+		notReady = append(notReady, msg)
+	}
+	if len(notReady) > 0 {
+		return topology.NotReady(strings.Join(notReady, "; "), lastConditions)
+	}
+	return topology.Ready(lastConditions)
+}
+
+// childRabbitmqClusterReference returns the RabbitmqCluster reference of the resources generated by a composite resource, such as a SuperStream
+// a connectionSecret reference is passed through to the generated resources, which are in the same namespace
+// a RabbitmqCluster referenced by name must exist, and allow resources from the namespace of the composite resource
+func childRabbitmqClusterReference(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string) (*topology.RabbitmqClusterReference, error) {
+	if rmq.ConnectionSecret != nil {
+		return &topology.RabbitmqClusterReference{
+			ConnectionSecret: rmq.ConnectionSecret,
+		}, nil
+	}
+
+	var namespace string
+	if rmq.Namespace == "" {
+		namespace = requestNamespace
+	} else {
+		namespace = rmq.Namespace
+	}
+
+	cluster := &rabbitmqv1beta1.RabbitmqCluster{}
+	if err := c.Get(ctx, types.NamespacedName{Name: rmq.Name, Namespace: namespace}, cluster); err != nil {
+		return nil, fmt.Errorf("failed to get cluster from reference: %s Error: %w", err, rabbitmqclient.NoSuchRabbitmqClusterError)
+	}
+
+	if !rabbitmqclient.AllowedNamespace(rmq, requestNamespace, cluster) {
+		return nil, rabbitmqclient.ResourceNotAllowedError
+	}
+
+	return &topology.RabbitmqClusterReference{
+		Name:      rmq.Name,
+		Namespace: namespace,
+	}, nil
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"sort"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 
 	"github.com/rabbitmq/messaging-topology-operator/internal"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
@@ -119,9 +122,94 @@ func (r *VhostReconciler) putVhost(ctx context.Context, client rabbitmqclient.Cl
 
 	logger.Info("Successfully created vhost", "vhost", vhost.Spec.Name)
 	r.Recorder.Event(vhost, corev1.EventTypeNormal, "SuccessfulCreate", "Successfully created vhost")
+	return r.putVhostLimits(ctx, client, vhost)
+}
+
+// sets limits from vhost.spec.limits using rabbithole client.PutVhostLimits
+// limits set on the server but not in vhost.spec.limits are cleared using rabbithole client.DeleteVhostLimits
+// limits in effect on the server afterwards are recorded in vhost.status.limits
+func (r *VhostReconciler) putVhostLimits(ctx context.Context, client rabbitmqclient.Client, vhost *topology.Vhost) error {
+	logger := ctrl.LoggerFrom(ctx)
+
+	currentLimits, err := client.GetVhostLimits(vhost.Spec.Name)
+	if err != nil {
+		msg := "failed to get vhost limits"
+		r.Recorder.Event(vhost, corev1.EventTypeWarning, "FailedUpdate", msg)
+		logger.Error(err, msg, "vhost", vhost.Spec.Name)
+		return err
+	}
+
+	desiredLimits := internal.GenerateVhostLimits(vhost)
+	var removedLimits rabbithole.VhostLimits
+	for _, info := range currentLimits {
+		for limit := range info.Value {
+			if _, ok := desiredLimits[limit]; !ok {
+				removedLimits = append(removedLimits, limit)
+			}
+		}
+	}
+	sort.Strings(removedLimits)
+
+	if len(desiredLimits) > 0 {
+		if err := validateResponse(client.PutVhostLimits(vhost.Spec.Name, desiredLimits)); err != nil {
+			msg := "failed to set vhost limits"
+			r.Recorder.Event(vhost, corev1.EventTypeWarning, "FailedUpdate", msg)
+			logger.Error(err, msg, "vhost", vhost.Spec.Name)
+			return err
+		}
+	}
+
+	if len(removedLimits) > 0 {
+		err := validateResponseForDeletion(client.DeleteVhostLimits(vhost.Spec.Name, removedLimits))
+		if errors.Is(err, NotFound) {
+			logger.Info("cannot find vhost limits in rabbitmq server; already cleared", "vhost", vhost.Spec.Name, "limits", removedLimits)
+		} else if err != nil {
+			msg := "failed to clear vhost limits"
+			r.Recorder.Event(vhost, corev1.EventTypeWarning, "FailedUpdate", msg)
+			logger.Error(err, msg, "vhost", vhost.Spec.Name, "limits", removedLimits)
+			return err
+		}
+	}
+
+	if len(desiredLimits) == 0 && len(removedLimits) == 0 {
+		vhost.Status.Limits = nil
+		return nil
+	}
+
+	appliedLimits, err := client.GetVhostLimits(vhost.Spec.Name)
+	if err != nil {
+		msg := "failed to get vhost limits"
+		r.Recorder.Event(vhost, corev1.EventTypeWarning, "FailedUpdate", msg)
+		logger.Error(err, msg, "vhost", vhost.Spec.Name)
+		return err
+	}
+	vhost.Status.Limits = vhostLimitsStatus(appliedLimits)
+
+	logger.Info("Successfully updated vhost limits", "vhost", vhost.Spec.Name)
 	return nil
 }
 
+// vhostLimitsStatus converts limits returned by the RabbitMQ server into vhost.status.limits
+// returns nil when no limits are set
+func vhostLimitsStatus(limitsInfo []rabbithole.VhostLimitsInfo) *topology.VhostLimits {
+	var limits *topology.VhostLimits
+	for _, info := range limitsInfo {
+		for limit, value := range info.Value {
+			v := int32(value)
+			if limits == nil {
+				limits = &topology.VhostLimits{}
+			}
+			switch limit {
+			case internal.VhostLimitMaxConnections:
+				limits.MaxConnections = &v
+			case internal.VhostLimitMaxQueues:
+				limits.MaxQueues = &v
+			}
+		}
+	}
+	return limits
+}
+
 // deletes vhost from server
 // if server responds with '404' Not Found, it logs and does not requeue on error
 func (r *VhostReconciler) deleteVhost(ctx context.Context, client rabbitmqclient.Client, vhost *topology.Vhost) error {
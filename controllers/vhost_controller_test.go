@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -15,6 +16,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
 )
 
 var _ = Describe("vhost-controller", func() {
@@ -218,6 +220,130 @@ var _ = Describe("vhost-controller", func() {
 		})
 	})
 
+	When("vhost limits are configured", func() {
+		JustBeforeEach(func() {
+			vhost = topology.Vhost{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      vhostName,
+					Namespace: "default",
+				},
+				Spec: topology.VhostSpec{
+					Name: vhostName,
+					Limits: &topology.VhostLimits{
+						MaxConnections: pointer.Int32Ptr(10),
+						MaxQueues:      pointer.Int32Ptr(20),
+					},
+					RabbitmqClusterReference: topology.RabbitmqClusterReference{
+						Name: "example-rabbit",
+					},
+				},
+			}
+			fakeRabbitMQClient.PutVhostReturns(&http.Response{
+				Status:     "201 Created",
+				StatusCode: http.StatusCreated,
+			}, nil)
+		})
+
+		When("the RabbitMQ Client successfully sets the limits", func() {
+			BeforeEach(func() {
+				vhostName = "test-limits-success"
+				fakeRabbitMQClient.PutVhostLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+				fakeRabbitMQClient.GetVhostLimitsReturns([]rabbithole.VhostLimitsInfo{{
+					Vhost: vhostName,
+					Value: rabbithole.VhostLimitsValues{"max-connections": 10, "max-queues": 20},
+				}}, nil)
+			})
+
+			AfterEach(func() {
+				fakeRabbitMQClient.GetVhostLimitsReturns(nil, nil)
+			})
+
+			It("sets the limits and records them in status", func() {
+				Expect(client.Create(ctx, &vhost)).To(Succeed())
+				Eventually(func() *topology.VhostLimits {
+					_ = client.Get(
+						ctx,
+						types.NamespacedName{Name: vhost.Name, Namespace: vhost.Namespace},
+						&vhost,
+					)
+					return vhost.Status.Limits
+				}, 10*time.Second, 1*time.Second).Should(Equal(&topology.VhostLimits{
+					MaxConnections: pointer.Int32Ptr(10),
+					MaxQueues:      pointer.Int32Ptr(20),
+				}))
+
+				Expect(fakeRabbitMQClient.PutVhostLimitsCallCount()).To(BeNumerically(">=", 1))
+				name, limits := fakeRabbitMQClient.PutVhostLimitsArgsForCall(fakeRabbitMQClient.PutVhostLimitsCallCount() - 1)
+				Expect(name).To(Equal(vhostName))
+				Expect(limits).To(Equal(rabbithole.VhostLimitsValues{"max-connections": 10, "max-queues": 20}))
+			})
+		})
+
+		When("a limit is removed from the spec", func() {
+			BeforeEach(func() {
+				vhostName = "test-limits-removed"
+				fakeRabbitMQClient.PutVhostLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+				fakeRabbitMQClient.DeleteVhostLimitsReturns(&http.Response{
+					Status:     "204 No Content",
+					StatusCode: http.StatusNoContent,
+				}, nil)
+				fakeRabbitMQClient.GetVhostLimitsReturns([]rabbithole.VhostLimitsInfo{{
+					Vhost: vhostName,
+					Value: rabbithole.VhostLimitsValues{"max-connections": 10, "max-queues": 20},
+				}}, nil)
+			})
+
+			AfterEach(func() {
+				fakeRabbitMQClient.GetVhostLimitsReturns(nil, nil)
+			})
+
+			It("clears the limit on the server", func() {
+				vhost.Spec.Limits.MaxQueues = nil
+				Expect(client.Create(ctx, &vhost)).To(Succeed())
+				Eventually(func() int {
+					return fakeRabbitMQClient.DeleteVhostLimitsCallCount()
+				}, 10*time.Second, 1*time.Second).Should(BeNumerically(">=", 1))
+				name, limits := fakeRabbitMQClient.DeleteVhostLimitsArgsForCall(fakeRabbitMQClient.DeleteVhostLimitsCallCount() - 1)
+				Expect(name).To(Equal(vhostName))
+				Expect(limits).To(Equal(rabbithole.VhostLimits{"max-queues"}))
+			})
+		})
+
+		When("the RabbitMQ Client returns a HTTP error response when setting limits", func() {
+			BeforeEach(func() {
+				vhostName = "test-limits-http-error"
+				fakeRabbitMQClient.PutVhostLimitsReturns(&http.Response{
+					Status:     "418 I'm a teapot",
+					StatusCode: 418,
+				}, errors.New("a limits failure"))
+			})
+
+			It("sets the status condition to indicate a failure to reconcile", func() {
+				Expect(client.Create(ctx, &vhost)).To(Succeed())
+				EventuallyWithOffset(1, func() []topology.Condition {
+					_ = client.Get(
+						ctx,
+						types.NamespacedName{Name: vhost.Name, Namespace: vhost.Namespace},
+						&vhost,
+					)
+
+					return vhost.Status.Conditions
+				}, 10*time.Second, 1*time.Second).Should(ContainElement(MatchFields(IgnoreExtras, Fields{
+					"Type":    Equal(topology.ConditionType("Ready")),
+					"Reason":  Equal("FailedCreateOrUpdate"),
+					"Status":  Equal(corev1.ConditionFalse),
+					"Message": ContainSubstring("a limits failure"),
+				})))
+			})
+		})
+	})
+
 	When("a vhost references a cluster from a prohibited namespace", func() {
 		JustBeforeEach(func() {
 			vhostName = "test-vhost-prohibited"
@@ -0,0 +1,18 @@
+package internal
+
+import (
+	"net/url"
+)
+
+// GenerateAMQPURI returns the AMQP URI of a vhost at endpoint, e.g. 'amqp://rmq.rabbitmq-system.svc:5672', with the given credentials
+// the vhost is always part of the URI, since an URI without a path connects to the default vhost of the server
+func GenerateAMQPURI(endpoint, username, password, vhost string) (string, error) {
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	uri.User = url.UserPassword(username, password)
+	uri.Path = "/" + vhost
+	uri.RawPath = "/" + url.PathEscape(vhost)
+	return uri.String(), nil
+}
@@ -0,0 +1,156 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+const (
+	DefinitionsTypeVhost    = "vhost"
+	DefinitionsTypeExchange = "exchange"
+	DefinitionsTypeQueue    = "queue"
+	DefinitionsTypeBinding  = "binding"
+	DefinitionsTypePolicy   = "policy"
+)
+
+// Definitions holds the objects of a RabbitMQ definitions document which can be imported
+// other sections of the document, such as users and permissions, are only recorded so that they can be reported as skipped
+type Definitions struct {
+	Vhosts    []rabbithole.VhostInfo    `json:"vhosts"`
+	Exchanges []rabbithole.ExchangeInfo `json:"exchanges"`
+	Queues    []rabbithole.QueueInfo    `json:"queues"`
+	Bindings  []rabbithole.BindingInfo  `json:"bindings"`
+	Policies  []rabbithole.Policy       `json:"policies"`
+
+	Users            []json.RawMessage `json:"users"`
+	Permissions      []json.RawMessage `json:"permissions"`
+	TopicPermissions []json.RawMessage `json:"topic_permissions"`
+	Parameters       []json.RawMessage `json:"parameters"`
+	GlobalParameters []json.RawMessage `json:"global_parameters"`
+}
+
+// ParseDefinitions parses a definitions document as exported by RabbitMQ
+// objects without a vhost, as found in definitions exported from a single vhost, are set to defaultVhost
+func ParseDefinitions(data []byte, defaultVhost string) (*Definitions, error) {
+	definitions := &Definitions{}
+	if err := json.Unmarshal(data, definitions); err != nil {
+		return nil, fmt.Errorf("failed to parse definitions: %w", err)
+	}
+
+	for i := range definitions.Exchanges {
+		if definitions.Exchanges[i].Vhost == "" {
+			definitions.Exchanges[i].Vhost = defaultVhost
+		}
+	}
+	for i := range definitions.Queues {
+		if definitions.Queues[i].Vhost == "" {
+			definitions.Queues[i].Vhost = defaultVhost
+		}
+	}
+	for i := range definitions.Bindings {
+		if definitions.Bindings[i].Vhost == "" {
+			definitions.Bindings[i].Vhost = defaultVhost
+		}
+	}
+	for i := range definitions.Policies {
+		if definitions.Policies[i].Vhost == "" {
+			definitions.Policies[i].Vhost = defaultVhost
+		}
+	}
+	return definitions, nil
+}
+
+// UnsupportedSections returns the sections of the definitions document which are not imported
+func (d *Definitions) UnsupportedSections() []string {
+	var sections []string
+	if len(d.Users) > 0 {
+		sections = append(sections, "users")
+	}
+	if len(d.Permissions) > 0 {
+		sections = append(sections, "permissions")
+	}
+	if len(d.TopicPermissions) > 0 {
+		sections = append(sections, "topic_permissions")
+	}
+	if len(d.Parameters) > 0 {
+		sections = append(sections, "parameters")
+	}
+	if len(d.GlobalParameters) > 0 {
+		sections = append(sections, "global_parameters")
+	}
+	return sections
+}
+
+func GenerateVhostSettingsFromDefinition(v rabbithole.VhostInfo) rabbithole.VhostSettings {
+	return rabbithole.VhostSettings{
+		Description: v.Description,
+		Tags:        v.Tags,
+		Tracing:     v.Tracing,
+	}
+}
+
+func GenerateExchangeSettingsFromDefinition(e rabbithole.ExchangeInfo) rabbithole.ExchangeSettings {
+	return rabbithole.ExchangeSettings{
+		Type:       e.Type,
+		Durable:    e.Durable,
+		AutoDelete: bool(e.AutoDelete),
+		Arguments:  e.Arguments,
+	}
+}
+
+func GenerateQueueSettingsFromDefinition(q rabbithole.QueueInfo) rabbithole.QueueSettings {
+	return rabbithole.QueueSettings{
+		Type:       q.Type,
+		Durable:    q.Durable,
+		AutoDelete: bool(q.AutoDelete),
+		Arguments:  q.Arguments,
+	}
+}
+
+func VhostDefinitionsObject(v rabbithole.VhostInfo) topology.DefinitionsObject {
+	return topology.DefinitionsObject{Type: DefinitionsTypeVhost, Name: v.Name}
+}
+
+func ExchangeDefinitionsObject(e rabbithole.ExchangeInfo) topology.DefinitionsObject {
+	return topology.DefinitionsObject{Type: DefinitionsTypeExchange, Vhost: e.Vhost, Name: e.Name}
+}
+
+func QueueDefinitionsObject(q rabbithole.QueueInfo) topology.DefinitionsObject {
+	return topology.DefinitionsObject{Type: DefinitionsTypeQueue, Vhost: q.Vhost, Name: q.Name}
+}
+
+func BindingDefinitionsObject(b rabbithole.BindingInfo) topology.DefinitionsObject {
+	return topology.DefinitionsObject{
+		Type:            DefinitionsTypeBinding,
+		Vhost:           b.Vhost,
+		Source:          b.Source,
+		Destination:     b.Destination,
+		DestinationType: b.DestinationType,
+		RoutingKey:      b.RoutingKey,
+		PropertiesKey:   b.PropertiesKey,
+	}
+}
+
+func PolicyDefinitionsObject(p rabbithole.Policy) topology.DefinitionsObject {
+	return topology.DefinitionsObject{Type: DefinitionsTypePolicy, Vhost: p.Vhost, Name: p.Name}
+}
+
+// DefinitionsObjectKey returns a string which uniquely identifies a RabbitMQ object listed in Definitions status
+func DefinitionsObjectKey(o topology.DefinitionsObject) string {
+	if o.Type == DefinitionsTypeBinding {
+		return fmt.Sprintf("%s|%s|%s|%s|%s|%s", o.Type, o.Vhost, o.Source, o.DestinationType, o.Destination, o.PropertiesKey)
+	}
+	return fmt.Sprintf("%s|%s|%s", o.Type, o.Vhost, o.Name)
+}
@@ -0,0 +1,133 @@
+package internal_test
+
+import (
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	. "github.com/rabbitmq/messaging-topology-operator/internal"
+)
+
+var _ = Describe("Definitions", func() {
+	const document = `{
+  "rabbit_version": "3.10.5",
+  "users": [{"name": "a-user", "password_hash": "hash", "tags": ""}],
+  "vhosts": [{"name": "a-vhost", "description": "a description", "tags": ["a-tag"]}],
+  "permissions": [{"user": "a-user", "vhost": "a-vhost", "configure": ".*", "write": ".*", "read": ".*"}],
+  "policies": [{"vhost": "a-vhost", "name": "a-policy", "pattern": "^a-", "apply-to": "queues", "definition": {"max-length": 10}, "priority": 1}],
+  "queues": [
+    {"name": "a-queue", "vhost": "a-vhost", "durable": true, "auto_delete": false, "arguments": {"x-queue-type": "quorum"}},
+    {"name": "another-queue", "durable": false, "auto_delete": true, "arguments": {}}
+  ],
+  "exchanges": [{"name": "an-exchange", "vhost": "a-vhost", "type": "topic", "durable": true, "auto_delete": false, "internal": false, "arguments": {}}],
+  "bindings": [{"source": "an-exchange", "vhost": "a-vhost", "destination": "a-queue", "destination_type": "queue", "routing_key": "a.#", "arguments": {}}]
+}`
+
+	Context("ParseDefinitions", func() {
+		It("parses all supported objects", func() {
+			definitions, err := ParseDefinitions([]byte(document), "/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(definitions.Vhosts).To(HaveLen(1))
+			Expect(definitions.Vhosts[0].Name).To(Equal("a-vhost"))
+			Expect(definitions.Exchanges).To(HaveLen(1))
+			Expect(definitions.Exchanges[0].Type).To(Equal("topic"))
+			Expect(definitions.Queues).To(HaveLen(2))
+			Expect(definitions.Queues[0].Arguments).To(HaveKeyWithValue("x-queue-type", "quorum"))
+			Expect(definitions.Bindings).To(HaveLen(1))
+			Expect(definitions.Bindings[0].RoutingKey).To(Equal("a.#"))
+			Expect(definitions.Policies).To(HaveLen(1))
+			Expect(definitions.Policies[0].ApplyTo).To(Equal("queues"))
+			Expect(definitions.Policies[0].Definition).To(HaveKeyWithValue("max-length", float64(10)))
+		})
+
+		It("sets the default vhost on objects without a vhost", func() {
+			definitions, err := ParseDefinitions([]byte(document), "default-vhost")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(definitions.Queues[0].Vhost).To(Equal("a-vhost"))
+			Expect(definitions.Queues[1].Vhost).To(Equal("default-vhost"))
+		})
+
+		It("reports sections which are not imported", func() {
+			definitions, err := ParseDefinitions([]byte(document), "/")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(definitions.UnsupportedSections()).To(ConsistOf("users", "permissions"))
+		})
+
+		It("errors when the document is not valid json", func() {
+			_, err := ParseDefinitions([]byte(`{"queues": [`), "/")
+			Expect(err).To(MatchError(ContainSubstring("failed to parse definitions")))
+		})
+	})
+
+	Context("settings", func() {
+		It("generates vhost settings", func() {
+			settings := GenerateVhostSettingsFromDefinition(rabbithole.VhostInfo{
+				Name:        "a-vhost",
+				Description: "a description",
+				Tags:        rabbithole.VhostTags{"a-tag"},
+				Tracing:     true,
+			})
+			Expect(settings).To(Equal(rabbithole.VhostSettings{
+				Description: "a description",
+				Tags:        rabbithole.VhostTags{"a-tag"},
+				Tracing:     true,
+			}))
+		})
+
+		It("generates exchange settings", func() {
+			settings := GenerateExchangeSettingsFromDefinition(rabbithole.ExchangeInfo{
+				Name:       "an-exchange",
+				Type:       "fanout",
+				Durable:    true,
+				AutoDelete: true,
+				Arguments:  map[string]interface{}{"alternate-exchange": "another-exchange"},
+			})
+			Expect(settings).To(Equal(rabbithole.ExchangeSettings{
+				Type:       "fanout",
+				Durable:    true,
+				AutoDelete: true,
+				Arguments:  map[string]interface{}{"alternate-exchange": "another-exchange"},
+			}))
+		})
+
+		It("generates queue settings", func() {
+			settings := GenerateQueueSettingsFromDefinition(rabbithole.QueueInfo{
+				Name:      "a-queue",
+				Durable:   true,
+				Arguments: map[string]interface{}{"x-queue-type": "quorum"},
+			})
+			Expect(settings).To(Equal(rabbithole.QueueSettings{
+				Durable:   true,
+				Arguments: map[string]interface{}{"x-queue-type": "quorum"},
+			}))
+		})
+	})
+
+	Context("DefinitionsObjectKey", func() {
+		It("identifies objects by type, vhost and name", func() {
+			queue := QueueDefinitionsObject(rabbithole.QueueInfo{Name: "a-name", Vhost: "a-vhost"})
+			exchange := ExchangeDefinitionsObject(rabbithole.ExchangeInfo{Name: "a-name", Vhost: "a-vhost"})
+			Expect(DefinitionsObjectKey(queue)).NotTo(Equal(DefinitionsObjectKey(exchange)))
+			Expect(DefinitionsObjectKey(queue)).To(Equal(DefinitionsObjectKey(topology.DefinitionsObject{
+				Type:    "queue",
+				Vhost:   "a-vhost",
+				Name:    "a-name",
+				Message: "ignored",
+			})))
+		})
+
+		It("identifies bindings by their properties key", func() {
+			binding := BindingDefinitionsObject(rabbithole.BindingInfo{
+				Source:          "an-exchange",
+				Vhost:           "a-vhost",
+				Destination:     "a-queue",
+				DestinationType: "queue",
+				RoutingKey:      "a.#",
+				PropertiesKey:   "a.%23",
+			})
+			another := binding
+			another.PropertiesKey = "b.%23"
+			Expect(DefinitionsObjectKey(binding)).NotTo(Equal(DefinitionsObjectKey(another)))
+		})
+	})
+})
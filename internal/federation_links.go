@@ -0,0 +1,81 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+const FederationLinkStatusRunning = "running"
+
+// GenerateFederationLinks returns the links of federation upstream 'upstream' from the links reported by RabbitMQ
+// links are sorted by type and name; credentials are removed from the link uri
+func GenerateFederationLinks(links []map[string]interface{}, upstream string) []topology.FederationLink {
+	var result []topology.FederationLink
+	for _, link := range links {
+		if linkField(link, "upstream") != upstream {
+			continue
+		}
+		linkType := linkField(link, "type")
+		result = append(result, topology.FederationLink{
+			Type:   linkType,
+			Name:   linkField(link, linkType),
+			URI:    redactURI(linkField(link, "uri")),
+			Status: linkField(link, "status"),
+			Error:  linkField(link, "error"),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// FederationLinksDown returns links which are not running
+func FederationLinksDown(links []topology.FederationLink) []topology.FederationLink {
+	var down []topology.FederationLink
+	for _, link := range links {
+		if link.Status != FederationLinkStatusRunning {
+			down = append(down, link)
+		}
+	}
+	return down
+}
+
+func linkField(link map[string]interface{}, key string) string {
+	value, ok := link[key]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// redactURI removes the password from uri
+// uris which cannot be parsed are omitted, since they may contain credentials
+func redactURI(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return ""
+	}
+	if u.User != nil {
+		u.User = url.User(u.User.Username())
+	}
+	return u.String()
+}
@@ -0,0 +1,61 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ApplicationBindingBuilder builds a binding of a MessagingApplication
+// bindings cannot be updated, so the name of the binding object is derived from all its properties:
+// updating a binding in the MessagingApplication replaces its binding object
+type ApplicationBindingBuilder struct {
+	*Builder
+	binding         *topologyv1alpha1.ApplicationBinding
+	vhost           string
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationBinding(binding *topologyv1alpha1.ApplicationBinding, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationBindingBuilder {
+	return &ApplicationBindingBuilder{builder, binding, vhost, rabbitmqCluster}
+}
+
+func (builder *ApplicationBindingBuilder) bindingSuffix() string {
+	key := fmt.Sprintf("%s/%s/%s/%s", builder.binding.Source, builder.binding.DestinationType, builder.binding.Destination, builder.binding.RoutingKey)
+	if builder.binding.Arguments != nil {
+		key += "/" + string(builder.binding.Arguments.Raw)
+	}
+	return "-binding-" + applicationHash(key)
+}
+
+func (builder *ApplicationBindingBuilder) Build() (client.Object, error) {
+	return &topology.Binding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(builder.bindingSuffix()),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationBindingBuilder) Update(object client.Object) error {
+	binding := object.(*topology.Binding)
+	binding.Spec.Vhost = builder.vhost
+	binding.Spec.Source = builder.binding.Source
+	binding.Spec.Destination = builder.binding.Destination
+	binding.Spec.DestinationType = builder.binding.DestinationType
+	binding.Spec.RoutingKey = builder.binding.RoutingKey
+	binding.Spec.Arguments = builder.binding.Arguments
+	binding.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationBindingBuilder) ResourceType() string { return "Binding" }
@@ -0,0 +1,73 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ApplicationBinding", func() {
+	var (
+		application topologyv1alpha1.MessagingApplication
+		builder     *managedresource.Builder
+		scheme      *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		application = topologyv1alpha1.MessagingApplication{}
+		application.Namespace = "foo"
+		application.Name = "foo"
+		application.Spec = topologyv1alpha1.MessagingApplicationSpec{
+			Vhost: "orders",
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &application,
+			Scheme:      scheme,
+		}
+	})
+
+	var (
+		applicationBinding topologyv1alpha1.ApplicationBinding
+		binding            *topology.Binding
+	)
+
+	BeforeEach(func() {
+		applicationBinding = topologyv1alpha1.ApplicationBinding{
+			Source:          "orders",
+			Destination:     "orders.created",
+			DestinationType: "queue",
+			RoutingKey:      "created",
+		}
+		bindingBuilder := builder.ApplicationBinding(&applicationBinding, "orders", testRabbitmqClusterReference)
+		obj, _ := bindingBuilder.Build()
+		binding = obj.(*topology.Binding)
+		Expect(bindingBuilder.Update(binding)).To(Succeed())
+	})
+
+	It("generates a binding object with the correct name and labels", func() {
+		Expect(binding.Name).To(MatchRegexp("^foo-binding-[0-9a-f]{8}$"))
+		Expect(binding.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+	})
+
+	It("binds the destination to the source in the vhost of the application", func() {
+		Expect(binding.Spec.Vhost).To(Equal("orders"))
+		Expect(binding.Spec.Source).To(Equal("orders"))
+		Expect(binding.Spec.Destination).To(Equal("orders.created"))
+		Expect(binding.Spec.DestinationType).To(Equal("queue"))
+		Expect(binding.Spec.RoutingKey).To(Equal("created"))
+		Expect(binding.OwnerReferences[0].Name).To(Equal(application.Name))
+	})
+
+	It("generates a different object name when the binding changes", func() {
+		updated := applicationBinding
+		updated.RoutingKey = "updated"
+		obj, _ := builder.ApplicationBinding(&updated, "orders", testRabbitmqClusterReference).Build()
+		Expect(obj.GetName()).NotTo(Equal(binding.Name))
+	})
+})
@@ -0,0 +1,51 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ApplicationExchangeBuilder builds an exchange of a MessagingApplication
+type ApplicationExchangeBuilder struct {
+	*Builder
+	exchange        *topologyv1alpha1.ApplicationExchange
+	vhost           string
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationExchange(exchange *topologyv1alpha1.ApplicationExchange, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationExchangeBuilder {
+	return &ApplicationExchangeBuilder{builder, exchange, vhost, rabbitmqCluster}
+}
+
+func (builder *ApplicationExchangeBuilder) Build() (client.Object, error) {
+	return &topology.Exchange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName("-exchange-" + applicationResourceID(builder.exchange.Name)),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationExchangeBuilder) Update(object client.Object) error {
+	exchange := object.(*topology.Exchange)
+	exchange.Spec.Name = builder.exchange.Name
+	exchange.Spec.Vhost = builder.vhost
+	exchange.Spec.Type = builder.exchange.Type
+	exchange.Spec.Durable = builder.exchange.Durable
+	exchange.Spec.AutoDelete = builder.exchange.AutoDelete
+	exchange.Spec.Arguments = builder.exchange.Arguments
+	exchange.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationExchangeBuilder) ResourceType() string { return "Exchange" }
@@ -0,0 +1,75 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ApplicationExchange", func() {
+	var (
+		application topologyv1alpha1.MessagingApplication
+		builder     *managedresource.Builder
+		scheme      *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		application = topologyv1alpha1.MessagingApplication{}
+		application.Namespace = "foo"
+		application.Name = "foo"
+		application.Spec = topologyv1alpha1.MessagingApplicationSpec{
+			Vhost: "orders",
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &application,
+			Scheme:      scheme,
+		}
+	})
+
+	var (
+		exchangeBuilder *managedresource.ApplicationExchangeBuilder
+		exchange        *topology.Exchange
+	)
+
+	BeforeEach(func() {
+		exchangeBuilder = builder.ApplicationExchange(&topologyv1alpha1.ApplicationExchange{
+			Name:    "orders",
+			Type:    "topic",
+			Durable: true,
+		}, "orders", testRabbitmqClusterReference)
+		obj, _ := exchangeBuilder.Build()
+		exchange = obj.(*topology.Exchange)
+		Expect(exchangeBuilder.Update(exchange)).To(Succeed())
+	})
+
+	It("generates an exchange object with the correct name and labels", func() {
+		Expect(exchange.Name).To(Equal("foo-exchange-orders"))
+		Expect(exchange.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+	})
+
+	It("declares the exchange in the vhost of the application", func() {
+		Expect(exchange.Spec.Name).To(Equal("orders"))
+		Expect(exchange.Spec.Vhost).To(Equal("orders"))
+		Expect(exchange.Spec.Type).To(Equal("topic"))
+		Expect(exchange.Spec.Durable).To(BeTrue())
+		Expect(exchange.Spec.AutoDelete).To(BeFalse())
+		Expect(exchange.OwnerReferences[0].Name).To(Equal(application.Name))
+	})
+
+	When("the exchange name is not a valid resource name", func() {
+		It("generates a unique and valid object name", func() {
+			obj, _ := builder.ApplicationExchange(&topologyv1alpha1.ApplicationExchange{Name: "Orders.Events"}, "orders", testRabbitmqClusterReference).Build()
+			Expect(obj.GetName()).To(MatchRegexp("^foo-exchange-orders-events-[0-9a-f]{8}$"))
+
+			other, _ := builder.ApplicationExchange(&topologyv1alpha1.ApplicationExchange{Name: "orders_events"}, "orders", testRabbitmqClusterReference).Build()
+			Expect(other.GetName()).To(MatchRegexp("^foo-exchange-orders-events-[0-9a-f]{8}$"))
+			Expect(other.GetName()).NotTo(Equal(obj.GetName()))
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// ApplicationQueueBuilder builds a queue of a MessagingApplication
+type ApplicationQueueBuilder struct {
+	*Builder
+	queue           *topologyv1alpha1.ApplicationQueue
+	vhost           string
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationQueue(queue *topologyv1alpha1.ApplicationQueue, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationQueueBuilder {
+	return &ApplicationQueueBuilder{builder, queue, vhost, rabbitmqCluster}
+}
+
+func (builder *ApplicationQueueBuilder) Build() (client.Object, error) {
+	return &topology.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName("-queue-" + applicationResourceID(builder.queue.Name)),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationQueueBuilder) Update(object client.Object) error {
+	queue := object.(*topology.Queue)
+	queue.Spec.Name = builder.queue.Name
+	queue.Spec.Vhost = builder.vhost
+	queue.Spec.Type = builder.queue.Type
+	queue.Spec.Durable = builder.queue.Durable
+	queue.Spec.AutoDelete = builder.queue.AutoDelete
+	queue.Spec.Arguments = builder.queue.Arguments
+	queue.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationQueueBuilder) ResourceType() string { return "Queue" }
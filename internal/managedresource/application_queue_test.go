@@ -0,0 +1,62 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ApplicationQueue", func() {
+	var (
+		application topologyv1alpha1.MessagingApplication
+		builder     *managedresource.Builder
+		scheme      *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		application = topologyv1alpha1.MessagingApplication{}
+		application.Namespace = "foo"
+		application.Name = "foo"
+		application.Spec = topologyv1alpha1.MessagingApplicationSpec{
+			Vhost: "orders",
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &application,
+			Scheme:      scheme,
+		}
+	})
+
+	var queue *topology.Queue
+
+	BeforeEach(func() {
+		queueBuilder := builder.ApplicationQueue(&topologyv1alpha1.ApplicationQueue{
+			Name:      "orders.created",
+			Type:      "quorum",
+			Durable:   true,
+			Arguments: &runtime.RawExtension{Raw: []byte(`{"x-max-length":1000}`)},
+		}, "orders", testRabbitmqClusterReference)
+		obj, _ := queueBuilder.Build()
+		queue = obj.(*topology.Queue)
+		Expect(queueBuilder.Update(queue)).To(Succeed())
+	})
+
+	It("generates a queue object with the correct name and labels", func() {
+		Expect(queue.Name).To(MatchRegexp("^foo-queue-orders-created-[0-9a-f]{8}$"))
+		Expect(queue.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+	})
+
+	It("declares the queue in the vhost of the application", func() {
+		Expect(queue.Spec.Name).To(Equal("orders.created"))
+		Expect(queue.Spec.Vhost).To(Equal("orders"))
+		Expect(queue.Spec.Type).To(Equal("quorum"))
+		Expect(queue.Spec.Durable).To(BeTrue())
+		Expect(queue.Spec.Arguments.Raw).To(MatchJSON(`{"x-max-length":1000}`))
+		Expect(queue.OwnerReferences[0].Name).To(Equal(application.Name))
+	})
+})
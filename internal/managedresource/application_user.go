@@ -0,0 +1,92 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	applicationUserSuffix       = "-user"
+	applicationPermissionSuffix = "-permission"
+)
+
+// ApplicationUserBuilder builds the user of a MessagingApplication
+type ApplicationUserBuilder struct {
+	*Builder
+	user            *topologyv1alpha1.ApplicationUser
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationUser(user *topologyv1alpha1.ApplicationUser, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationUserBuilder {
+	return &ApplicationUserBuilder{builder, user, rabbitmqCluster}
+}
+
+func (builder *ApplicationUserBuilder) Build() (client.Object, error) {
+	return &topology.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(applicationUserSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationUserBuilder) Update(object client.Object) error {
+	user := object.(*topology.User)
+	user.Spec.Tags = builder.user.Tags
+	user.Spec.ImportCredentialsSecret = builder.user.ImportCredentialsSecret
+	user.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationUserBuilder) ResourceType() string { return "User" }
+
+// ApplicationPermissionBuilder builds the permissions of the user of a MessagingApplication in its vhost
+type ApplicationPermissionBuilder struct {
+	*Builder
+	user            *topologyv1alpha1.ApplicationUser
+	vhost           string
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationPermission(user *topologyv1alpha1.ApplicationUser, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationPermissionBuilder {
+	return &ApplicationPermissionBuilder{builder, user, vhost, rabbitmqCluster}
+}
+
+func (builder *ApplicationPermissionBuilder) Build() (client.Object, error) {
+	return &topology.Permission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(applicationPermissionSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationPermissionBuilder) Update(object client.Object) error {
+	permission := object.(*topology.Permission)
+	permission.Spec.UserReference = &corev1.LocalObjectReference{
+		Name: builder.GenerateChildResourceName(applicationUserSuffix),
+	}
+	permission.Spec.Vhost = builder.vhost
+	permission.Spec.Permissions = builder.user.Permissions
+	permission.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationPermissionBuilder) ResourceType() string { return "Permission" }
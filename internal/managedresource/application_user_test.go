@@ -0,0 +1,98 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ApplicationUser", func() {
+	var (
+		application topologyv1alpha1.MessagingApplication
+		builder     *managedresource.Builder
+		scheme      *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		application = topologyv1alpha1.MessagingApplication{}
+		application.Namespace = "foo"
+		application.Name = "foo"
+		application.Spec = topologyv1alpha1.MessagingApplicationSpec{
+			Vhost: "orders",
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &application,
+			Scheme:      scheme,
+		}
+	})
+
+	var applicationUser *topologyv1alpha1.ApplicationUser
+
+	BeforeEach(func() {
+		applicationUser = &topologyv1alpha1.ApplicationUser{
+			Tags:                    []topology.UserTag{"monitoring"},
+			ImportCredentialsSecret: &corev1.LocalObjectReference{Name: "orders-credentials"},
+			Permissions: topology.VhostPermissions{
+				Configure: "^orders",
+				Write:     ".*",
+				Read:      ".*",
+			},
+		}
+	})
+
+	Context("user", func() {
+		var user *topology.User
+
+		BeforeEach(func() {
+			userBuilder := builder.ApplicationUser(applicationUser, testRabbitmqClusterReference)
+			obj, _ := userBuilder.Build()
+			user = obj.(*topology.User)
+			Expect(userBuilder.Update(user)).To(Succeed())
+		})
+
+		It("generates a user object with the correct name and labels", func() {
+			Expect(user.Name).To(Equal("foo-user"))
+			Expect(user.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+		})
+
+		It("sets the tags and the imported credentials", func() {
+			Expect(user.Spec.Tags).To(ConsistOf(topology.UserTag("monitoring")))
+			Expect(user.Spec.ImportCredentialsSecret.Name).To(Equal("orders-credentials"))
+			Expect(user.OwnerReferences[0].Name).To(Equal(application.Name))
+		})
+	})
+
+	Context("permission", func() {
+		var permission *topology.Permission
+
+		BeforeEach(func() {
+			permissionBuilder := builder.ApplicationPermission(applicationUser, "orders", testRabbitmqClusterReference)
+			obj, _ := permissionBuilder.Build()
+			permission = obj.(*topology.Permission)
+			Expect(permissionBuilder.Update(permission)).To(Succeed())
+		})
+
+		It("generates a permission object with the correct name and labels", func() {
+			Expect(permission.Name).To(Equal("foo-permission"))
+			Expect(permission.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+		})
+
+		It("grants the permissions to the user object in the vhost of the application", func() {
+			Expect(permission.Spec.UserReference.Name).To(Equal("foo-user"))
+			Expect(permission.Spec.Vhost).To(Equal("orders"))
+			Expect(permission.Spec.Permissions).To(Equal(topology.VhostPermissions{
+				Configure: "^orders",
+				Write:     ".*",
+				Read:      ".*",
+			}))
+			Expect(permission.OwnerReferences[0].Name).To(Equal(application.Name))
+		})
+	})
+})
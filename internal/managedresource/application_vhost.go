@@ -0,0 +1,84 @@
+package managedresource
+
+import (
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"strings"
+
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	applicationVhostSuffix = "-vhost"
+	applicationIDMaxLength = 40
+)
+
+var invalidApplicationIDCharacters = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// applicationResourceID returns an identifier for an exchange or queue name which can be used in the name of a child resource
+// names which are not valid in resource names are sanitized, and suffixed with a hash of the name to keep identifiers unique
+func applicationResourceID(name string) string {
+	id := strings.Trim(invalidApplicationIDCharacters.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(id) > applicationIDMaxLength {
+		id = strings.TrimRight(id[:applicationIDMaxLength], "-")
+	}
+	if id == name {
+		return id
+	}
+	if id == "" {
+		return applicationHash(name)
+	}
+	return id + "-" + applicationHash(name)
+}
+
+func applicationHash(s string) string {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(s))
+	return fmt.Sprintf("%08x", hash.Sum32())
+}
+
+func applicationLabels(owner metav1.Object) map[string]string {
+	return map[string]string{
+		AnnotationMessagingApplication: owner.GetName(),
+	}
+}
+
+// ApplicationVhostBuilder builds the vhost of a MessagingApplication
+type ApplicationVhostBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.MessagingApplicationSpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ApplicationVhost(spec *topologyv1alpha1.MessagingApplicationSpec, rabbitmqCluster *topology.RabbitmqClusterReference) *ApplicationVhostBuilder {
+	return &ApplicationVhostBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *ApplicationVhostBuilder) Build() (client.Object, error) {
+	return &topology.Vhost{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(applicationVhostSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels:    applicationLabels(builder.ObjectOwner),
+		},
+	}, nil
+}
+
+func (builder *ApplicationVhostBuilder) Update(object client.Object) error {
+	vhost := object.(*topology.Vhost)
+	vhost.Spec.Name = builder.spec.Vhost
+	vhost.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ApplicationVhostBuilder) ResourceType() string { return "Vhost" }
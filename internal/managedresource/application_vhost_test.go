@@ -0,0 +1,55 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ApplicationVhost", func() {
+	var (
+		application topologyv1alpha1.MessagingApplication
+		builder     *managedresource.Builder
+		scheme      *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		application = topologyv1alpha1.MessagingApplication{}
+		application.Namespace = "foo"
+		application.Name = "foo"
+		application.Spec = topologyv1alpha1.MessagingApplicationSpec{
+			Vhost: "orders",
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &application,
+			Scheme:      scheme,
+		}
+	})
+
+	var vhost *topology.Vhost
+
+	BeforeEach(func() {
+		vhostBuilder := builder.ApplicationVhost(&application.Spec, testRabbitmqClusterReference)
+		obj, _ := vhostBuilder.Build()
+		vhost = obj.(*topology.Vhost)
+		Expect(vhostBuilder.Update(vhost)).To(Succeed())
+	})
+
+	It("generates a vhost object with the correct name and labels", func() {
+		Expect(vhost.Name).To(Equal("foo-vhost"))
+		Expect(vhost.Namespace).To(Equal("foo"))
+		Expect(vhost.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/messaging-application", "foo"))
+	})
+
+	It("sets the vhost name and the owner reference", func() {
+		Expect(vhost.Spec.Name).To(Equal("orders"))
+		Expect(vhost.Spec.RabbitmqClusterReference.Name).To(Equal(testRabbitmqClusterReference.Name))
+		Expect(vhost.OwnerReferences[0].Name).To(Equal(application.Name))
+	})
+})
@@ -0,0 +1,89 @@
+package managedresource
+
+import (
+	"fmt"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	clusterEndpointUserSuffix       = "-user"
+	clusterEndpointPermissionSuffix = "-permission"
+)
+
+// ClusterEndpointUserBuilder builds the user which a Federation or a Shovel connects to a ClusterEndpoint with
+// endpoint distinguishes the users of an owner with several endpoints, e.g. '-upstream'
+type ClusterEndpointUserBuilder struct {
+	*Builder
+	endpoint        string
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ClusterEndpointUser(endpoint string, rabbitmqCluster *topology.RabbitmqClusterReference) *ClusterEndpointUserBuilder {
+	return &ClusterEndpointUserBuilder{builder, endpoint, rabbitmqCluster}
+}
+
+func (builder *ClusterEndpointUserBuilder) Build() (client.Object, error) {
+	return &topology.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(builder.endpoint + clusterEndpointUserSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+		},
+	}, nil
+}
+
+func (builder *ClusterEndpointUserBuilder) Update(object client.Object) error {
+	user := object.(*topology.User)
+	user.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ClusterEndpointUserBuilder) ResourceType() string { return "User" }
+
+// ClusterEndpointPermissionBuilder builds the permissions of the user of a ClusterEndpoint in the vhost of the endpoint
+type ClusterEndpointPermissionBuilder struct {
+	*Builder
+	endpoint        string
+	vhost           string
+	permissions     topology.VhostPermissions
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) ClusterEndpointPermission(endpoint, vhost string, permissions topology.VhostPermissions, rabbitmqCluster *topology.RabbitmqClusterReference) *ClusterEndpointPermissionBuilder {
+	return &ClusterEndpointPermissionBuilder{builder, endpoint, vhost, permissions, rabbitmqCluster}
+}
+
+func (builder *ClusterEndpointPermissionBuilder) Build() (client.Object, error) {
+	return &topology.Permission{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(builder.endpoint + clusterEndpointPermissionSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+		},
+	}, nil
+}
+
+func (builder *ClusterEndpointPermissionBuilder) Update(object client.Object) error {
+	permission := object.(*topology.Permission)
+	permission.Spec.UserReference = &corev1.LocalObjectReference{
+		Name: builder.GenerateChildResourceName(builder.endpoint + clusterEndpointUserSuffix),
+	}
+	permission.Spec.Vhost = builder.vhost
+	permission.Spec.Permissions = builder.permissions
+	permission.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *ClusterEndpointPermissionBuilder) ResourceType() string { return "Permission" }
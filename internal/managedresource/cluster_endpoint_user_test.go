@@ -0,0 +1,79 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("ClusterEndpointUser", func() {
+	var (
+		federation topology.Federation
+		builder    *managedresource.Builder
+		scheme     *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		federation = topology.Federation{}
+		federation.Namespace = "foo"
+		federation.Name = "foo"
+		builder = &managedresource.Builder{
+			ObjectOwner: &federation,
+			Scheme:      scheme,
+		}
+	})
+
+	Context("user", func() {
+		var user *topology.User
+
+		BeforeEach(func() {
+			userBuilder := builder.ClusterEndpointUser("-upstream", testRabbitmqClusterReference)
+			obj, _ := userBuilder.Build()
+			user = obj.(*topology.User)
+			Expect(userBuilder.Update(user)).To(Succeed())
+		})
+
+		It("generates a user object with the correct name and namespace", func() {
+			Expect(user.Name).To(Equal("foo-upstream-user"))
+			Expect(user.Namespace).To(Equal("foo"))
+		})
+
+		It("creates the user in the cluster of the endpoint", func() {
+			Expect(user.Spec.RabbitmqClusterReference).To(Equal(*testRabbitmqClusterReference))
+			Expect(user.Spec.Tags).To(BeEmpty())
+			Expect(user.OwnerReferences[0].Name).To(Equal(federation.Name))
+		})
+	})
+
+	Context("permission", func() {
+		var permission *topology.Permission
+
+		BeforeEach(func() {
+			permissionBuilder := builder.ClusterEndpointPermission("-upstream", "upstream-vhost", topology.VhostPermissions{
+				Configure: "^federation: ",
+				Write:     "^federation: ",
+				Read:      ".*",
+			}, testRabbitmqClusterReference)
+			obj, _ := permissionBuilder.Build()
+			permission = obj.(*topology.Permission)
+			Expect(permissionBuilder.Update(permission)).To(Succeed())
+		})
+
+		It("generates a permission object with the correct name", func() {
+			Expect(permission.Name).To(Equal("foo-upstream-permission"))
+		})
+
+		It("grants the permissions to the user of the endpoint in the vhost of the endpoint", func() {
+			Expect(permission.Spec.UserReference.Name).To(Equal("foo-upstream-user"))
+			Expect(permission.Spec.Vhost).To(Equal("upstream-vhost"))
+			Expect(permission.Spec.Permissions.Configure).To(Equal("^federation: "))
+			Expect(permission.Spec.Permissions.Read).To(Equal(".*"))
+			Expect(permission.Spec.RabbitmqClusterReference).To(Equal(*testRabbitmqClusterReference))
+			Expect(permission.OwnerReferences[0].Name).To(Equal(federation.Name))
+		})
+	})
+})
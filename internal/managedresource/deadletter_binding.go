@@ -0,0 +1,53 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	deadLetterBindingSuffix = "-dead-letter-binding"
+)
+
+type DeadLetterBindingBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterBinding(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterBindingBuilder {
+	return &DeadLetterBindingBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterBindingBuilder) Build() (client.Object, error) {
+	return &topology.Binding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterBindingSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterBindingBuilder) Update(object client.Object) error {
+	binding := object.(*topology.Binding)
+	binding.Spec.Source = builder.spec.DeadLetterExchangeName()
+	binding.Spec.DestinationType = "queue"
+	binding.Spec.Destination = builder.spec.DeadLetterQueueName()
+	binding.Spec.Vhost = builder.spec.Vhost
+	binding.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *DeadLetterBindingBuilder) ResourceType() string { return "DeadLetterBinding" }
@@ -0,0 +1,80 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("DeadLetterBinding", func() {
+	var (
+		deadLetterTopology topologyv1alpha1.DeadLetterTopology
+		builder            *managedresource.Builder
+		bindingBuilder     *managedresource.DeadLetterBindingBuilder
+		binding            *topology.Binding
+		scheme             *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		deadLetterTopology = topologyv1alpha1.DeadLetterTopology{}
+		deadLetterTopology.Namespace = "foo"
+		deadLetterTopology.Name = "foo"
+		deadLetterTopology.Spec = topologyv1alpha1.DeadLetterTopologySpec{
+			Name:               "orders",
+			Vhost:              "vvv",
+			Type:               "quorum",
+			DeliveryLimit:      pointer.Int64(5),
+			DeadLetterQueueTTL: pointer.Int64(60000),
+			Mode:               topologyv1alpha1.DeadLetterModeArguments,
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &deadLetterTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	JustBeforeEach(func() {
+		bindingBuilder = builder.DeadLetterBinding(&deadLetterTopology.Spec, testRabbitmqClusterReference)
+		obj, _ := bindingBuilder.Build()
+		binding = obj.(*topology.Binding)
+	})
+
+	Context("Build", func() {
+		It("generates a binding object with the correct name", func() {
+			Expect(binding.Name).To(Equal("foo-dead-letter-binding"))
+		})
+
+		It("sets labels on the object to tie back to the original dead letter topology", func() {
+			Expect(binding.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/dead-letter-topology", "foo"))
+		})
+	})
+
+	Context("Update", func() {
+		JustBeforeEach(func() {
+			Expect(bindingBuilder.Update(binding)).To(Succeed())
+		})
+
+		It("sets owner reference", func() {
+			Expect(binding.OwnerReferences[0].Name).To(Equal(deadLetterTopology.Name))
+		})
+
+		It("binds the dead letter queue to the dead letter exchange", func() {
+			Expect(binding.Spec.Source).To(Equal("orders.dlx"))
+			Expect(binding.Spec.Destination).To(Equal("orders.dlq"))
+			Expect(binding.Spec.DestinationType).To(Equal("queue"))
+			Expect(binding.Spec.Vhost).To(Equal("vvv"))
+		})
+
+		It("sets the expected RabbitmqClusterReference", func() {
+			Expect(binding.Spec.RabbitmqClusterReference.Name).To(Equal(testRabbitmqClusterReference.Name))
+			Expect(binding.Spec.RabbitmqClusterReference.Namespace).To(Equal(testRabbitmqClusterReference.Namespace))
+		})
+	})
+})
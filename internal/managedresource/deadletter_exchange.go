@@ -0,0 +1,54 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	deadLetterExchangeSuffix = "-dead-letter-exchange"
+)
+
+type DeadLetterExchangeBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterExchange(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterExchangeBuilder {
+	return &DeadLetterExchangeBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterExchangeBuilder) Build() (client.Object, error) {
+	return &topology.Exchange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterExchangeSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterExchangeBuilder) Update(object client.Object) error {
+	exchange := object.(*topology.Exchange)
+	exchange.Spec.Name = builder.spec.DeadLetterExchangeName()
+	exchange.Spec.Vhost = builder.spec.Vhost
+	// dead lettered messages keep their routing key, so all of them are routed to the dead letter queue
+	exchange.Spec.Type = "fanout"
+	exchange.Spec.Durable = true
+	exchange.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *DeadLetterExchangeBuilder) ResourceType() string { return "DeadLetterExchange" }
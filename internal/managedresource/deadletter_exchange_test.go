@@ -0,0 +1,85 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("DeadLetterExchange", func() {
+	var (
+		deadLetterTopology topologyv1alpha1.DeadLetterTopology
+		builder            *managedresource.Builder
+		exchangeBuilder    *managedresource.DeadLetterExchangeBuilder
+		exchange           *topology.Exchange
+		scheme             *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		deadLetterTopology = topologyv1alpha1.DeadLetterTopology{}
+		deadLetterTopology.Namespace = "foo"
+		deadLetterTopology.Name = "foo"
+		deadLetterTopology.Spec = topologyv1alpha1.DeadLetterTopologySpec{
+			Name:               "orders",
+			Vhost:              "vvv",
+			Type:               "quorum",
+			DeliveryLimit:      pointer.Int64(5),
+			DeadLetterQueueTTL: pointer.Int64(60000),
+			Mode:               topologyv1alpha1.DeadLetterModeArguments,
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &deadLetterTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	JustBeforeEach(func() {
+		exchangeBuilder = builder.DeadLetterExchange(&deadLetterTopology.Spec, testRabbitmqClusterReference)
+		obj, _ := exchangeBuilder.Build()
+		exchange = obj.(*topology.Exchange)
+	})
+
+	Context("Build", func() {
+		It("generates an exchange object with the correct name", func() {
+			Expect(exchange.Name).To(Equal("foo-dead-letter-exchange"))
+		})
+
+		It("sets labels on the object to tie back to the original dead letter topology", func() {
+			Expect(exchange.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/dead-letter-topology", "foo"))
+		})
+	})
+
+	Context("Update", func() {
+		JustBeforeEach(func() {
+			Expect(exchangeBuilder.Update(exchange)).To(Succeed())
+		})
+
+		It("sets owner reference", func() {
+			Expect(exchange.OwnerReferences[0].Name).To(Equal(deadLetterTopology.Name))
+		})
+
+		It("declares a durable fanout exchange", func() {
+			Expect(exchange.Spec.Name).To(Equal("orders.dlx"))
+			Expect(exchange.Spec.Vhost).To(Equal("vvv"))
+			Expect(exchange.Spec.Type).To(Equal("fanout"))
+			Expect(exchange.Spec.Durable).To(BeTrue())
+		})
+
+		When("the dead letter exchange name is set", func() {
+			BeforeEach(func() {
+				deadLetterTopology.Spec.DeadLetterExchange = "orders-dead"
+			})
+
+			It("sets the name of the exchange", func() {
+				Expect(exchange.Spec.Name).To(Equal("orders-dead"))
+			})
+		})
+	})
+})
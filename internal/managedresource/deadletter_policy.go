@@ -0,0 +1,112 @@
+package managedresource
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	deadLetterPolicySuffix      = "-policy"
+	deadLetterQueuePolicySuffix = "-dead-letter-queue-policy"
+)
+
+// DeadLetterPolicyBuilder builds the policy which configures dead lettering of the work queue in mode 'policy'
+type DeadLetterPolicyBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterPolicy(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterPolicyBuilder {
+	return &DeadLetterPolicyBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterPolicyBuilder) Build() (client.Object, error) {
+	return &topology.Policy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterPolicySuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterPolicyBuilder) Update(object client.Object) error {
+	definition := map[string]interface{}{
+		"dead-letter-exchange": builder.spec.DeadLetterExchangeName(),
+	}
+	if builder.spec.DeliveryLimit != nil {
+		definition["delivery-limit"] = *builder.spec.DeliveryLimit
+	}
+	return updateQueuePolicy(builder.Builder, object.(*topology.Policy), builder.spec.Name+".dead-letter",
+		builder.spec.Name, builder.spec.Vhost, definition, builder.rabbitmqCluster)
+}
+
+func (builder *DeadLetterPolicyBuilder) ResourceType() string { return "Policy" }
+
+// DeadLetterQueuePolicyBuilder builds the policy which configures the message TTL of the dead letter queue in mode 'policy'
+type DeadLetterQueuePolicyBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterQueuePolicy(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterQueuePolicyBuilder {
+	return &DeadLetterQueuePolicyBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterQueuePolicyBuilder) Build() (client.Object, error) {
+	return &topology.Policy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterQueuePolicySuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterQueuePolicyBuilder) Update(object client.Object) error {
+	if builder.spec.DeadLetterQueueTTL == nil {
+		return fmt.Errorf("dead letter queue policy requires deadLetterQueueTTL to be set")
+	}
+	definition := map[string]interface{}{
+		"message-ttl": *builder.spec.DeadLetterQueueTTL,
+	}
+	return updateQueuePolicy(builder.Builder, object.(*topology.Policy), builder.spec.DeadLetterQueueName()+".message-ttl",
+		builder.spec.DeadLetterQueueName(), builder.spec.Vhost, definition, builder.rabbitmqCluster)
+}
+
+func (builder *DeadLetterQueuePolicyBuilder) ResourceType() string { return "DeadLetterQueuePolicy" }
+
+// updateQueuePolicy sets policy to apply definition to the queue named 'queue' only
+func updateQueuePolicy(builder *Builder, policy *topology.Policy, name, queue, vhost string, definition map[string]interface{}, rabbitmqCluster *topology.RabbitmqClusterReference) error {
+	definitionBytes, err := json.Marshal(definition)
+	if err != nil {
+		return fmt.Errorf("failed to marshal policy definition: %w", err)
+	}
+
+	policy.Spec.Name = name
+	policy.Spec.Vhost = vhost
+	policy.Spec.Pattern = "^" + regexp.QuoteMeta(queue) + "$"
+	policy.Spec.ApplyTo = "queues"
+	policy.Spec.Definition = &runtime.RawExtension{Raw: definitionBytes}
+	policy.Spec.RabbitmqClusterReference = *rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, policy, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("DeadLetterPolicy", func() {
+	var (
+		deadLetterTopology topologyv1alpha1.DeadLetterTopology
+		builder            *managedresource.Builder
+		scheme             *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		deadLetterTopology = topologyv1alpha1.DeadLetterTopology{}
+		deadLetterTopology.Namespace = "foo"
+		deadLetterTopology.Name = "foo"
+		deadLetterTopology.Spec = topologyv1alpha1.DeadLetterTopologySpec{
+			Name:               "orders.v1",
+			Vhost:              "vvv",
+			Type:               "quorum",
+			DeliveryLimit:      pointer.Int64(5),
+			DeadLetterQueueTTL: pointer.Int64(60000),
+			Mode:               topologyv1alpha1.DeadLetterModePolicy,
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &deadLetterTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	Context("work queue policy", func() {
+		var (
+			policyBuilder *managedresource.DeadLetterPolicyBuilder
+			policy        *topology.Policy
+		)
+
+		JustBeforeEach(func() {
+			policyBuilder = builder.DeadLetterPolicy(&deadLetterTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := policyBuilder.Build()
+			policy = obj.(*topology.Policy)
+		})
+
+		It("generates a policy object with the correct name and labels", func() {
+			Expect(policy.Name).To(Equal("foo-policy"))
+			Expect(policy.Namespace).To(Equal(deadLetterTopology.Namespace))
+			Expect(policy.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/dead-letter-topology", "foo"))
+		})
+
+		Context("Update", func() {
+			JustBeforeEach(func() {
+				Expect(policyBuilder.Update(policy)).To(Succeed())
+			})
+
+			It("sets owner reference", func() {
+				Expect(policy.OwnerReferences[0].Name).To(Equal(deadLetterTopology.Name))
+			})
+
+			It("applies to the work queue only", func() {
+				Expect(policy.Spec.Name).To(Equal("orders.v1.dead-letter"))
+				Expect(policy.Spec.Vhost).To(Equal("vvv"))
+				Expect(policy.Spec.Pattern).To(Equal(`^orders\.v1$`))
+				Expect(policy.Spec.ApplyTo).To(Equal("queues"))
+			})
+
+			It("sets the dead letter exchange and delivery limit in the definition", func() {
+				Expect(policy.Spec.Definition.Raw).To(MatchJSON(`{"dead-letter-exchange":"orders.v1.dlx","delivery-limit":5}`))
+			})
+
+			When("delivery limit is not set", func() {
+				BeforeEach(func() {
+					deadLetterTopology.Spec.DeliveryLimit = nil
+				})
+
+				It("only sets the dead letter exchange in the definition", func() {
+					Expect(policy.Spec.Definition.Raw).To(MatchJSON(`{"dead-letter-exchange":"orders.v1.dlx"}`))
+				})
+			})
+		})
+	})
+
+	Context("dead letter queue policy", func() {
+		var (
+			policyBuilder *managedresource.DeadLetterQueuePolicyBuilder
+			policy        *topology.Policy
+		)
+
+		JustBeforeEach(func() {
+			policyBuilder = builder.DeadLetterQueuePolicy(&deadLetterTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := policyBuilder.Build()
+			policy = obj.(*topology.Policy)
+		})
+
+		It("generates a policy object with the correct name", func() {
+			Expect(policy.Name).To(Equal("foo-dead-letter-queue-policy"))
+		})
+
+		It("applies the message TTL to the dead letter queue only", func() {
+			Expect(policyBuilder.Update(policy)).To(Succeed())
+			Expect(policy.Spec.Name).To(Equal("orders.v1.dlq.message-ttl"))
+			Expect(policy.Spec.Pattern).To(Equal(`^orders\.v1\.dlq$`))
+			Expect(policy.Spec.ApplyTo).To(Equal("queues"))
+			Expect(policy.Spec.Definition.Raw).To(MatchJSON(`{"message-ttl":60000}`))
+			Expect(policy.OwnerReferences[0].Name).To(Equal(deadLetterTopology.Name))
+		})
+
+		When("dead letter queue TTL is not set", func() {
+			BeforeEach(func() {
+				deadLetterTopology.Spec.DeadLetterQueueTTL = nil
+			})
+
+			It("returns an error", func() {
+				Expect(policyBuilder.Update(policy)).To(MatchError(ContainSubstring("deadLetterQueueTTL")))
+			})
+		})
+	})
+})
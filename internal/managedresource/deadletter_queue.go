@@ -0,0 +1,60 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	deadLetterQueueSuffix = "-dead-letter-queue"
+)
+
+type DeadLetterQueueBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterQueue(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterQueueBuilder {
+	return &DeadLetterQueueBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterQueueBuilder) Build() (client.Object, error) {
+	return &topology.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterQueueSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterQueueBuilder) Update(object client.Object) error {
+	queue := object.(*topology.Queue)
+	queue.Spec.Name = builder.spec.DeadLetterQueueName()
+	queue.Spec.Vhost = builder.spec.Vhost
+	queue.Spec.Type = builder.spec.Type
+	queue.Spec.Durable = true
+	queue.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	// in mode 'policy', the message TTL is configured by the DeadLetterQueuePolicy instead
+	if builder.spec.Mode == topologyv1alpha1.DeadLetterModePolicy {
+		queue.Spec.MessageTTL = nil
+	} else {
+		queue.Spec.MessageTTL = builder.spec.DeadLetterQueueTTL
+	}
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *DeadLetterQueueBuilder) ResourceType() string { return "DeadLetterQueue" }
@@ -0,0 +1,103 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("DeadLetterQueue", func() {
+	var (
+		deadLetterTopology topologyv1alpha1.DeadLetterTopology
+		builder            *managedresource.Builder
+		queueBuilder       *managedresource.DeadLetterQueueBuilder
+		queue              *topology.Queue
+		scheme             *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		deadLetterTopology = topologyv1alpha1.DeadLetterTopology{}
+		deadLetterTopology.Namespace = "foo"
+		deadLetterTopology.Name = "foo"
+		deadLetterTopology.Spec = topologyv1alpha1.DeadLetterTopologySpec{
+			Name:               "orders",
+			Vhost:              "vvv",
+			Type:               "quorum",
+			DeliveryLimit:      pointer.Int64(5),
+			DeadLetterQueueTTL: pointer.Int64(60000),
+			Mode:               topologyv1alpha1.DeadLetterModeArguments,
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &deadLetterTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	JustBeforeEach(func() {
+		queueBuilder = builder.DeadLetterQueue(&deadLetterTopology.Spec, testRabbitmqClusterReference)
+		obj, _ := queueBuilder.Build()
+		queue = obj.(*topology.Queue)
+	})
+
+	Context("Build", func() {
+		It("generates a queue object with the correct name", func() {
+			Expect(queue.Name).To(Equal("foo-dead-letter-queue"))
+		})
+
+		It("generates a queue object with the correct namespace", func() {
+			Expect(queue.Namespace).To(Equal(deadLetterTopology.Namespace))
+		})
+
+		It("sets labels on the object to tie back to the original dead letter topology", func() {
+			Expect(queue.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/dead-letter-topology", "foo"))
+		})
+	})
+
+	Context("Update", func() {
+		JustBeforeEach(func() {
+			Expect(queueBuilder.Update(queue)).To(Succeed())
+		})
+
+		It("sets owner reference", func() {
+			Expect(queue.OwnerReferences[0].Name).To(Equal(deadLetterTopology.Name))
+		})
+
+		It("sets the default name, vhost, type and durability of the queue", func() {
+			Expect(queue.Spec.Name).To(Equal("orders.dlq"))
+			Expect(queue.Spec.Vhost).To(Equal("vvv"))
+			Expect(queue.Spec.Type).To(Equal("quorum"))
+			Expect(queue.Spec.Durable).To(BeTrue())
+		})
+
+		It("sets the message TTL as a queue argument", func() {
+			Expect(*queue.Spec.MessageTTL).To(BeNumerically("==", 60000))
+		})
+
+		When("the dead letter queue name is set", func() {
+			BeforeEach(func() {
+				deadLetterTopology.Spec.DeadLetterQueue = "orders-failed"
+			})
+
+			It("sets the name of the queue", func() {
+				Expect(queue.Spec.Name).To(Equal("orders-failed"))
+			})
+		})
+
+		When("mode is 'policy'", func() {
+			BeforeEach(func() {
+				deadLetterTopology.Spec.Mode = topologyv1alpha1.DeadLetterModePolicy
+			})
+
+			It("does not set the message TTL as a queue argument", func() {
+				Expect(queue.Spec.MessageTTL).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	deadLetterWorkQueueSuffix = "-queue"
+)
+
+type DeadLetterWorkQueueBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.DeadLetterTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) DeadLetterWorkQueue(spec *topologyv1alpha1.DeadLetterTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *DeadLetterWorkQueueBuilder {
+	return &DeadLetterWorkQueueBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *DeadLetterWorkQueueBuilder) Build() (client.Object, error) {
+	return &topology.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(deadLetterWorkQueueSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationDeadLetterTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *DeadLetterWorkQueueBuilder) Update(object client.Object) error {
+	queue := object.(*topology.Queue)
+	queue.Spec.Name = builder.spec.Name
+	queue.Spec.Vhost = builder.spec.Vhost
+	queue.Spec.Type = builder.spec.Type
+	queue.Spec.Durable = true
+	queue.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	// in mode 'policy', dead lettering is configured by the DeadLetterPolicy instead
+	if builder.spec.Mode == topologyv1alpha1.DeadLetterModePolicy {
+		queue.Spec.DeadLetterExchange = ""
+		queue.Spec.DeliveryLimit = nil
+	} else {
+		queue.Spec.DeadLetterExchange = builder.spec.DeadLetterExchangeName()
+		queue.Spec.DeliveryLimit = builder.spec.DeliveryLimit
+	}
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *DeadLetterWorkQueueBuilder) ResourceType() string { return "WorkQueue" }
@@ -7,10 +7,17 @@ import (
 )
 
 const (
-	AnnotationSuperStream           = "rabbitmq.com/super-stream"
-	AnnotationSuperStreamPartition  = "rabbitmq.com/super-stream-partition"
-	AnnotationSuperStreamRoutingKey = "rabbitmq.com/super-stream-routing-key"
-	AnnotationConsumerPodSpecHash   = "rabbitmq.com/consumer-pod-spec-hash"
+	AnnotationSuperStream             = "rabbitmq.com/super-stream"
+	AnnotationSuperStreamPartition    = "rabbitmq.com/super-stream-partition"
+	AnnotationSuperStreamRoutingKey   = "rabbitmq.com/super-stream-routing-key"
+	AnnotationSuperStreamUnboundSince = "rabbitmq.com/super-stream-unbound-since"
+	AnnotationConsumerPodSpecHash     = "rabbitmq.com/consumer-pod-spec-hash"
+	AnnotationSuperStreamConsumer     = "rabbitmq.com/super-stream-consumer"
+	AnnotationDeadLetterTopology      = "rabbitmq.com/dead-letter-topology"
+	AnnotationRetryTopology           = "rabbitmq.com/retry-topology"
+	AnnotationRetryDelay              = "rabbitmq.com/retry-delay"
+	AnnotationRetryDrainingSince      = "rabbitmq.com/retry-draining-since"
+	AnnotationMessagingApplication    = "rabbitmq.com/messaging-application"
 )
 
 type Builder struct {
@@ -0,0 +1,97 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	retryWorkBindingSuffix = "-binding"
+)
+
+// RetryWorkBindingBuilder builds the binding of the work queue to the work exchange
+type RetryWorkBindingBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.RetryTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryWorkBinding(spec *topologyv1alpha1.RetryTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryWorkBindingBuilder {
+	return &RetryWorkBindingBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *RetryWorkBindingBuilder) Build() (client.Object, error) {
+	return &topology.Binding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryWorkBindingSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryWorkBindingBuilder) Update(object client.Object) error {
+	binding := object.(*topology.Binding)
+	binding.Spec.Source = builder.spec.Name
+	binding.Spec.DestinationType = "queue"
+	binding.Spec.Destination = builder.spec.Name
+	binding.Spec.Vhost = builder.spec.Vhost
+	binding.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryWorkBindingBuilder) ResourceType() string { return "WorkBinding" }
+
+// RetryBindingBuilder builds the binding of the retry queue of a delay to the retry exchange
+type RetryBindingBuilder struct {
+	*Builder
+	spec              *topologyv1alpha1.RetryTopologySpec
+	delayMilliseconds int64
+	rabbitmqCluster   *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryBinding(spec *topologyv1alpha1.RetryTopologySpec, delayMilliseconds int64, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryBindingBuilder {
+	return &RetryBindingBuilder{builder, spec, delayMilliseconds, rabbitmqCluster}
+}
+
+func (builder *RetryBindingBuilder) Build() (client.Object, error) {
+	return &topology.Binding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryQueueSuffix(builder.delayMilliseconds) + "-binding"),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+				AnnotationRetryDelay:    topologyv1alpha1.RetryRoutingKey(builder.delayMilliseconds),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryBindingBuilder) Update(object client.Object) error {
+	binding := object.(*topology.Binding)
+	binding.Spec.Source = builder.spec.RetryExchangeName()
+	binding.Spec.DestinationType = "queue"
+	binding.Spec.Destination = builder.spec.RetryQueueName(builder.delayMilliseconds)
+	binding.Spec.RoutingKey = topologyv1alpha1.RetryRoutingKey(builder.delayMilliseconds)
+	binding.Spec.Vhost = builder.spec.Vhost
+	binding.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryBindingBuilder) ResourceType() string { return "RetryBinding" }
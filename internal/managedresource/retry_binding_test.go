@@ -0,0 +1,88 @@
+package managedresource_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("RetryBinding", func() {
+	var (
+		retryTopology topologyv1alpha1.RetryTopology
+		builder       *managedresource.Builder
+		scheme        *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		retryTopology = topologyv1alpha1.RetryTopology{}
+		retryTopology.Namespace = "foo"
+		retryTopology.Name = "foo"
+		retryTopology.Spec = topologyv1alpha1.RetryTopologySpec{
+			Name:   "orders",
+			Vhost:  "vvv",
+			Type:   "quorum",
+			Delays: []metav1.Duration{{Duration: 5 * time.Second}},
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &retryTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	Context("work binding", func() {
+		var binding *topology.Binding
+
+		BeforeEach(func() {
+			bindingBuilder := builder.RetryWorkBinding(&retryTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := bindingBuilder.Build()
+			binding = obj.(*topology.Binding)
+			Expect(bindingBuilder.Update(binding)).To(Succeed())
+		})
+
+		It("generates a binding object with the correct name", func() {
+			Expect(binding.Name).To(Equal("foo-binding"))
+			Expect(binding.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-topology", "foo"))
+		})
+
+		It("binds the work queue to the work exchange", func() {
+			Expect(binding.Spec.Source).To(Equal("orders"))
+			Expect(binding.Spec.Destination).To(Equal("orders"))
+			Expect(binding.Spec.DestinationType).To(Equal("queue"))
+			Expect(binding.Spec.Vhost).To(Equal("vvv"))
+			Expect(binding.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+		})
+	})
+
+	Context("retry binding", func() {
+		var binding *topology.Binding
+
+		BeforeEach(func() {
+			bindingBuilder := builder.RetryBinding(&retryTopology.Spec, 5000, testRabbitmqClusterReference)
+			obj, _ := bindingBuilder.Build()
+			binding = obj.(*topology.Binding)
+			Expect(bindingBuilder.Update(binding)).To(Succeed())
+		})
+
+		It("generates a binding object with the correct name and labels", func() {
+			Expect(binding.Name).To(Equal("foo-retry-5000-binding"))
+			Expect(binding.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-delay", "5000"))
+		})
+
+		It("binds the retry queue to the retry exchange with the delay as routing key", func() {
+			Expect(binding.Spec.Source).To(Equal("orders.retry"))
+			Expect(binding.Spec.Destination).To(Equal("orders.retry.5000"))
+			Expect(binding.Spec.DestinationType).To(Equal("queue"))
+			Expect(binding.Spec.RoutingKey).To(Equal("5000"))
+			Expect(binding.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+		})
+	})
+})
@@ -0,0 +1,97 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	retryWorkExchangeSuffix = "-exchange"
+	retryExchangeSuffix     = "-retry-exchange"
+)
+
+// RetryWorkExchangeBuilder builds the exchange which the work queue is bound to, and which retry queues dead letter to
+type RetryWorkExchangeBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.RetryTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryWorkExchange(spec *topologyv1alpha1.RetryTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryWorkExchangeBuilder {
+	return &RetryWorkExchangeBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *RetryWorkExchangeBuilder) Build() (client.Object, error) {
+	return &topology.Exchange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryWorkExchangeSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryWorkExchangeBuilder) Update(object client.Object) error {
+	exchange := object.(*topology.Exchange)
+	exchange.Spec.Name = builder.spec.Name
+	exchange.Spec.Vhost = builder.spec.Vhost
+	// messages dead lettered from retry queues keep the routing key of the retry queue,
+	// so the work exchange routes all messages to the work queue
+	exchange.Spec.Type = "fanout"
+	exchange.Spec.Durable = true
+	exchange.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryWorkExchangeBuilder) ResourceType() string { return "WorkExchange" }
+
+// RetryExchangeBuilder builds the exchange which routes messages to retry queues by delay
+type RetryExchangeBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.RetryTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryExchange(spec *topologyv1alpha1.RetryTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryExchangeBuilder {
+	return &RetryExchangeBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *RetryExchangeBuilder) Build() (client.Object, error) {
+	return &topology.Exchange{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryExchangeSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryExchangeBuilder) Update(object client.Object) error {
+	exchange := object.(*topology.Exchange)
+	exchange.Spec.Name = builder.spec.RetryExchangeName()
+	exchange.Spec.Vhost = builder.spec.Vhost
+	exchange.Spec.Type = "direct"
+	exchange.Spec.Durable = true
+	exchange.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryExchangeBuilder) ResourceType() string { return "RetryExchange" }
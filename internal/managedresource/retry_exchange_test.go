@@ -0,0 +1,91 @@
+package managedresource_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("RetryExchange", func() {
+	var (
+		retryTopology topologyv1alpha1.RetryTopology
+		builder       *managedresource.Builder
+		scheme        *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		retryTopology = topologyv1alpha1.RetryTopology{}
+		retryTopology.Namespace = "foo"
+		retryTopology.Name = "foo"
+		retryTopology.Spec = topologyv1alpha1.RetryTopologySpec{
+			Name:   "orders",
+			Vhost:  "vvv",
+			Type:   "quorum",
+			Delays: []metav1.Duration{{Duration: 5 * time.Second}},
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &retryTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	Context("work exchange", func() {
+		var exchange *topology.Exchange
+
+		BeforeEach(func() {
+			exchangeBuilder := builder.RetryWorkExchange(&retryTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := exchangeBuilder.Build()
+			exchange = obj.(*topology.Exchange)
+			Expect(exchangeBuilder.Update(exchange)).To(Succeed())
+		})
+
+		It("generates an exchange object with the correct name and labels", func() {
+			Expect(exchange.Name).To(Equal("foo-exchange"))
+			Expect(exchange.Namespace).To(Equal(retryTopology.Namespace))
+			Expect(exchange.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-topology", "foo"))
+		})
+
+		It("sets owner reference", func() {
+			Expect(exchange.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+		})
+
+		It("declares a durable fanout exchange named like the work queue", func() {
+			Expect(exchange.Spec.Name).To(Equal("orders"))
+			Expect(exchange.Spec.Vhost).To(Equal("vvv"))
+			Expect(exchange.Spec.Type).To(Equal("fanout"))
+			Expect(exchange.Spec.Durable).To(BeTrue())
+			Expect(exchange.Spec.RabbitmqClusterReference.Name).To(Equal(testRabbitmqClusterReference.Name))
+		})
+	})
+
+	Context("retry exchange", func() {
+		var exchange *topology.Exchange
+
+		BeforeEach(func() {
+			exchangeBuilder := builder.RetryExchange(&retryTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := exchangeBuilder.Build()
+			exchange = obj.(*topology.Exchange)
+			Expect(exchangeBuilder.Update(exchange)).To(Succeed())
+		})
+
+		It("generates an exchange object with the correct name", func() {
+			Expect(exchange.Name).To(Equal("foo-retry-exchange"))
+		})
+
+		It("declares a durable direct exchange", func() {
+			Expect(exchange.Spec.Name).To(Equal("orders.retry"))
+			Expect(exchange.Spec.Type).To(Equal("direct"))
+			Expect(exchange.Spec.Durable).To(BeTrue())
+			Expect(exchange.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package managedresource
+
+import (
+	"fmt"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	retryWorkQueueSuffix = "-queue"
+)
+
+func retryQueueSuffix(delayMilliseconds int64) string {
+	return fmt.Sprintf("-retry-%d", delayMilliseconds)
+}
+
+// RetryWorkQueueBuilder builds the work queue of a RetryTopology
+type RetryWorkQueueBuilder struct {
+	*Builder
+	spec            *topologyv1alpha1.RetryTopologySpec
+	rabbitmqCluster *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryWorkQueue(spec *topologyv1alpha1.RetryTopologySpec, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryWorkQueueBuilder {
+	return &RetryWorkQueueBuilder{builder, spec, rabbitmqCluster}
+}
+
+func (builder *RetryWorkQueueBuilder) Build() (client.Object, error) {
+	return &topology.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryWorkQueueSuffix),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryWorkQueueBuilder) Update(object client.Object) error {
+	queue := object.(*topology.Queue)
+	queue.Spec.Name = builder.spec.Name
+	queue.Spec.Vhost = builder.spec.Vhost
+	queue.Spec.Type = builder.spec.Type
+	queue.Spec.Durable = true
+	queue.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryWorkQueueBuilder) ResourceType() string { return "WorkQueue" }
+
+// RetryQueueBuilder builds the retry queue of a delay, whose messages expire after the delay
+// and are dead lettered to the work exchange
+type RetryQueueBuilder struct {
+	*Builder
+	spec              *topologyv1alpha1.RetryTopologySpec
+	delayMilliseconds int64
+	rabbitmqCluster   *topology.RabbitmqClusterReference
+}
+
+func (builder *Builder) RetryQueue(spec *topologyv1alpha1.RetryTopologySpec, delayMilliseconds int64, rabbitmqCluster *topology.RabbitmqClusterReference) *RetryQueueBuilder {
+	return &RetryQueueBuilder{builder, spec, delayMilliseconds, rabbitmqCluster}
+}
+
+func (builder *RetryQueueBuilder) Build() (client.Object, error) {
+	return &topology.Queue{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(retryQueueSuffix(builder.delayMilliseconds)),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+			Labels: map[string]string{
+				AnnotationRetryTopology: builder.ObjectOwner.GetName(),
+				AnnotationRetryDelay:    topologyv1alpha1.RetryRoutingKey(builder.delayMilliseconds),
+			},
+		},
+	}, nil
+}
+
+func (builder *RetryQueueBuilder) Update(object client.Object) error {
+	queue := object.(*topology.Queue)
+	queue.Spec.Name = builder.spec.RetryQueueName(builder.delayMilliseconds)
+	queue.Spec.Vhost = builder.spec.Vhost
+	queue.Spec.Type = builder.spec.Type
+	queue.Spec.Durable = true
+	queue.Spec.MessageTTL = &builder.delayMilliseconds
+	queue.Spec.DeadLetterExchange = builder.spec.Name
+	queue.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
+
+	// a delay which is added back while its retry queue is draining keeps the retry queue
+	delete(queue.Annotations, AnnotationRetryDrainingSince)
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *RetryQueueBuilder) ResourceType() string { return "RetryQueue" }
@@ -0,0 +1,106 @@
+package managedresource_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("RetryQueue", func() {
+	var (
+		retryTopology topologyv1alpha1.RetryTopology
+		builder       *managedresource.Builder
+		scheme        *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topology.AddToScheme(scheme)).To(Succeed())
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		retryTopology = topologyv1alpha1.RetryTopology{}
+		retryTopology.Namespace = "foo"
+		retryTopology.Name = "foo"
+		retryTopology.Spec = topologyv1alpha1.RetryTopologySpec{
+			Name:   "orders",
+			Vhost:  "vvv",
+			Type:   "quorum",
+			Delays: []metav1.Duration{{Duration: 5 * time.Second}},
+		}
+		builder = &managedresource.Builder{
+			ObjectOwner: &retryTopology,
+			Scheme:      scheme,
+		}
+	})
+
+	Context("work queue", func() {
+		var queue *topology.Queue
+
+		BeforeEach(func() {
+			queueBuilder := builder.RetryWorkQueue(&retryTopology.Spec, testRabbitmqClusterReference)
+			obj, _ := queueBuilder.Build()
+			queue = obj.(*topology.Queue)
+			Expect(queueBuilder.Update(queue)).To(Succeed())
+		})
+
+		It("generates a queue object with the correct name and labels", func() {
+			Expect(queue.Name).To(Equal("foo-queue"))
+			Expect(queue.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-topology", "foo"))
+			Expect(queue.ObjectMeta.Labels).NotTo(HaveKey("rabbitmq.com/retry-delay"))
+		})
+
+		It("declares a durable queue", func() {
+			Expect(queue.Spec.Name).To(Equal("orders"))
+			Expect(queue.Spec.Vhost).To(Equal("vvv"))
+			Expect(queue.Spec.Type).To(Equal("quorum"))
+			Expect(queue.Spec.Durable).To(BeTrue())
+			Expect(queue.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+		})
+	})
+
+	Context("retry queue", func() {
+		var (
+			queueBuilder *managedresource.RetryQueueBuilder
+			queue        *topology.Queue
+		)
+
+		BeforeEach(func() {
+			queueBuilder = builder.RetryQueue(&retryTopology.Spec, 5000, testRabbitmqClusterReference)
+			obj, _ := queueBuilder.Build()
+			queue = obj.(*topology.Queue)
+		})
+
+		It("generates a queue object with the correct name and labels", func() {
+			Expect(queue.Name).To(Equal("foo-retry-5000"))
+			Expect(queue.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-topology", "foo"))
+			Expect(queue.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/retry-delay", "5000"))
+		})
+
+		Context("Update", func() {
+			It("sets owner reference", func() {
+				Expect(queueBuilder.Update(queue)).To(Succeed())
+				Expect(queue.OwnerReferences[0].Name).To(Equal(retryTopology.Name))
+			})
+
+			It("expires messages after the delay and dead letters them to the work exchange", func() {
+				Expect(queueBuilder.Update(queue)).To(Succeed())
+				Expect(queue.Spec.Name).To(Equal("orders.retry.5000"))
+				Expect(queue.Spec.Type).To(Equal("quorum"))
+				Expect(queue.Spec.Durable).To(BeTrue())
+				Expect(*queue.Spec.MessageTTL).To(BeNumerically("==", 5000))
+				Expect(queue.Spec.DeadLetterExchange).To(Equal("orders"))
+			})
+
+			It("removes the draining annotation", func() {
+				queue.Annotations = map[string]string{"rabbitmq.com/retry-draining-since": "2022-01-01T00:00:00Z"}
+				Expect(queueBuilder.Update(queue)).To(Succeed())
+				Expect(queue.Annotations).NotTo(HaveKey("rabbitmq.com/retry-draining-since"))
+			})
+		})
+	})
+})
@@ -13,15 +13,24 @@ type SuperStreamBindingBuilder struct {
 	*Builder
 	partitionIndex  int
 	routingKey      string
+	bindingKey      string
 	vhost           string
 	rabbitmqCluster *topology.RabbitmqClusterReference
 }
 
 func (builder *Builder) SuperStreamBinding(partitionIndex int, routingKey, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *SuperStreamBindingBuilder {
-	return &SuperStreamBindingBuilder{builder, partitionIndex, routingKey, vhost, rabbitmqCluster}
+	return &SuperStreamBindingBuilder{builder, partitionIndex, routingKey, "", vhost, rabbitmqCluster}
+}
+
+// SuperStreamAdditionalBinding builds an extra binding to a partition, with one of the binding keys of the partition
+func (builder *Builder) SuperStreamAdditionalBinding(partitionIndex int, routingKey, bindingKey, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *SuperStreamBindingBuilder {
+	return &SuperStreamBindingBuilder{builder, partitionIndex, routingKey, bindingKey, vhost, rabbitmqCluster}
 }
 
 func (builder *SuperStreamBindingBuilder) partitionSuffix() string {
+	if builder.bindingKey != "" {
+		return fmt.Sprintf("-binding-%d-%s", builder.partitionIndex, applicationHash(builder.bindingKey))
+	}
 	return fmt.Sprintf("-binding-%d", builder.partitionIndex)
 }
 
@@ -44,12 +53,17 @@ func (builder *SuperStreamBindingBuilder) Update(object client.Object) error {
 	binding.Spec.Source = builder.ObjectOwner.GetName()
 	binding.Spec.DestinationType = "queue"
 	binding.Spec.Destination = fmt.Sprintf("%s-%s", builder.ObjectOwner.GetName(), builder.routingKey)
-	binding.Spec.RoutingKey = builder.routingKey
 	binding.Spec.Vhost = builder.vhost
 	binding.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
 
-	argumentString := fmt.Sprintf(`{"x-stream-partition-order": %d}`, builder.partitionIndex)
-	binding.Spec.Arguments = &runtime.RawExtension{Raw: []byte(argumentString)}
+	if builder.bindingKey == "" {
+		binding.Spec.RoutingKey = builder.routingKey
+		argumentString := fmt.Sprintf(`{"x-stream-partition-order": %d}`, builder.partitionIndex)
+		binding.Spec.Arguments = &runtime.RawExtension{Raw: []byte(argumentString)}
+	} else {
+		// extra bindings have no partition order, so that stream clients list each partition once
+		binding.Spec.RoutingKey = builder.bindingKey
+	}
 
 	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
 		return fmt.Errorf("failed setting controller reference: %w", err)
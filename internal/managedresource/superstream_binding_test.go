@@ -86,4 +86,31 @@ var _ = Describe("SuperstreamBinding", func() {
 			Expect(binding.Spec.RabbitmqClusterReference.Namespace).To(Equal(testRabbitmqClusterReference.Namespace))
 		})
 	})
+
+	When("building an additional binding with a binding key", func() {
+		BeforeEach(func() {
+			bindingBuilder = builder.SuperStreamAdditionalBinding(678, "emea", "eu-west", "vvv", testRabbitmqClusterReference)
+			obj, _ := bindingBuilder.Build()
+			binding = obj.(*topology.Binding)
+			Expect(bindingBuilder.Update(binding)).To(Succeed())
+		})
+
+		It("generates a binding object with a name unique to the binding key", func() {
+			Expect(binding.Name).To(HavePrefix("foo-binding-678-"))
+			Expect(binding.Name).NotTo(Equal("foo-binding-678"))
+		})
+
+		It("labels the binding with the routing key of the partition", func() {
+			Expect(binding.ObjectMeta.Labels).To(HaveKeyWithValue("rabbitmq.com/super-stream-routing-key", "emea"))
+		})
+
+		It("binds the partition queue with the binding key", func() {
+			Expect(binding.Spec.Destination).To(Equal("foo-emea"))
+			Expect(binding.Spec.RoutingKey).To(Equal("eu-west"))
+		})
+
+		It("does not set the stream partition args", func() {
+			Expect(binding.Spec.Arguments).To(BeNil())
+		})
+	})
 })
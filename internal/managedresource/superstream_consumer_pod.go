@@ -0,0 +1,117 @@
+package managedresource
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	EnvSuperStream           = "RABBITMQ_SUPER_STREAM"
+	EnvSuperStreamPartitions = "RABBITMQ_SUPER_STREAM_PARTITIONS"
+)
+
+// SuperStreamConsumerPodBuilder builds a consumer pod of a SuperStreamConsumer, which consumes from a group of partitions
+// the pod is annotated with a hash of its pod template and of the partitions of the SuperStream; a pod whose hash
+// differs from the hash of its builder is outdated, and must be replaced
+type SuperStreamConsumerPodBuilder struct {
+	*Builder
+	podIndex              int
+	superStream           string
+	partitions            []string
+	superStreamPartitions []string
+	template              *corev1.PodTemplateSpec
+}
+
+func (builder *Builder) SuperStreamConsumerPod(podIndex int, superStream string, partitions, superStreamPartitions []string, template *corev1.PodTemplateSpec) *SuperStreamConsumerPodBuilder {
+	return &SuperStreamConsumerPodBuilder{builder, podIndex, superStream, partitions, superStreamPartitions, template}
+}
+
+// PodSpecHash returns the hash of the pod template and of the partitions of the SuperStream
+// since it covers all partitions, adding partitions to the SuperStream replaces all consumer pods
+func (builder *SuperStreamConsumerPodBuilder) PodSpecHash() (string, error) {
+	content, err := json.Marshal(struct {
+		Template              *corev1.PodTemplateSpec `json:"template"`
+		Partitions            []string                `json:"partitions"`
+		SuperStreamPartitions []string                `json:"superStreamPartitions"`
+	}{builder.template, builder.partitions, builder.superStreamPartitions})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal consumer pod template: %w", err)
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write(content)
+	return fmt.Sprintf("%08x", hash.Sum32()), nil
+}
+
+func (builder *SuperStreamConsumerPodBuilder) Build() (client.Object, error) {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      builder.GenerateChildResourceName(fmt.Sprintf("-%d", builder.podIndex)),
+			Namespace: builder.ObjectOwner.GetNamespace(),
+		},
+	}, nil
+}
+
+// Update sets the pod template on a pod which does not exist yet; pod specs cannot be updated, outdated pods are replaced instead
+func (builder *SuperStreamConsumerPodBuilder) Update(object client.Object) error {
+	podSpecHash, err := builder.PodSpecHash()
+	if err != nil {
+		return err
+	}
+
+	pod := object.(*corev1.Pod)
+	template := builder.template.DeepCopy()
+	pod.Labels = template.Labels
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	pod.Labels[AnnotationSuperStream] = builder.superStream
+	pod.Labels[AnnotationSuperStreamConsumer] = builder.ObjectOwner.GetName()
+	pod.Annotations = template.Annotations
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[AnnotationSuperStreamPartition] = strings.Join(builder.partitions, ",")
+	pod.Annotations[AnnotationConsumerPodSpecHash] = podSpecHash
+
+	pod.Spec = template.Spec
+	env := []corev1.EnvVar{
+		{Name: EnvSuperStream, Value: builder.superStream},
+		{Name: EnvSuperStreamPartitions, Value: strings.Join(builder.partitions, ",")},
+	}
+	for i := range pod.Spec.Containers {
+		pod.Spec.Containers[i].Env = append(pod.Spec.Containers[i].Env, env...)
+	}
+
+	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
+		return fmt.Errorf("failed setting controller reference: %w", err)
+	}
+
+	return nil
+}
+
+func (builder *SuperStreamConsumerPodBuilder) ResourceType() string { return "ConsumerPod" }
+
+// SuperStreamConsumerPartitionGroups assigns the partitions of a SuperStream to consumer pods, in order
+func SuperStreamConsumerPartitionGroups(consumer *topologyv1alpha1.SuperStreamConsumer, partitions []string) [][]string {
+	partitionsPerPod := consumer.Spec.PartitionsPerPod
+	if partitionsPerPod < 1 {
+		partitionsPerPod = 1
+	}
+	var groups [][]string
+	for start := 0; start < len(partitions); start += partitionsPerPod {
+		end := start + partitionsPerPod
+		if end > len(partitions) {
+			end = len(partitions)
+		}
+		groups = append(groups, partitions[start:end])
+	}
+	return groups
+}
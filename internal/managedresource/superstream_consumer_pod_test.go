@@ -0,0 +1,124 @@
+package managedresource_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topologyv1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	"github.com/rabbitmq/messaging-topology-operator/internal/managedresource"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("SuperStreamConsumerPod", func() {
+	var (
+		consumer   topologyv1alpha1.SuperStreamConsumer
+		builder    *managedresource.Builder
+		podBuilder *managedresource.SuperStreamConsumerPodBuilder
+		pod        *corev1.Pod
+		scheme     *runtime.Scheme
+		partitions []string
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(topologyv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		consumer = topologyv1alpha1.SuperStreamConsumer{}
+		consumer.Namespace = "foo"
+		consumer.Name = "foo"
+		consumer.Spec = topologyv1alpha1.SuperStreamConsumerSpec{
+			SuperStreamReference: corev1.LocalObjectReference{Name: "orders"},
+			PartitionsPerPod:     1,
+			PodTemplate: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"app": "orders-consumer"},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "consumer",
+						Image: "orders-consumer:1.0",
+						Env:   []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "debug"}},
+					}},
+				},
+			},
+		}
+		partitions = []string{"orders-0", "orders-1", "orders-2"}
+		builder = &managedresource.Builder{
+			ObjectOwner: &consumer,
+			Scheme:      scheme,
+		}
+	})
+
+	JustBeforeEach(func() {
+		podBuilder = builder.SuperStreamConsumerPod(1, "orders", partitions[1:2], partitions, &consumer.Spec.PodTemplate)
+		obj, _ := podBuilder.Build()
+		pod = obj.(*corev1.Pod)
+		Expect(podBuilder.Update(pod)).To(Succeed())
+	})
+
+	It("generates a pod object with the correct name and labels", func() {
+		Expect(pod.Name).To(Equal("foo-1"))
+		Expect(pod.Namespace).To(Equal("foo"))
+		Expect(pod.Labels).To(SatisfyAll(
+			HaveKeyWithValue("app", "orders-consumer"),
+			HaveKeyWithValue("rabbitmq.com/super-stream", "orders"),
+			HaveKeyWithValue("rabbitmq.com/super-stream-consumer", "foo"),
+		))
+		Expect(pod.OwnerReferences[0].Name).To(Equal(consumer.Name))
+	})
+
+	It("annotates the pod with its partitions and the hash of its template", func() {
+		podSpecHash, err := podBuilder.PodSpecHash()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pod.Annotations).To(SatisfyAll(
+			HaveKeyWithValue("rabbitmq.com/super-stream-partition", "orders-1"),
+			HaveKeyWithValue("rabbitmq.com/consumer-pod-spec-hash", podSpecHash),
+		))
+	})
+
+	It("sets the super stream and the partitions in the environment of the containers", func() {
+		Expect(pod.Spec.Containers[0].Image).To(Equal("orders-consumer:1.0"))
+		Expect(pod.Spec.Containers[0].Env).To(ConsistOf(
+			corev1.EnvVar{Name: "LOG_LEVEL", Value: "debug"},
+			corev1.EnvVar{Name: "RABBITMQ_SUPER_STREAM", Value: "orders"},
+			corev1.EnvVar{Name: "RABBITMQ_SUPER_STREAM_PARTITIONS", Value: "orders-1"},
+		))
+	})
+
+	It("does not modify the pod template", func() {
+		Expect(consumer.Spec.PodTemplate.Spec.Containers[0].Env).To(HaveLen(1))
+		Expect(consumer.Spec.PodTemplate.Labels).To(HaveLen(1))
+	})
+
+	Context("PodSpecHash", func() {
+		It("changes when the pod template is updated", func() {
+			podSpecHash, _ := podBuilder.PodSpecHash()
+			consumer.Spec.PodTemplate.Spec.Containers[0].Image = "orders-consumer:2.0"
+			updatedHash, _ := builder.SuperStreamConsumerPod(1, "orders", partitions[1:2], partitions, &consumer.Spec.PodTemplate).PodSpecHash()
+			Expect(updatedHash).NotTo(Equal(podSpecHash))
+		})
+
+		It("changes when partitions are added to the super stream", func() {
+			podSpecHash, _ := podBuilder.PodSpecHash()
+			scaledUp := append(partitions, "orders-3")
+			updatedHash, _ := builder.SuperStreamConsumerPod(1, "orders", partitions[1:2], scaledUp, &consumer.Spec.PodTemplate).PodSpecHash()
+			Expect(updatedHash).NotTo(Equal(podSpecHash))
+		})
+	})
+
+	Context("SuperStreamConsumerPartitionGroups", func() {
+		It("assigns one partition per pod by default", func() {
+			Expect(managedresource.SuperStreamConsumerPartitionGroups(&consumer, partitions)).To(Equal([][]string{
+				{"orders-0"}, {"orders-1"}, {"orders-2"},
+			}))
+		})
+
+		It("assigns partitions to pods in order", func() {
+			consumer.Spec.PartitionsPerPod = 2
+			Expect(managedresource.SuperStreamConsumerPartitionGroups(&consumer, partitions)).To(Equal([][]string{
+				{"orders-0", "orders-1"}, {"orders-2"},
+			}))
+		})
+	})
+})
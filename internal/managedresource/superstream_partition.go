@@ -14,11 +14,12 @@ type SuperStreamPartitionBuilder struct {
 	vhost           string
 	routingKey      string
 	partitionIndex  int
+	stream          *topology.StreamSettings
 	rabbitmqCluster *topology.RabbitmqClusterReference
 }
 
-func (builder *Builder) SuperStreamPartition(partitionIndex int, routingKey, vhost string, rabbitmqCluster *topology.RabbitmqClusterReference) *SuperStreamPartitionBuilder {
-	return &SuperStreamPartitionBuilder{builder, vhost, routingKey, partitionIndex, rabbitmqCluster}
+func (builder *Builder) SuperStreamPartition(partitionIndex int, routingKey, vhost string, stream *topology.StreamSettings, rabbitmqCluster *topology.RabbitmqClusterReference) *SuperStreamPartitionBuilder {
+	return &SuperStreamPartitionBuilder{builder, vhost, routingKey, partitionIndex, stream, rabbitmqCluster}
 }
 
 func partitionSuffix(partitionIndex int) string {
@@ -44,8 +45,12 @@ func (builder *SuperStreamPartitionBuilder) Update(object client.Object) error {
 	partition.Spec.Durable = true
 	partition.Spec.Type = "stream"
 	partition.Spec.Vhost = builder.vhost
+	partition.Spec.Stream = builder.stream
 	partition.Spec.RabbitmqClusterReference = *builder.rabbitmqCluster
 
+	// a partition which is added back while it is being removed is kept
+	delete(partition.Annotations, AnnotationSuperStreamUnboundSince)
+
 	if err := controllerutil.SetControllerReference(builder.ObjectOwner, object, builder.Scheme); err != nil {
 		return fmt.Errorf("failed setting controller reference: %w", err)
 	}
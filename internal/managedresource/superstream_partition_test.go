@@ -29,7 +29,7 @@ var _ = Describe("SuperstreamPartition", func() {
 			ObjectOwner: &superStream,
 			Scheme:      scheme,
 		}
-		partitionBuilder = builder.SuperStreamPartition(345, "emea", "vvv", testRabbitmqClusterReference)
+		partitionBuilder = builder.SuperStreamPartition(345, "emea", "vvv", &topology.StreamSettings{MaxAge: "7D", InitialClusterSize: 3}, testRabbitmqClusterReference)
 		obj, _ := partitionBuilder.Build()
 		partition = obj.(*topology.Queue)
 	})
@@ -73,9 +73,19 @@ var _ = Describe("SuperstreamPartition", func() {
 			Expect(partition.Spec.Vhost).To(Equal("vvv"))
 		})
 
+		It("sets the stream settings", func() {
+			Expect(partition.Spec.Stream).To(Equal(&topology.StreamSettings{MaxAge: "7D", InitialClusterSize: 3}))
+		})
+
 		It("sets the expected RabbitmqClusterReference", func() {
 			Expect(partition.Spec.RabbitmqClusterReference.Name).To(Equal(testRabbitmqClusterReference.Name))
 			Expect(partition.Spec.RabbitmqClusterReference.Namespace).To(Equal(testRabbitmqClusterReference.Namespace))
 		})
+
+		It("removes the unbound annotation", func() {
+			partition.Annotations = map[string]string{"rabbitmq.com/super-stream-unbound-since": "2022-01-01T00:00:00Z"}
+			Expect(partitionBuilder.Update(partition)).To(Succeed())
+			Expect(partition.Annotations).NotTo(HaveKey("rabbitmq.com/super-stream-unbound-since"))
+		})
 	})
 })
@@ -18,6 +18,7 @@ import (
 
 // generates rabbithole.QueueSettings for a given Queue
 // queue.Spec.Arguments (type k8s runtime.RawExtensions) is unmarshalled
+// arguments set through typed fields, such as queue.Spec.DeadLetterExchange, are added to the arguments
 // Unmarshall stores float64, for JSON numbers
 // See: https://golang.org/pkg/encoding/json/#Unmarshal
 func GenerateQueueSettings(q *topology.Queue) (*rabbithole.QueueSettings, error) {
@@ -28,6 +29,10 @@ func GenerateQueueSettings(q *topology.Queue) (*rabbithole.QueueSettings, error)
 		}
 	}
 
+	for k, v := range q.Spec.TypedArguments() {
+		arguments[k] = v
+	}
+
 	return &rabbithole.QueueSettings{
 		Type:       q.Spec.Type,
 		Durable:    q.Spec.Durable,
@@ -70,4 +70,78 @@ var _ = Describe("GenerateQueueSettings", func() {
 			))
 		})
 	})
+
+	When("typed queue arguments are provided", func() {
+		It("adds them to the queue arguments", func() {
+			messageTTL := int64(60000)
+			maxLength := int64(0)
+			deliveryLimit := int64(5)
+			q.Spec.DeadLetterExchange = "dlx"
+			q.Spec.DeadLetterRoutingKey = "dead"
+			q.Spec.MessageTTL = &messageTTL
+			q.Spec.MaxLength = &maxLength
+			q.Spec.Overflow = "reject-publish"
+			q.Spec.DeliveryLimit = &deliveryLimit
+			q.Spec.SingleActiveConsumer = true
+			q.Spec.InitialGroupSize = 3
+			q.Spec.Arguments = &runtime.RawExtension{
+				Raw: []byte(`{"x-max-in-memory-length": 500}`)}
+			settings, err := internal.GenerateQueueSettings(q)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Arguments).To(Equal(map[string]interface{}{
+				"x-max-in-memory-length":      float64(500),
+				"x-dead-letter-exchange":      "dlx",
+				"x-dead-letter-routing-key":   "dead",
+				"x-message-ttl":               int64(60000),
+				"x-max-length":                int64(0),
+				"x-overflow":                  "reject-publish",
+				"x-delivery-limit":            int64(5),
+				"x-single-active-consumer":    true,
+				"x-quorum-initial-group-size": 3,
+			}))
+		})
+	})
+
+	When("stream settings are provided", func() {
+		BeforeEach(func() {
+			q.Spec.Type = "stream"
+			q.Spec.Stream = &topology.StreamSettings{
+				MaxAge:              "7D",
+				MaxLengthBytes:      20000000000,
+				MaxSegmentSizeBytes: 500000000,
+				InitialClusterSize:  3,
+			}
+		})
+
+		It("adds stream settings to the queue arguments", func() {
+			settings, err := internal.GenerateQueueSettings(q)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Arguments).Should(SatisfyAll(
+				HaveLen(4),
+				HaveKeyWithValue("x-max-age", "7D"),
+				HaveKeyWithValue("x-max-length-bytes", int64(20000000000)),
+				HaveKeyWithValue("x-stream-max-segment-size-bytes", int64(500000000)),
+				HaveKeyWithValue("x-initial-cluster-size", 3),
+			))
+		})
+
+		It("merges stream settings with queue arguments", func() {
+			q.Spec.Arguments = &runtime.RawExtension{
+				Raw: []byte(`{"x-max-age": "1D", "x-stream-filter-size-bytes": 32}`)}
+			settings, err := internal.GenerateQueueSettings(q)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Arguments).Should(SatisfyAll(
+				HaveLen(5),
+				HaveKeyWithValue("x-max-age", "7D"),
+				HaveKeyWithValue("x-stream-filter-size-bytes", float64(32)),
+			))
+		})
+
+		It("does not set arguments for stream settings which are not set", func() {
+			q.Spec.Stream = &topology.StreamSettings{MaxAge: "12h"}
+			settings, err := internal.GenerateQueueSettings(q)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings.Arguments).To(Equal(map[string]interface{}{"x-max-age": "12h"}))
+		})
+	})
 })
@@ -0,0 +1,59 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+const defaultRuntimeParameterVhost = "/"
+
+// GenerateRuntimeParameterValue returns the decoded spec.value, ready to be sent to the RabbitMQ management API
+func GenerateRuntimeParameterValue(p *topology.RuntimeParameter) (interface{}, error) {
+	if p.Spec.Value == nil {
+		return nil, fmt.Errorf("runtime parameter value is not set")
+	}
+	var value interface{}
+	if err := json.Unmarshal(p.Spec.Value.Raw, &value); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal runtime parameter value: %w", err)
+	}
+	return value, nil
+}
+
+// RuntimeParameterVhost returns the vhost a runtime parameter is declared in
+// global runtime parameters are not scoped to a vhost and always return an empty string
+func RuntimeParameterVhost(p *topology.RuntimeParameter) string {
+	if p.Spec.Component == "" {
+		return ""
+	}
+	if p.Spec.Vhost == "" {
+		return defaultRuntimeParameterVhost
+	}
+	return p.Spec.Vhost
+}
+
+// RuntimeParameterKey returns a string which uniquely identifies the runtime parameter a RuntimeParameter object manages
+// the key is made of the referenced RabbitMQ cluster, component, vhost and name
+// two RuntimeParameter objects with the same key target the same runtime parameter in RabbitMQ
+func RuntimeParameterKey(p *topology.RuntimeParameter) string {
+	ref := p.Spec.RabbitmqClusterReference
+	namespace := ref.Namespace
+	if namespace == "" {
+		namespace = p.Namespace
+	}
+	cluster := fmt.Sprintf("cluster:%s/%s", namespace, ref.Name)
+	if ref.ConnectionSecret != nil {
+		cluster = fmt.Sprintf("secret:%s/%s", p.Namespace, ref.ConnectionSecret.Name)
+	}
+	return fmt.Sprintf("%s|%s|%s|%s", cluster, p.Spec.Component, RuntimeParameterVhost(p), p.Spec.Name)
+}
@@ -0,0 +1,137 @@
+package internal_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	. "github.com/rabbitmq/messaging-topology-operator/internal"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("RuntimeParameter", func() {
+	var p *topology.RuntimeParameter
+
+	BeforeEach(func() {
+		p = &topology.RuntimeParameter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "a-runtime-parameter",
+				Namespace: "a-namespace",
+			},
+			Spec: topology.RuntimeParameterSpec{
+				Name:      "a-set",
+				Component: "federation-upstream-set",
+				Value: &runtime.RawExtension{
+					Raw: []byte(`[{"upstream":"upstream-1"},{"upstream":"upstream-2"}]`),
+				},
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: "a-cluster",
+				},
+			},
+		}
+	})
+
+	Context("GenerateRuntimeParameterValue", func() {
+		It("decodes json arrays", func() {
+			value, err := GenerateRuntimeParameterValue(p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal([]interface{}{
+				map[string]interface{}{"upstream": "upstream-1"},
+				map[string]interface{}{"upstream": "upstream-2"},
+			}))
+		})
+
+		It("decodes json objects", func() {
+			p.Spec.Value = &runtime.RawExtension{Raw: []byte(`{"1883":"vhost1","8883":"vhost2"}`)}
+			value, err := GenerateRuntimeParameterValue(p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(map[string]interface{}{"1883": "vhost1", "8883": "vhost2"}))
+		})
+
+		It("decodes json strings", func() {
+			p.Spec.Value = &runtime.RawExtension{Raw: []byte(`"a-cluster-name"`)}
+			value, err := GenerateRuntimeParameterValue(p)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("a-cluster-name"))
+		})
+
+		It("errors when value is not valid json", func() {
+			p.Spec.Value = &runtime.RawExtension{Raw: []byte(`{not-json`)}
+			_, err := GenerateRuntimeParameterValue(p)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("errors when value is not set", func() {
+			p.Spec.Value = nil
+			_, err := GenerateRuntimeParameterValue(p)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("RuntimeParameterVhost", func() {
+		It("defaults to '/' when component is set", func() {
+			Expect(RuntimeParameterVhost(p)).To(Equal("/"))
+		})
+
+		It("returns the configured vhost", func() {
+			p.Spec.Vhost = "a-vhost"
+			Expect(RuntimeParameterVhost(p)).To(Equal("a-vhost"))
+		})
+
+		It("returns an empty vhost for global runtime parameters", func() {
+			p.Spec.Component = ""
+			Expect(RuntimeParameterVhost(p)).To(BeEmpty())
+		})
+	})
+
+	Context("RuntimeParameterKey", func() {
+		var other *topology.RuntimeParameter
+
+		BeforeEach(func() {
+			other = p.DeepCopy()
+			other.Name = "another-runtime-parameter"
+		})
+
+		It("matches for objects targeting the same runtime parameter", func() {
+			Expect(RuntimeParameterKey(p)).To(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("matches when the default vhost is set explicitly", func() {
+			other.Spec.Vhost = "/"
+			Expect(RuntimeParameterKey(p)).To(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("matches when the cluster namespace is set to the object namespace", func() {
+			other.Spec.RabbitmqClusterReference.Namespace = "a-namespace"
+			Expect(RuntimeParameterKey(p)).To(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("differs for different components", func() {
+			other.Spec.Component = "shovel"
+			Expect(RuntimeParameterKey(p)).NotTo(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("differs for different vhosts", func() {
+			other.Spec.Vhost = "another-vhost"
+			Expect(RuntimeParameterKey(p)).NotTo(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("differs for different names", func() {
+			other.Spec.Name = "another-set"
+			Expect(RuntimeParameterKey(p)).NotTo(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("differs for different clusters", func() {
+			other.Spec.RabbitmqClusterReference.Name = "another-cluster"
+			Expect(RuntimeParameterKey(p)).NotTo(Equal(RuntimeParameterKey(other)))
+		})
+
+		It("differs for clusters referenced by connection secret", func() {
+			other.Spec.RabbitmqClusterReference = topology.RabbitmqClusterReference{
+				ConnectionSecret: &corev1.LocalObjectReference{Name: "a-cluster"},
+			}
+			Expect(RuntimeParameterKey(p)).NotTo(Equal(RuntimeParameterKey(other)))
+		})
+	})
+})
@@ -11,9 +11,11 @@ package internal
 
 import (
 	"fmt"
+	"strconv"
 
 	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -26,20 +28,76 @@ func GenerateUserSettings(credentials *corev1.Secret, tags []topology.UserTag) (
 	if !ok {
 		return rabbithole.UserSettings{}, fmt.Errorf("could not find password in credentials secret %s", credentials.Name)
 	}
+	return GenerateUserSettingsFromCredentials(string(username), string(password), tags), nil
+}
 
+// GenerateUserSettingsFromCredentials returns the settings of a user with the given username and password, e.g. read from Vault
+func GenerateUserSettingsFromCredentials(username, password string, tags []topology.UserTag) rabbithole.UserSettings {
 	var userTagStrings []string
 	for _, tag := range tags {
 		userTagStrings = append(userTagStrings, string(tag))
 	}
 
 	return rabbithole.UserSettings{
-		Name: string(username),
+		Name: username,
 		Tags: userTagStrings,
 		// To avoid sending raw passwords over the wire, compute a password hash using a random salt
 		// and use this in the UserSettings instead.
 		// For more information on this hashing algorithm, see
 		// https://www.rabbitmq.com/passwords.html#computing-password-hash.
-		PasswordHash:     rabbithole.Base64EncodedSaltedPasswordHashSHA512(string(password)),
+		PasswordHash:     rabbithole.Base64EncodedSaltedPasswordHashSHA512(password),
 		HashingAlgorithm: rabbithole.HashingAlgorithmSHA512,
-	}, nil
+	}
+}
+
+const (
+	UserLimitMaxConnections = "max-connections"
+	UserLimitMaxChannels    = "max-channels"
+)
+
+// GenerateUserLimits returns the limits set in user.spec.limits, keyed by their RabbitMQ limit name
+func GenerateUserLimits(limits *topology.UserLimits) rabbithole.UserLimitsValues {
+	userLimits := rabbithole.UserLimitsValues{}
+	if limits == nil {
+		return userLimits
+	}
+	if limits.MaxConnections != nil {
+		userLimits[UserLimitMaxConnections] = int(*limits.MaxConnections)
+	}
+	if limits.MaxChannels != nil {
+		userLimits[UserLimitMaxChannels] = int(*limits.MaxChannels)
+	}
+	return userLimits
+}
+
+// GenerateUserBindingData returns the entries which, together with username and password, make the credentials Secret of a user
+// a Provisioned Service Secret: type, provider, host, port, vhost, uri, and ca.crt when the cluster has a CA certificate.
+// For more information, see https://servicebinding.io/spec/core/1.0.0/#well-known-secret-entries.
+func GenerateUserBindingData(credentials *corev1.Secret, connection *rabbitmqclient.AMQPConnection, vhost string) (map[string][]byte, error) {
+	username, ok := credentials.Data["username"]
+	if !ok {
+		return nil, fmt.Errorf("could not find username in credentials secret %s", credentials.Name)
+	}
+	password, ok := credentials.Data["password"]
+	if !ok {
+		return nil, fmt.Errorf("could not find password in credentials secret %s", credentials.Name)
+	}
+
+	uri, err := GenerateAMQPURI(connection.Endpoint(), string(username), string(password), vhost)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string][]byte{
+		"type":     []byte("rabbitmq"),
+		"provider": []byte("rabbitmq"),
+		"host":     []byte(connection.Host),
+		"port":     []byte(strconv.Itoa(int(connection.Port))),
+		"vhost":    []byte(vhost),
+		"uri":      []byte(uri),
+	}
+	if len(connection.CACertificate) > 0 {
+		data["ca.crt"] = connection.CACertificate
+	}
+	return data, nil
 }
@@ -20,3 +20,23 @@ func GenerateVhostSettings(v *topology.Vhost) *rabbithole.VhostSettings {
 		Tags:    v.Spec.Tags,
 	}
 }
+
+const (
+	VhostLimitMaxConnections = "max-connections"
+	VhostLimitMaxQueues      = "max-queues"
+)
+
+// GenerateVhostLimits returns the limits set in vhost.spec.limits, keyed by their RabbitMQ limit name
+func GenerateVhostLimits(v *topology.Vhost) rabbithole.VhostLimitsValues {
+	limits := rabbithole.VhostLimitsValues{}
+	if v.Spec.Limits == nil {
+		return limits
+	}
+	if v.Spec.Limits.MaxConnections != nil {
+		limits[VhostLimitMaxConnections] = int(*v.Spec.Limits.MaxConnections)
+	}
+	if v.Spec.Limits.MaxQueues != nil {
+		limits[VhostLimitMaxQueues] = int(*v.Spec.Limits.MaxQueues)
+	}
+	return limits
+}
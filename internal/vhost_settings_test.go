@@ -6,6 +6,7 @@ import (
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 	"github.com/rabbitmq/messaging-topology-operator/internal"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 )
 
 var _ = Describe("GenerateVhostSettings", func() {
@@ -33,3 +34,40 @@ var _ = Describe("GenerateVhostSettings", func() {
 		Expect(settings.Tags).To(ConsistOf("tag1", "tag2", "multi_dc_replication"))
 	})
 })
+
+var _ = Describe("GenerateVhostLimits", func() {
+	var v *topology.Vhost
+
+	BeforeEach(func() {
+		v = &topology.Vhost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: topology.VhostSpec{
+				Limits: &topology.VhostLimits{
+					MaxConnections: pointer.Int32Ptr(100),
+					MaxQueues:      pointer.Int32Ptr(0),
+				},
+			},
+		}
+	})
+
+	It("sets 'max-connections' and 'max-queues' according to vhost.spec.limits", func() {
+		limits := internal.GenerateVhostLimits(v)
+		Expect(limits).To(HaveLen(2))
+		Expect(limits).To(HaveKeyWithValue("max-connections", 100))
+		Expect(limits).To(HaveKeyWithValue("max-queues", 0))
+	})
+
+	It("only sets limits that are configured", func() {
+		v.Spec.Limits.MaxQueues = nil
+		limits := internal.GenerateVhostLimits(v)
+		Expect(limits).To(HaveLen(1))
+		Expect(limits).To(HaveKeyWithValue("max-connections", 100))
+	})
+
+	It("returns no limits when vhost.spec.limits is not set", func() {
+		v.Spec.Limits = nil
+		Expect(internal.GenerateVhostLimits(v)).To(BeEmpty())
+	})
+})
@@ -90,6 +90,9 @@ func main() {
 
 	clusterDomain := sanitizeClusterDomainInput(os.Getenv(controllers.KubernetesInternalDomainEnvVar))
 
+	vaultUserCredentialsPathPrefix := os.Getenv(controllers.VaultUserCredentialsPathPrefixEnvVar)
+	topology.VaultUserCredentialsPathPrefix = vaultUserCredentialsPathPrefix
+
 	managerOpts := ctrl.Options{
 		Scheme:                  scheme,
 		MetricsBindAddress:      metricsAddr,
@@ -159,12 +162,13 @@ func main() {
 		os.Exit(1)
 	}
 	if err = (&controllers.UserReconciler{
-		Client:                  mgr.GetClient(),
-		Log:                     ctrl.Log.WithName(controllers.UserControllerName),
-		Scheme:                  mgr.GetScheme(),
-		Recorder:                mgr.GetEventRecorderFor(controllers.UserControllerName),
-		RabbitmqClientFactory:   rabbitmqclient.RabbitholeClientFactory,
-		KubernetesClusterDomain: clusterDomain,
+		Client:                     mgr.GetClient(),
+		Log:                        ctrl.Log.WithName(controllers.UserControllerName),
+		Scheme:                     mgr.GetScheme(),
+		Recorder:                   mgr.GetEventRecorderFor(controllers.UserControllerName),
+		RabbitmqClientFactory:      rabbitmqclient.RabbitholeClientFactory,
+		KubernetesClusterDomain:    clusterDomain,
+		VaultCredentialsPathPrefix: vaultUserCredentialsPathPrefix,
 	}).SetupWithManager(mgr); err != nil {
 		log.Error(err, "unable to create controller", "controller", controllers.UserControllerName)
 		os.Exit(1)
@@ -191,6 +195,17 @@ func main() {
 		log.Error(err, "unable to create controller", "controller", controllers.PolicyControllerName)
 		os.Exit(1)
 	}
+	if err = (&controllers.OperatorPolicyReconciler{
+		Client:                  mgr.GetClient(),
+		Log:                     ctrl.Log.WithName(controllers.OperatorPolicyControllerName),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor(controllers.OperatorPolicyControllerName),
+		RabbitmqClientFactory:   rabbitmqclient.RabbitholeClientFactory,
+		KubernetesClusterDomain: clusterDomain,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.OperatorPolicyControllerName)
+		os.Exit(1)
+	}
 	if err = (&controllers.PermissionReconciler{
 		Client:                  mgr.GetClient(),
 		Log:                     ctrl.Log.WithName(controllers.PermissionControllerName),
@@ -202,6 +217,17 @@ func main() {
 		log.Error(err, "unable to create controller", "controller", controllers.PermissionControllerName)
 		os.Exit(1)
 	}
+	if err = (&controllers.TopicPermissionReconciler{
+		Client:                  mgr.GetClient(),
+		Log:                     ctrl.Log.WithName(controllers.TopicPermissionControllerName),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor(controllers.TopicPermissionControllerName),
+		RabbitmqClientFactory:   rabbitmqclient.RabbitholeClientFactory,
+		KubernetesClusterDomain: clusterDomain,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.TopicPermissionControllerName)
+		os.Exit(1)
+	}
 	if err = (&controllers.SchemaReplicationReconciler{
 		Client:                  mgr.GetClient(),
 		Log:                     ctrl.Log.WithName(controllers.SchemaReplicationControllerName),
@@ -235,6 +261,28 @@ func main() {
 		log.Error(err, "unable to create controller", "controller", controllers.ShovelControllerName)
 		os.Exit(1)
 	}
+	if err = (&controllers.RuntimeParameterReconciler{
+		Client:                  mgr.GetClient(),
+		Log:                     ctrl.Log.WithName(controllers.RuntimeParameterControllerName),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor(controllers.RuntimeParameterControllerName),
+		RabbitmqClientFactory:   rabbitmqclient.RabbitholeClientFactory,
+		KubernetesClusterDomain: clusterDomain,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.RuntimeParameterControllerName)
+		os.Exit(1)
+	}
+	if err = (&controllers.DefinitionsReconciler{
+		Client:                  mgr.GetClient(),
+		Log:                     ctrl.Log.WithName(controllers.DefinitionsControllerName),
+		Scheme:                  mgr.GetScheme(),
+		Recorder:                mgr.GetEventRecorderFor(controllers.DefinitionsControllerName),
+		RabbitmqClientFactory:   rabbitmqclient.RabbitholeClientFactory,
+		KubernetesClusterDomain: clusterDomain,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.DefinitionsControllerName)
+		os.Exit(1)
+	}
 	if err = (&controllers.SuperStreamReconciler{
 		Client:                mgr.GetClient(),
 		Log:                   ctrl.Log.WithName(controllers.SuperStreamControllerName),
@@ -245,6 +293,45 @@ func main() {
 		log.Error(err, "unable to create controller", "controller", controllers.SuperStreamControllerName)
 		os.Exit(1)
 	}
+	if err = (&controllers.DeadLetterTopologyReconciler{
+		Client:                mgr.GetClient(),
+		Log:                   ctrl.Log.WithName(controllers.DeadLetterTopologyControllerName),
+		Scheme:                mgr.GetScheme(),
+		Recorder:              mgr.GetEventRecorderFor(controllers.DeadLetterTopologyControllerName),
+		RabbitmqClientFactory: rabbitmqclient.RabbitholeClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.DeadLetterTopologyControllerName)
+		os.Exit(1)
+	}
+	if err = (&controllers.RetryTopologyReconciler{
+		Client:                mgr.GetClient(),
+		Log:                   ctrl.Log.WithName(controllers.RetryTopologyControllerName),
+		Scheme:                mgr.GetScheme(),
+		Recorder:              mgr.GetEventRecorderFor(controllers.RetryTopologyControllerName),
+		RabbitmqClientFactory: rabbitmqclient.RabbitholeClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.RetryTopologyControllerName)
+		os.Exit(1)
+	}
+	if err = (&controllers.MessagingApplicationReconciler{
+		Client:                mgr.GetClient(),
+		Log:                   ctrl.Log.WithName(controllers.MessagingApplicationControllerName),
+		Scheme:                mgr.GetScheme(),
+		Recorder:              mgr.GetEventRecorderFor(controllers.MessagingApplicationControllerName),
+		RabbitmqClientFactory: rabbitmqclient.RabbitholeClientFactory,
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.MessagingApplicationControllerName)
+		os.Exit(1)
+	}
+	if err = (&controllers.SuperStreamConsumerReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName(controllers.SuperStreamConsumerControllerName),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor(controllers.SuperStreamConsumerControllerName),
+	}).SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to create controller", "controller", controllers.SuperStreamConsumerControllerName)
+		os.Exit(1)
+	}
 
 	if os.Getenv(controllers.EnableWebhooksEnvVar) != "false" {
 		if err = (&topology.Binding{}).SetupWebhookWithManager(mgr); err != nil {
@@ -267,6 +354,10 @@ func main() {
 			log.Error(err, "unable to create webhook", "webhook", "Policy")
 			os.Exit(1)
 		}
+		if err = (&topology.OperatorPolicy{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "OperatorPolicy")
+			os.Exit(1)
+		}
 		if err = (&topology.User{}).SetupWebhookWithManager(mgr); err != nil {
 			log.Error(err, "unable to create webhook", "webhook", "User")
 			os.Exit(1)
@@ -275,6 +366,18 @@ func main() {
 			log.Error(err, "unable to create webhook", "webhook", "Permission")
 			os.Exit(1)
 		}
+		if err = (&topology.TopicPermission{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "TopicPermission")
+			os.Exit(1)
+		}
+		if err = (&topology.RuntimeParameter{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "RuntimeParameter")
+			os.Exit(1)
+		}
+		if err = (&topology.Definitions{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "Definitions")
+			os.Exit(1)
+		}
 		if err = (&topology.SchemaReplication{}).SetupWebhookWithManager(mgr); err != nil {
 			log.Error(err, "unable to create webhook", "webhook", "SchemaReplication")
 			os.Exit(1)
@@ -291,6 +394,22 @@ func main() {
 			log.Error(err, "unable to create webhook", "webhook", "SuperStream")
 			os.Exit(1)
 		}
+		if err = (&topologyv1alpha1.DeadLetterTopology{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "DeadLetterTopology")
+			os.Exit(1)
+		}
+		if err = (&topologyv1alpha1.RetryTopology{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "RetryTopology")
+			os.Exit(1)
+		}
+		if err = (&topologyv1alpha1.MessagingApplication{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "MessagingApplication")
+			os.Exit(1)
+		}
+		if err = (&topologyv1alpha1.SuperStreamConsumer{}).SetupWebhookWithManager(mgr); err != nil {
+			log.Error(err, "unable to create webhook", "webhook", "SuperStreamConsumer")
+			os.Exit(1)
+		}
 	}
 	// +kubebuilder:scaffold:builder
 
@@ -0,0 +1,188 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	scheme "github.com/rabbitmq/messaging-topology-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DeadLetterTopologiesGetter has a method to return a DeadLetterTopologyInterface.
+// A group's client should implement this interface.
+type DeadLetterTopologiesGetter interface {
+	DeadLetterTopologies(namespace string) DeadLetterTopologyInterface
+}
+
+// DeadLetterTopologyInterface has methods to work with DeadLetterTopology resources.
+type DeadLetterTopologyInterface interface {
+	Create(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.CreateOptions) (*v1alpha1.DeadLetterTopology, error)
+	Update(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (*v1alpha1.DeadLetterTopology, error)
+	UpdateStatus(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (*v1alpha1.DeadLetterTopology, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.DeadLetterTopology, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DeadLetterTopologyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeadLetterTopology, err error)
+	DeadLetterTopologyExpansion
+}
+
+// deadLetterTopologies implements DeadLetterTopologyInterface
+type deadLetterTopologies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDeadLetterTopologies returns a DeadLetterTopologies
+func newDeadLetterTopologies(c *RabbitmqV1alpha1Client, namespace string) *deadLetterTopologies {
+	return &deadLetterTopologies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the deadLetterTopology, and returns the corresponding deadLetterTopology object, and an error if there is any.
+func (c *deadLetterTopologies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	result = &v1alpha1.DeadLetterTopology{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DeadLetterTopologies that match those selectors.
+func (c *deadLetterTopologies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeadLetterTopologyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DeadLetterTopologyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested deadLetterTopologies.
+func (c *deadLetterTopologies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a deadLetterTopology and creates it.  Returns the server's representation of the deadLetterTopology, and an error, if there is any.
+func (c *deadLetterTopologies) Create(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.CreateOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	result = &v1alpha1.DeadLetterTopology{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deadLetterTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a deadLetterTopology and updates it. Returns the server's representation of the deadLetterTopology, and an error, if there is any.
+func (c *deadLetterTopologies) Update(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	result = &v1alpha1.DeadLetterTopology{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		Name(deadLetterTopology.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deadLetterTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *deadLetterTopologies) UpdateStatus(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	result = &v1alpha1.DeadLetterTopology{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		Name(deadLetterTopology.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deadLetterTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the deadLetterTopology and deletes it. Returns an error if one occurs.
+func (c *deadLetterTopologies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *deadLetterTopologies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched deadLetterTopology.
+func (c *deadLetterTopologies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeadLetterTopology, err error) {
+	result = &v1alpha1.DeadLetterTopology{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("deadlettertopologies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDeadLetterTopologies implements DeadLetterTopologyInterface
+type FakeDeadLetterTopologies struct {
+	Fake *FakeRabbitmqV1alpha1
+	ns   string
+}
+
+var deadlettertopologiesResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1alpha1", Resource: "deadlettertopologies"}
+
+var deadlettertopologiesKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1alpha1", Kind: "DeadLetterTopology"}
+
+// Get takes name of the deadLetterTopology, and returns the corresponding deadLetterTopology object, and an error if there is any.
+func (c *FakeDeadLetterTopologies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(deadlettertopologiesResource, c.ns, name), &v1alpha1.DeadLetterTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), err
+}
+
+// List takes label and field selectors, and returns the list of DeadLetterTopologies that match those selectors.
+func (c *FakeDeadLetterTopologies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeadLetterTopologyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(deadlettertopologiesResource, deadlettertopologiesKind, c.ns, opts), &v1alpha1.DeadLetterTopologyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DeadLetterTopologyList{ListMeta: obj.(*v1alpha1.DeadLetterTopologyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DeadLetterTopologyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested deadLetterTopologies.
+func (c *FakeDeadLetterTopologies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(deadlettertopologiesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a deadLetterTopology and creates it.  Returns the server's representation of the deadLetterTopology, and an error, if there is any.
+func (c *FakeDeadLetterTopologies) Create(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.CreateOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(deadlettertopologiesResource, c.ns, deadLetterTopology), &v1alpha1.DeadLetterTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), err
+}
+
+// Update takes the representation of a deadLetterTopology and updates it. Returns the server's representation of the deadLetterTopology, and an error, if there is any.
+func (c *FakeDeadLetterTopologies) Update(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (result *v1alpha1.DeadLetterTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(deadlettertopologiesResource, c.ns, deadLetterTopology), &v1alpha1.DeadLetterTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDeadLetterTopologies) UpdateStatus(ctx context.Context, deadLetterTopology *v1alpha1.DeadLetterTopology, opts v1.UpdateOptions) (*v1alpha1.DeadLetterTopology, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(deadlettertopologiesResource, "status", c.ns, deadLetterTopology), &v1alpha1.DeadLetterTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), err
+}
+
+// Delete takes name of the deadLetterTopology and deletes it. Returns an error if one occurs.
+func (c *FakeDeadLetterTopologies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(deadlettertopologiesResource, c.ns, name, opts), &v1alpha1.DeadLetterTopology{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDeadLetterTopologies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(deadlettertopologiesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DeadLetterTopologyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched deadLetterTopology.
+func (c *FakeDeadLetterTopologies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DeadLetterTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(deadlettertopologiesResource, c.ns, name, pt, data, subresources...), &v1alpha1.DeadLetterTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), err
+}
@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeMessagingApplications implements MessagingApplicationInterface
+type FakeMessagingApplications struct {
+	Fake *FakeRabbitmqV1alpha1
+	ns   string
+}
+
+var messagingapplicationsResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1alpha1", Resource: "messagingapplications"}
+
+var messagingapplicationsKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1alpha1", Kind: "MessagingApplication"}
+
+// Get takes name of the messagingApplication, and returns the corresponding messagingApplication object, and an error if there is any.
+func (c *FakeMessagingApplications) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.MessagingApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(messagingapplicationsResource, c.ns, name), &v1alpha1.MessagingApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.MessagingApplication), err
+}
+
+// List takes label and field selectors, and returns the list of MessagingApplications that match those selectors.
+func (c *FakeMessagingApplications) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.MessagingApplicationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(messagingapplicationsResource, messagingapplicationsKind, c.ns, opts), &v1alpha1.MessagingApplicationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.MessagingApplicationList{ListMeta: obj.(*v1alpha1.MessagingApplicationList).ListMeta}
+	for _, item := range obj.(*v1alpha1.MessagingApplicationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested messagingApplications.
+func (c *FakeMessagingApplications) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(messagingapplicationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a messagingApplication and creates it.  Returns the server's representation of the messagingApplication, and an error, if there is any.
+func (c *FakeMessagingApplications) Create(ctx context.Context, messagingApplication *v1alpha1.MessagingApplication, opts v1.CreateOptions) (result *v1alpha1.MessagingApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(messagingapplicationsResource, c.ns, messagingApplication), &v1alpha1.MessagingApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.MessagingApplication), err
+}
+
+// Update takes the representation of a messagingApplication and updates it. Returns the server's representation of the messagingApplication, and an error, if there is any.
+func (c *FakeMessagingApplications) Update(ctx context.Context, messagingApplication *v1alpha1.MessagingApplication, opts v1.UpdateOptions) (result *v1alpha1.MessagingApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(messagingapplicationsResource, c.ns, messagingApplication), &v1alpha1.MessagingApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.MessagingApplication), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeMessagingApplications) UpdateStatus(ctx context.Context, messagingApplication *v1alpha1.MessagingApplication, opts v1.UpdateOptions) (*v1alpha1.MessagingApplication, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(messagingapplicationsResource, "status", c.ns, messagingApplication), &v1alpha1.MessagingApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.MessagingApplication), err
+}
+
+// Delete takes name of the messagingApplication and deletes it. Returns an error if one occurs.
+func (c *FakeMessagingApplications) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(messagingapplicationsResource, c.ns, name, opts), &v1alpha1.MessagingApplication{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeMessagingApplications) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(messagingapplicationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.MessagingApplicationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched messagingApplication.
+func (c *FakeMessagingApplications) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.MessagingApplication, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(messagingapplicationsResource, c.ns, name, pt, data, subresources...), &v1alpha1.MessagingApplication{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.MessagingApplication), err
+}
@@ -21,10 +21,26 @@ type FakeRabbitmqV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeRabbitmqV1alpha1) DeadLetterTopologies(namespace string) v1alpha1.DeadLetterTopologyInterface {
+	return &FakeDeadLetterTopologies{c, namespace}
+}
+
+func (c *FakeRabbitmqV1alpha1) MessagingApplications(namespace string) v1alpha1.MessagingApplicationInterface {
+	return &FakeMessagingApplications{c, namespace}
+}
+
+func (c *FakeRabbitmqV1alpha1) RetryTopologies(namespace string) v1alpha1.RetryTopologyInterface {
+	return &FakeRetryTopologies{c, namespace}
+}
+
 func (c *FakeRabbitmqV1alpha1) SuperStreams(namespace string) v1alpha1.SuperStreamInterface {
 	return &FakeSuperStreams{c, namespace}
 }
 
+func (c *FakeRabbitmqV1alpha1) SuperStreamConsumers(namespace string) v1alpha1.SuperStreamConsumerInterface {
+	return &FakeSuperStreamConsumers{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeRabbitmqV1alpha1) RESTClient() rest.Interface {
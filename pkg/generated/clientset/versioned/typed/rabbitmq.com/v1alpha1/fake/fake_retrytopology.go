@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRetryTopologies implements RetryTopologyInterface
+type FakeRetryTopologies struct {
+	Fake *FakeRabbitmqV1alpha1
+	ns   string
+}
+
+var retrytopologiesResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1alpha1", Resource: "retrytopologies"}
+
+var retrytopologiesKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1alpha1", Kind: "RetryTopology"}
+
+// Get takes name of the retryTopology, and returns the corresponding retryTopology object, and an error if there is any.
+func (c *FakeRetryTopologies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RetryTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(retrytopologiesResource, c.ns, name), &v1alpha1.RetryTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RetryTopology), err
+}
+
+// List takes label and field selectors, and returns the list of RetryTopologies that match those selectors.
+func (c *FakeRetryTopologies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RetryTopologyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(retrytopologiesResource, retrytopologiesKind, c.ns, opts), &v1alpha1.RetryTopologyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RetryTopologyList{ListMeta: obj.(*v1alpha1.RetryTopologyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RetryTopologyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested retryTopologies.
+func (c *FakeRetryTopologies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(retrytopologiesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a retryTopology and creates it.  Returns the server's representation of the retryTopology, and an error, if there is any.
+func (c *FakeRetryTopologies) Create(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.CreateOptions) (result *v1alpha1.RetryTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(retrytopologiesResource, c.ns, retryTopology), &v1alpha1.RetryTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RetryTopology), err
+}
+
+// Update takes the representation of a retryTopology and updates it. Returns the server's representation of the retryTopology, and an error, if there is any.
+func (c *FakeRetryTopologies) Update(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (result *v1alpha1.RetryTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(retrytopologiesResource, c.ns, retryTopology), &v1alpha1.RetryTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RetryTopology), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRetryTopologies) UpdateStatus(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (*v1alpha1.RetryTopology, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(retrytopologiesResource, "status", c.ns, retryTopology), &v1alpha1.RetryTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RetryTopology), err
+}
+
+// Delete takes name of the retryTopology and deletes it. Returns an error if one occurs.
+func (c *FakeRetryTopologies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(retrytopologiesResource, c.ns, name, opts), &v1alpha1.RetryTopology{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRetryTopologies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(retrytopologiesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RetryTopologyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched retryTopology.
+func (c *FakeRetryTopologies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RetryTopology, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(retrytopologiesResource, c.ns, name, pt, data, subresources...), &v1alpha1.RetryTopology{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RetryTopology), err
+}
@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSuperStreamConsumers implements SuperStreamConsumerInterface
+type FakeSuperStreamConsumers struct {
+	Fake *FakeRabbitmqV1alpha1
+	ns   string
+}
+
+var superstreamconsumersResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1alpha1", Resource: "superstreamconsumers"}
+
+var superstreamconsumersKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1alpha1", Kind: "SuperStreamConsumer"}
+
+// Get takes name of the superStreamConsumer, and returns the corresponding superStreamConsumer object, and an error if there is any.
+func (c *FakeSuperStreamConsumers) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.SuperStreamConsumer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(superstreamconsumersResource, c.ns, name), &v1alpha1.SuperStreamConsumer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SuperStreamConsumer), err
+}
+
+// List takes label and field selectors, and returns the list of SuperStreamConsumers that match those selectors.
+func (c *FakeSuperStreamConsumers) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.SuperStreamConsumerList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(superstreamconsumersResource, superstreamconsumersKind, c.ns, opts), &v1alpha1.SuperStreamConsumerList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.SuperStreamConsumerList{ListMeta: obj.(*v1alpha1.SuperStreamConsumerList).ListMeta}
+	for _, item := range obj.(*v1alpha1.SuperStreamConsumerList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested superStreamConsumers.
+func (c *FakeSuperStreamConsumers) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(superstreamconsumersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a superStreamConsumer and creates it.  Returns the server's representation of the superStreamConsumer, and an error, if there is any.
+func (c *FakeSuperStreamConsumers) Create(ctx context.Context, superStreamConsumer *v1alpha1.SuperStreamConsumer, opts v1.CreateOptions) (result *v1alpha1.SuperStreamConsumer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(superstreamconsumersResource, c.ns, superStreamConsumer), &v1alpha1.SuperStreamConsumer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SuperStreamConsumer), err
+}
+
+// Update takes the representation of a superStreamConsumer and updates it. Returns the server's representation of the superStreamConsumer, and an error, if there is any.
+func (c *FakeSuperStreamConsumers) Update(ctx context.Context, superStreamConsumer *v1alpha1.SuperStreamConsumer, opts v1.UpdateOptions) (result *v1alpha1.SuperStreamConsumer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(superstreamconsumersResource, c.ns, superStreamConsumer), &v1alpha1.SuperStreamConsumer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SuperStreamConsumer), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSuperStreamConsumers) UpdateStatus(ctx context.Context, superStreamConsumer *v1alpha1.SuperStreamConsumer, opts v1.UpdateOptions) (*v1alpha1.SuperStreamConsumer, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(superstreamconsumersResource, "status", c.ns, superStreamConsumer), &v1alpha1.SuperStreamConsumer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SuperStreamConsumer), err
+}
+
+// Delete takes name of the superStreamConsumer and deletes it. Returns an error if one occurs.
+func (c *FakeSuperStreamConsumers) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(superstreamconsumersResource, c.ns, name, opts), &v1alpha1.SuperStreamConsumer{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSuperStreamConsumers) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(superstreamconsumersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.SuperStreamConsumerList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched superStreamConsumer.
+func (c *FakeSuperStreamConsumers) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.SuperStreamConsumer, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(superstreamconsumersResource, c.ns, name, pt, data, subresources...), &v1alpha1.SuperStreamConsumer{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SuperStreamConsumer), err
+}
@@ -11,4 +11,12 @@ This product may include a number of subcomponents with separate copyright notic
 
 package v1alpha1
 
+type DeadLetterTopologyExpansion interface{}
+
+type MessagingApplicationExpansion interface{}
+
+type RetryTopologyExpansion interface{}
+
 type SuperStreamExpansion interface{}
+
+type SuperStreamConsumerExpansion interface{}
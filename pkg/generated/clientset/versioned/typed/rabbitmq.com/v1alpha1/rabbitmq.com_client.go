@@ -21,7 +21,11 @@ import (
 
 type RabbitmqV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	DeadLetterTopologiesGetter
+	MessagingApplicationsGetter
+	RetryTopologiesGetter
 	SuperStreamsGetter
+	SuperStreamConsumersGetter
 }
 
 // RabbitmqV1alpha1Client is used to interact with features provided by the rabbitmq.com group.
@@ -29,10 +33,26 @@ type RabbitmqV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *RabbitmqV1alpha1Client) DeadLetterTopologies(namespace string) DeadLetterTopologyInterface {
+	return newDeadLetterTopologies(c, namespace)
+}
+
+func (c *RabbitmqV1alpha1Client) MessagingApplications(namespace string) MessagingApplicationInterface {
+	return newMessagingApplications(c, namespace)
+}
+
+func (c *RabbitmqV1alpha1Client) RetryTopologies(namespace string) RetryTopologyInterface {
+	return newRetryTopologies(c, namespace)
+}
+
 func (c *RabbitmqV1alpha1Client) SuperStreams(namespace string) SuperStreamInterface {
 	return newSuperStreams(c, namespace)
 }
 
+func (c *RabbitmqV1alpha1Client) SuperStreamConsumers(namespace string) SuperStreamConsumerInterface {
+	return newSuperStreamConsumers(c, namespace)
+}
+
 // NewForConfig creates a new RabbitmqV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -0,0 +1,188 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	scheme "github.com/rabbitmq/messaging-topology-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RetryTopologiesGetter has a method to return a RetryTopologyInterface.
+// A group's client should implement this interface.
+type RetryTopologiesGetter interface {
+	RetryTopologies(namespace string) RetryTopologyInterface
+}
+
+// RetryTopologyInterface has methods to work with RetryTopology resources.
+type RetryTopologyInterface interface {
+	Create(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.CreateOptions) (*v1alpha1.RetryTopology, error)
+	Update(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (*v1alpha1.RetryTopology, error)
+	UpdateStatus(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (*v1alpha1.RetryTopology, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.RetryTopology, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.RetryTopologyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RetryTopology, err error)
+	RetryTopologyExpansion
+}
+
+// retryTopologies implements RetryTopologyInterface
+type retryTopologies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRetryTopologies returns a RetryTopologies
+func newRetryTopologies(c *RabbitmqV1alpha1Client, namespace string) *retryTopologies {
+	return &retryTopologies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the retryTopology, and returns the corresponding retryTopology object, and an error if there is any.
+func (c *retryTopologies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RetryTopology, err error) {
+	result = &v1alpha1.RetryTopology{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RetryTopologies that match those selectors.
+func (c *retryTopologies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RetryTopologyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.RetryTopologyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested retryTopologies.
+func (c *retryTopologies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a retryTopology and creates it.  Returns the server's representation of the retryTopology, and an error, if there is any.
+func (c *retryTopologies) Create(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.CreateOptions) (result *v1alpha1.RetryTopology, err error) {
+	result = &v1alpha1.RetryTopology{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(retryTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a retryTopology and updates it. Returns the server's representation of the retryTopology, and an error, if there is any.
+func (c *retryTopologies) Update(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (result *v1alpha1.RetryTopology, err error) {
+	result = &v1alpha1.RetryTopology{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		Name(retryTopology.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(retryTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *retryTopologies) UpdateStatus(ctx context.Context, retryTopology *v1alpha1.RetryTopology, opts v1.UpdateOptions) (result *v1alpha1.RetryTopology, err error) {
+	result = &v1alpha1.RetryTopology{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		Name(retryTopology.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(retryTopology).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the retryTopology and deletes it. Returns an error if one occurs.
+func (c *retryTopologies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *retryTopologies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched retryTopology.
+func (c *retryTopologies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RetryTopology, err error) {
+	result = &v1alpha1.RetryTopology{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("retrytopologies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
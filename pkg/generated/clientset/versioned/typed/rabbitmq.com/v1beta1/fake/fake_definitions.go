@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDefinitionses implements DefinitionsInterface
+type FakeDefinitionses struct {
+	Fake *FakeRabbitmqV1beta1
+	ns   string
+}
+
+var definitionsesResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1beta1", Resource: "definitions"}
+
+var definitionsesKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1beta1", Kind: "Definitions"}
+
+// Get takes name of the definitions, and returns the corresponding definitions object, and an error if there is any.
+func (c *FakeDefinitionses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Definitions, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(definitionsesResource, c.ns, name), &v1beta1.Definitions{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Definitions), err
+}
+
+// List takes label and field selectors, and returns the list of Definitionses that match those selectors.
+func (c *FakeDefinitionses) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.DefinitionsList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(definitionsesResource, definitionsesKind, c.ns, opts), &v1beta1.DefinitionsList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.DefinitionsList{ListMeta: obj.(*v1beta1.DefinitionsList).ListMeta}
+	for _, item := range obj.(*v1beta1.DefinitionsList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested definitionses.
+func (c *FakeDefinitionses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(definitionsesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a definitions and creates it.  Returns the server's representation of the definitions, and an error, if there is any.
+func (c *FakeDefinitionses) Create(ctx context.Context, definitions *v1beta1.Definitions, opts v1.CreateOptions) (result *v1beta1.Definitions, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(definitionsesResource, c.ns, definitions), &v1beta1.Definitions{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Definitions), err
+}
+
+// Update takes the representation of a definitions and updates it. Returns the server's representation of the definitions, and an error, if there is any.
+func (c *FakeDefinitionses) Update(ctx context.Context, definitions *v1beta1.Definitions, opts v1.UpdateOptions) (result *v1beta1.Definitions, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(definitionsesResource, c.ns, definitions), &v1beta1.Definitions{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Definitions), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDefinitionses) UpdateStatus(ctx context.Context, definitions *v1beta1.Definitions, opts v1.UpdateOptions) (*v1beta1.Definitions, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(definitionsesResource, "status", c.ns, definitions), &v1beta1.Definitions{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Definitions), err
+}
+
+// Delete takes name of the definitions and deletes it. Returns an error if one occurs.
+func (c *FakeDefinitionses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(definitionsesResource, c.ns, name, opts), &v1beta1.Definitions{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDefinitionses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(definitionsesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.DefinitionsList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched definitions.
+func (c *FakeDefinitionses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Definitions, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(definitionsesResource, c.ns, name, pt, data, subresources...), &v1beta1.Definitions{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Definitions), err
+}
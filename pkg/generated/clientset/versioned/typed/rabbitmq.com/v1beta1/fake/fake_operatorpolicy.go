@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeOperatorPolicies implements OperatorPolicyInterface
+type FakeOperatorPolicies struct {
+	Fake *FakeRabbitmqV1beta1
+	ns   string
+}
+
+var operatorpoliciesResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1beta1", Resource: "operatorpolicies"}
+
+var operatorpoliciesKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1beta1", Kind: "OperatorPolicy"}
+
+// Get takes name of the operatorPolicy, and returns the corresponding operatorPolicy object, and an error if there is any.
+func (c *FakeOperatorPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.OperatorPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(operatorpoliciesResource, c.ns, name), &v1beta1.OperatorPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OperatorPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of OperatorPolicies that match those selectors.
+func (c *FakeOperatorPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.OperatorPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(operatorpoliciesResource, operatorpoliciesKind, c.ns, opts), &v1beta1.OperatorPolicyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.OperatorPolicyList{ListMeta: obj.(*v1beta1.OperatorPolicyList).ListMeta}
+	for _, item := range obj.(*v1beta1.OperatorPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested operatorPolicies.
+func (c *FakeOperatorPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(operatorpoliciesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a operatorPolicy and creates it.  Returns the server's representation of the operatorPolicy, and an error, if there is any.
+func (c *FakeOperatorPolicies) Create(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.CreateOptions) (result *v1beta1.OperatorPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(operatorpoliciesResource, c.ns, operatorPolicy), &v1beta1.OperatorPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OperatorPolicy), err
+}
+
+// Update takes the representation of a operatorPolicy and updates it. Returns the server's representation of the operatorPolicy, and an error, if there is any.
+func (c *FakeOperatorPolicies) Update(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (result *v1beta1.OperatorPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(operatorpoliciesResource, c.ns, operatorPolicy), &v1beta1.OperatorPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OperatorPolicy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeOperatorPolicies) UpdateStatus(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (*v1beta1.OperatorPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(operatorpoliciesResource, "status", c.ns, operatorPolicy), &v1beta1.OperatorPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OperatorPolicy), err
+}
+
+// Delete takes name of the operatorPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeOperatorPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(operatorpoliciesResource, c.ns, name, opts), &v1beta1.OperatorPolicy{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeOperatorPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(operatorpoliciesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.OperatorPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched operatorPolicy.
+func (c *FakeOperatorPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.OperatorPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(operatorpoliciesResource, c.ns, name, pt, data, subresources...), &v1beta1.OperatorPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OperatorPolicy), err
+}
@@ -25,6 +25,10 @@ func (c *FakeRabbitmqV1beta1) Bindings(namespace string) v1beta1.BindingInterfac
 	return &FakeBindings{c, namespace}
 }
 
+func (c *FakeRabbitmqV1beta1) Definitionses(namespace string) v1beta1.DefinitionsInterface {
+	return &FakeDefinitionses{c, namespace}
+}
+
 func (c *FakeRabbitmqV1beta1) Exchanges(namespace string) v1beta1.ExchangeInterface {
 	return &FakeExchanges{c, namespace}
 }
@@ -33,6 +37,10 @@ func (c *FakeRabbitmqV1beta1) Federations(namespace string) v1beta1.FederationIn
 	return &FakeFederations{c, namespace}
 }
 
+func (c *FakeRabbitmqV1beta1) OperatorPolicies(namespace string) v1beta1.OperatorPolicyInterface {
+	return &FakeOperatorPolicies{c, namespace}
+}
+
 func (c *FakeRabbitmqV1beta1) Permissions(namespace string) v1beta1.PermissionInterface {
 	return &FakePermissions{c, namespace}
 }
@@ -45,6 +53,10 @@ func (c *FakeRabbitmqV1beta1) Queues(namespace string) v1beta1.QueueInterface {
 	return &FakeQueues{c, namespace}
 }
 
+func (c *FakeRabbitmqV1beta1) RuntimeParameters(namespace string) v1beta1.RuntimeParameterInterface {
+	return &FakeRuntimeParameters{c, namespace}
+}
+
 func (c *FakeRabbitmqV1beta1) SchemaReplications(namespace string) v1beta1.SchemaReplicationInterface {
 	return &FakeSchemaReplications{c, namespace}
 }
@@ -53,6 +65,10 @@ func (c *FakeRabbitmqV1beta1) Shovels(namespace string) v1beta1.ShovelInterface
 	return &FakeShovels{c, namespace}
 }
 
+func (c *FakeRabbitmqV1beta1) TopicPermissions(namespace string) v1beta1.TopicPermissionInterface {
+	return &FakeTopicPermissions{c, namespace}
+}
+
 func (c *FakeRabbitmqV1beta1) Users(namespace string) v1beta1.UserInterface {
 	return &FakeUsers{c, namespace}
 }
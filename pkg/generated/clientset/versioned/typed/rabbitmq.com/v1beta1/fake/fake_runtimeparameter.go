@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRuntimeParameters implements RuntimeParameterInterface
+type FakeRuntimeParameters struct {
+	Fake *FakeRabbitmqV1beta1
+	ns   string
+}
+
+var runtimeparametersResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1beta1", Resource: "runtimeparameters"}
+
+var runtimeparametersKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1beta1", Kind: "RuntimeParameter"}
+
+// Get takes name of the runtimeParameter, and returns the corresponding runtimeParameter object, and an error if there is any.
+func (c *FakeRuntimeParameters) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.RuntimeParameter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(runtimeparametersResource, c.ns, name), &v1beta1.RuntimeParameter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RuntimeParameter), err
+}
+
+// List takes label and field selectors, and returns the list of RuntimeParameters that match those selectors.
+func (c *FakeRuntimeParameters) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.RuntimeParameterList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(runtimeparametersResource, runtimeparametersKind, c.ns, opts), &v1beta1.RuntimeParameterList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.RuntimeParameterList{ListMeta: obj.(*v1beta1.RuntimeParameterList).ListMeta}
+	for _, item := range obj.(*v1beta1.RuntimeParameterList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested runtimeParameters.
+func (c *FakeRuntimeParameters) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(runtimeparametersResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a runtimeParameter and creates it.  Returns the server's representation of the runtimeParameter, and an error, if there is any.
+func (c *FakeRuntimeParameters) Create(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.CreateOptions) (result *v1beta1.RuntimeParameter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(runtimeparametersResource, c.ns, runtimeParameter), &v1beta1.RuntimeParameter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RuntimeParameter), err
+}
+
+// Update takes the representation of a runtimeParameter and updates it. Returns the server's representation of the runtimeParameter, and an error, if there is any.
+func (c *FakeRuntimeParameters) Update(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (result *v1beta1.RuntimeParameter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(runtimeparametersResource, c.ns, runtimeParameter), &v1beta1.RuntimeParameter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RuntimeParameter), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRuntimeParameters) UpdateStatus(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (*v1beta1.RuntimeParameter, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(runtimeparametersResource, "status", c.ns, runtimeParameter), &v1beta1.RuntimeParameter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RuntimeParameter), err
+}
+
+// Delete takes name of the runtimeParameter and deletes it. Returns an error if one occurs.
+func (c *FakeRuntimeParameters) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(runtimeparametersResource, c.ns, name, opts), &v1beta1.RuntimeParameter{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRuntimeParameters) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(runtimeparametersResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.RuntimeParameterList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched runtimeParameter.
+func (c *FakeRuntimeParameters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RuntimeParameter, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(runtimeparametersResource, c.ns, name, pt, data, subresources...), &v1beta1.RuntimeParameter{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.RuntimeParameter), err
+}
@@ -0,0 +1,135 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTopicPermissions implements TopicPermissionInterface
+type FakeTopicPermissions struct {
+	Fake *FakeRabbitmqV1beta1
+	ns   string
+}
+
+var topicpermissionsResource = schema.GroupVersionResource{Group: "rabbitmq.com", Version: "v1beta1", Resource: "topicpermissions"}
+
+var topicpermissionsKind = schema.GroupVersionKind{Group: "rabbitmq.com", Version: "v1beta1", Kind: "TopicPermission"}
+
+// Get takes name of the topicPermission, and returns the corresponding topicPermission object, and an error if there is any.
+func (c *FakeTopicPermissions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.TopicPermission, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(topicpermissionsResource, c.ns, name), &v1beta1.TopicPermission{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.TopicPermission), err
+}
+
+// List takes label and field selectors, and returns the list of TopicPermissions that match those selectors.
+func (c *FakeTopicPermissions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.TopicPermissionList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(topicpermissionsResource, topicpermissionsKind, c.ns, opts), &v1beta1.TopicPermissionList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.TopicPermissionList{ListMeta: obj.(*v1beta1.TopicPermissionList).ListMeta}
+	for _, item := range obj.(*v1beta1.TopicPermissionList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested topicPermissions.
+func (c *FakeTopicPermissions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(topicpermissionsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a topicPermission and creates it.  Returns the server's representation of the topicPermission, and an error, if there is any.
+func (c *FakeTopicPermissions) Create(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.CreateOptions) (result *v1beta1.TopicPermission, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(topicpermissionsResource, c.ns, topicPermission), &v1beta1.TopicPermission{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.TopicPermission), err
+}
+
+// Update takes the representation of a topicPermission and updates it. Returns the server's representation of the topicPermission, and an error, if there is any.
+func (c *FakeTopicPermissions) Update(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (result *v1beta1.TopicPermission, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(topicpermissionsResource, c.ns, topicPermission), &v1beta1.TopicPermission{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.TopicPermission), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTopicPermissions) UpdateStatus(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (*v1beta1.TopicPermission, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(topicpermissionsResource, "status", c.ns, topicPermission), &v1beta1.TopicPermission{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.TopicPermission), err
+}
+
+// Delete takes name of the topicPermission and deletes it. Returns an error if one occurs.
+func (c *FakeTopicPermissions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(topicpermissionsResource, c.ns, name, opts), &v1beta1.TopicPermission{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTopicPermissions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(topicpermissionsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.TopicPermissionList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched topicPermission.
+func (c *FakeTopicPermissions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.TopicPermission, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(topicpermissionsResource, c.ns, name, pt, data, subresources...), &v1beta1.TopicPermission{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.TopicPermission), err
+}
@@ -13,20 +13,28 @@ package v1beta1
 
 type BindingExpansion interface{}
 
+type DefinitionsExpansion interface{}
+
 type ExchangeExpansion interface{}
 
 type FederationExpansion interface{}
 
+type OperatorPolicyExpansion interface{}
+
 type PermissionExpansion interface{}
 
 type PolicyExpansion interface{}
 
 type QueueExpansion interface{}
 
+type RuntimeParameterExpansion interface{}
+
 type SchemaReplicationExpansion interface{}
 
 type ShovelExpansion interface{}
 
+type TopicPermissionExpansion interface{}
+
 type UserExpansion interface{}
 
 type VhostExpansion interface{}
@@ -0,0 +1,188 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	scheme "github.com/rabbitmq/messaging-topology-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// OperatorPoliciesGetter has a method to return a OperatorPolicyInterface.
+// A group's client should implement this interface.
+type OperatorPoliciesGetter interface {
+	OperatorPolicies(namespace string) OperatorPolicyInterface
+}
+
+// OperatorPolicyInterface has methods to work with OperatorPolicy resources.
+type OperatorPolicyInterface interface {
+	Create(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.CreateOptions) (*v1beta1.OperatorPolicy, error)
+	Update(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (*v1beta1.OperatorPolicy, error)
+	UpdateStatus(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (*v1beta1.OperatorPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.OperatorPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.OperatorPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.OperatorPolicy, err error)
+	OperatorPolicyExpansion
+}
+
+// operatorPolicies implements OperatorPolicyInterface
+type operatorPolicies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newOperatorPolicies returns a OperatorPolicies
+func newOperatorPolicies(c *RabbitmqV1beta1Client, namespace string) *operatorPolicies {
+	return &operatorPolicies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the operatorPolicy, and returns the corresponding operatorPolicy object, and an error if there is any.
+func (c *operatorPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.OperatorPolicy, err error) {
+	result = &v1beta1.OperatorPolicy{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of OperatorPolicies that match those selectors.
+func (c *operatorPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.OperatorPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.OperatorPolicyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested operatorPolicies.
+func (c *operatorPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a operatorPolicy and creates it.  Returns the server's representation of the operatorPolicy, and an error, if there is any.
+func (c *operatorPolicies) Create(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.CreateOptions) (result *v1beta1.OperatorPolicy, err error) {
+	result = &v1beta1.OperatorPolicy{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(operatorPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a operatorPolicy and updates it. Returns the server's representation of the operatorPolicy, and an error, if there is any.
+func (c *operatorPolicies) Update(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (result *v1beta1.OperatorPolicy, err error) {
+	result = &v1beta1.OperatorPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		Name(operatorPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(operatorPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *operatorPolicies) UpdateStatus(ctx context.Context, operatorPolicy *v1beta1.OperatorPolicy, opts v1.UpdateOptions) (result *v1beta1.OperatorPolicy, err error) {
+	result = &v1beta1.OperatorPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		Name(operatorPolicy.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(operatorPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the operatorPolicy and deletes it. Returns an error if one occurs.
+func (c *operatorPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *operatorPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched operatorPolicy.
+func (c *operatorPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.OperatorPolicy, err error) {
+	result = &v1beta1.OperatorPolicy{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("operatorpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -22,13 +22,17 @@ import (
 type RabbitmqV1beta1Interface interface {
 	RESTClient() rest.Interface
 	BindingsGetter
+	DefinitionsesGetter
 	ExchangesGetter
 	FederationsGetter
+	OperatorPoliciesGetter
 	PermissionsGetter
 	PoliciesGetter
 	QueuesGetter
+	RuntimeParametersGetter
 	SchemaReplicationsGetter
 	ShovelsGetter
+	TopicPermissionsGetter
 	UsersGetter
 	VhostsGetter
 }
@@ -42,6 +46,10 @@ func (c *RabbitmqV1beta1Client) Bindings(namespace string) BindingInterface {
 	return newBindings(c, namespace)
 }
 
+func (c *RabbitmqV1beta1Client) Definitionses(namespace string) DefinitionsInterface {
+	return newDefinitionses(c, namespace)
+}
+
 func (c *RabbitmqV1beta1Client) Exchanges(namespace string) ExchangeInterface {
 	return newExchanges(c, namespace)
 }
@@ -50,6 +58,10 @@ func (c *RabbitmqV1beta1Client) Federations(namespace string) FederationInterfac
 	return newFederations(c, namespace)
 }
 
+func (c *RabbitmqV1beta1Client) OperatorPolicies(namespace string) OperatorPolicyInterface {
+	return newOperatorPolicies(c, namespace)
+}
+
 func (c *RabbitmqV1beta1Client) Permissions(namespace string) PermissionInterface {
 	return newPermissions(c, namespace)
 }
@@ -62,6 +74,10 @@ func (c *RabbitmqV1beta1Client) Queues(namespace string) QueueInterface {
 	return newQueues(c, namespace)
 }
 
+func (c *RabbitmqV1beta1Client) RuntimeParameters(namespace string) RuntimeParameterInterface {
+	return newRuntimeParameters(c, namespace)
+}
+
 func (c *RabbitmqV1beta1Client) SchemaReplications(namespace string) SchemaReplicationInterface {
 	return newSchemaReplications(c, namespace)
 }
@@ -70,6 +86,10 @@ func (c *RabbitmqV1beta1Client) Shovels(namespace string) ShovelInterface {
 	return newShovels(c, namespace)
 }
 
+func (c *RabbitmqV1beta1Client) TopicPermissions(namespace string) TopicPermissionInterface {
+	return newTopicPermissions(c, namespace)
+}
+
 func (c *RabbitmqV1beta1Client) Users(namespace string) UserInterface {
 	return newUsers(c, namespace)
 }
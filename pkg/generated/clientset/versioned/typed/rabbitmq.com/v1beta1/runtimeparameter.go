@@ -0,0 +1,188 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	scheme "github.com/rabbitmq/messaging-topology-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RuntimeParametersGetter has a method to return a RuntimeParameterInterface.
+// A group's client should implement this interface.
+type RuntimeParametersGetter interface {
+	RuntimeParameters(namespace string) RuntimeParameterInterface
+}
+
+// RuntimeParameterInterface has methods to work with RuntimeParameter resources.
+type RuntimeParameterInterface interface {
+	Create(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.CreateOptions) (*v1beta1.RuntimeParameter, error)
+	Update(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (*v1beta1.RuntimeParameter, error)
+	UpdateStatus(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (*v1beta1.RuntimeParameter, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.RuntimeParameter, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.RuntimeParameterList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RuntimeParameter, err error)
+	RuntimeParameterExpansion
+}
+
+// runtimeParameters implements RuntimeParameterInterface
+type runtimeParameters struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRuntimeParameters returns a RuntimeParameters
+func newRuntimeParameters(c *RabbitmqV1beta1Client, namespace string) *runtimeParameters {
+	return &runtimeParameters{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the runtimeParameter, and returns the corresponding runtimeParameter object, and an error if there is any.
+func (c *runtimeParameters) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.RuntimeParameter, err error) {
+	result = &v1beta1.RuntimeParameter{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RuntimeParameters that match those selectors.
+func (c *runtimeParameters) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.RuntimeParameterList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.RuntimeParameterList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested runtimeParameters.
+func (c *runtimeParameters) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a runtimeParameter and creates it.  Returns the server's representation of the runtimeParameter, and an error, if there is any.
+func (c *runtimeParameters) Create(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.CreateOptions) (result *v1beta1.RuntimeParameter, err error) {
+	result = &v1beta1.RuntimeParameter{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(runtimeParameter).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a runtimeParameter and updates it. Returns the server's representation of the runtimeParameter, and an error, if there is any.
+func (c *runtimeParameters) Update(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (result *v1beta1.RuntimeParameter, err error) {
+	result = &v1beta1.RuntimeParameter{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		Name(runtimeParameter.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(runtimeParameter).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *runtimeParameters) UpdateStatus(ctx context.Context, runtimeParameter *v1beta1.RuntimeParameter, opts v1.UpdateOptions) (result *v1beta1.RuntimeParameter, err error) {
+	result = &v1beta1.RuntimeParameter{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		Name(runtimeParameter.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(runtimeParameter).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the runtimeParameter and deletes it. Returns an error if one occurs.
+func (c *runtimeParameters) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *runtimeParameters) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched runtimeParameter.
+func (c *runtimeParameters) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.RuntimeParameter, err error) {
+	result = &v1beta1.RuntimeParameter{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("runtimeparameters").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
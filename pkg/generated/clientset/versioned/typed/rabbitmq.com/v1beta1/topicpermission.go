@@ -0,0 +1,188 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	scheme "github.com/rabbitmq/messaging-topology-operator/pkg/generated/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TopicPermissionsGetter has a method to return a TopicPermissionInterface.
+// A group's client should implement this interface.
+type TopicPermissionsGetter interface {
+	TopicPermissions(namespace string) TopicPermissionInterface
+}
+
+// TopicPermissionInterface has methods to work with TopicPermission resources.
+type TopicPermissionInterface interface {
+	Create(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.CreateOptions) (*v1beta1.TopicPermission, error)
+	Update(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (*v1beta1.TopicPermission, error)
+	UpdateStatus(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (*v1beta1.TopicPermission, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.TopicPermission, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.TopicPermissionList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.TopicPermission, err error)
+	TopicPermissionExpansion
+}
+
+// topicPermissions implements TopicPermissionInterface
+type topicPermissions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTopicPermissions returns a TopicPermissions
+func newTopicPermissions(c *RabbitmqV1beta1Client, namespace string) *topicPermissions {
+	return &topicPermissions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the topicPermission, and returns the corresponding topicPermission object, and an error if there is any.
+func (c *topicPermissions) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.TopicPermission, err error) {
+	result = &v1beta1.TopicPermission{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TopicPermissions that match those selectors.
+func (c *topicPermissions) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.TopicPermissionList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.TopicPermissionList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested topicPermissions.
+func (c *topicPermissions) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a topicPermission and creates it.  Returns the server's representation of the topicPermission, and an error, if there is any.
+func (c *topicPermissions) Create(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.CreateOptions) (result *v1beta1.TopicPermission, err error) {
+	result = &v1beta1.TopicPermission{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(topicPermission).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a topicPermission and updates it. Returns the server's representation of the topicPermission, and an error, if there is any.
+func (c *topicPermissions) Update(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (result *v1beta1.TopicPermission, err error) {
+	result = &v1beta1.TopicPermission{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		Name(topicPermission.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(topicPermission).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *topicPermissions) UpdateStatus(ctx context.Context, topicPermission *v1beta1.TopicPermission, opts v1.UpdateOptions) (result *v1beta1.TopicPermission, err error) {
+	result = &v1beta1.TopicPermission{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		Name(topicPermission.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(topicPermission).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the topicPermission and deletes it. Returns an error if one occurs.
+func (c *topicPermissions) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *topicPermissions) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched topicPermission.
+func (c *topicPermissions) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.TopicPermission, err error) {
+	result = &v1beta1.TopicPermission{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("topicpermissions").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -47,26 +47,42 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=rabbitmq.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("deadlettertopologies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1alpha1().DeadLetterTopologies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("messagingapplications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1alpha1().MessagingApplications().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("retrytopologies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1alpha1().RetryTopologies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("superstreams"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1alpha1().SuperStreams().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("superstreamconsumers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1alpha1().SuperStreamConsumers().Informer()}, nil
 
 		// Group=rabbitmq.com, Version=v1beta1
 	case v1beta1.SchemeGroupVersion.WithResource("bindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Bindings().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("definitions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Definitionses().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("exchanges"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Exchanges().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("federations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Federations().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("operatorpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().OperatorPolicies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("permissions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Permissions().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("policies"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Policies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("queues"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Queues().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("runtimeparameters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().RuntimeParameters().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("schemareplications"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().SchemaReplications().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shovels"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Shovels().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("topicpermissions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().TopicPermissions().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("users"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Rabbitmq().V1beta1().Users().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("vhosts"):
@@ -17,8 +17,16 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// DeadLetterTopologies returns a DeadLetterTopologyInformer.
+	DeadLetterTopologies() DeadLetterTopologyInformer
+	// MessagingApplications returns a MessagingApplicationInformer.
+	MessagingApplications() MessagingApplicationInformer
+	// RetryTopologies returns a RetryTopologyInformer.
+	RetryTopologies() RetryTopologyInformer
 	// SuperStreams returns a SuperStreamInformer.
 	SuperStreams() SuperStreamInformer
+	// SuperStreamConsumers returns a SuperStreamConsumerInformer.
+	SuperStreamConsumers() SuperStreamConsumerInformer
 }
 
 type version struct {
@@ -32,7 +40,27 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// DeadLetterTopologies returns a DeadLetterTopologyInformer.
+func (v *version) DeadLetterTopologies() DeadLetterTopologyInformer {
+	return &deadLetterTopologyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MessagingApplications returns a MessagingApplicationInformer.
+func (v *version) MessagingApplications() MessagingApplicationInformer {
+	return &messagingApplicationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// RetryTopologies returns a RetryTopologyInformer.
+func (v *version) RetryTopologies() RetryTopologyInformer {
+	return &retryTopologyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SuperStreams returns a SuperStreamInformer.
 func (v *version) SuperStreams() SuperStreamInformer {
 	return &superStreamInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// SuperStreamConsumers returns a SuperStreamConsumerInformer.
+func (v *version) SuperStreamConsumers() SuperStreamConsumerInformer {
+	return &superStreamConsumerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
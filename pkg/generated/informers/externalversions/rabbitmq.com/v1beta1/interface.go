@@ -19,20 +19,28 @@ import (
 type Interface interface {
 	// Bindings returns a BindingInformer.
 	Bindings() BindingInformer
+	// Definitionses returns a DefinitionsInformer.
+	Definitionses() DefinitionsInformer
 	// Exchanges returns a ExchangeInformer.
 	Exchanges() ExchangeInformer
 	// Federations returns a FederationInformer.
 	Federations() FederationInformer
+	// OperatorPolicies returns a OperatorPolicyInformer.
+	OperatorPolicies() OperatorPolicyInformer
 	// Permissions returns a PermissionInformer.
 	Permissions() PermissionInformer
 	// Policies returns a PolicyInformer.
 	Policies() PolicyInformer
 	// Queues returns a QueueInformer.
 	Queues() QueueInformer
+	// RuntimeParameters returns a RuntimeParameterInformer.
+	RuntimeParameters() RuntimeParameterInformer
 	// SchemaReplications returns a SchemaReplicationInformer.
 	SchemaReplications() SchemaReplicationInformer
 	// Shovels returns a ShovelInformer.
 	Shovels() ShovelInformer
+	// TopicPermissions returns a TopicPermissionInformer.
+	TopicPermissions() TopicPermissionInformer
 	// Users returns a UserInformer.
 	Users() UserInformer
 	// Vhosts returns a VhostInformer.
@@ -55,6 +63,11 @@ func (v *version) Bindings() BindingInformer {
 	return &bindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Definitionses returns a DefinitionsInformer.
+func (v *version) Definitionses() DefinitionsInformer {
+	return &definitionsInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Exchanges returns a ExchangeInformer.
 func (v *version) Exchanges() ExchangeInformer {
 	return &exchangeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -65,6 +78,11 @@ func (v *version) Federations() FederationInformer {
 	return &federationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OperatorPolicies returns a OperatorPolicyInformer.
+func (v *version) OperatorPolicies() OperatorPolicyInformer {
+	return &operatorPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Permissions returns a PermissionInformer.
 func (v *version) Permissions() PermissionInformer {
 	return &permissionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -80,6 +98,11 @@ func (v *version) Queues() QueueInformer {
 	return &queueInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RuntimeParameters returns a RuntimeParameterInformer.
+func (v *version) RuntimeParameters() RuntimeParameterInformer {
+	return &runtimeParameterInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SchemaReplications returns a SchemaReplicationInformer.
 func (v *version) SchemaReplications() SchemaReplicationInformer {
 	return &schemaReplicationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -90,6 +113,11 @@ func (v *version) Shovels() ShovelInformer {
 	return &shovelInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TopicPermissions returns a TopicPermissionInformer.
+func (v *version) TopicPermissions() TopicPermissionInformer {
+	return &topicPermissionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Users returns a UserInformer.
 func (v *version) Users() UserInformer {
 	return &userInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
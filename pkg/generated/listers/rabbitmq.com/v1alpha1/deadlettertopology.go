@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeadLetterTopologyLister helps list DeadLetterTopologies.
+// All objects returned here must be treated as read-only.
+type DeadLetterTopologyLister interface {
+	// List lists all DeadLetterTopologies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeadLetterTopology, err error)
+	// DeadLetterTopologies returns an object that can list and get DeadLetterTopologies.
+	DeadLetterTopologies(namespace string) DeadLetterTopologyNamespaceLister
+	DeadLetterTopologyListerExpansion
+}
+
+// deadLetterTopologyLister implements the DeadLetterTopologyLister interface.
+type deadLetterTopologyLister struct {
+	indexer cache.Indexer
+}
+
+// NewDeadLetterTopologyLister returns a new DeadLetterTopologyLister.
+func NewDeadLetterTopologyLister(indexer cache.Indexer) DeadLetterTopologyLister {
+	return &deadLetterTopologyLister{indexer: indexer}
+}
+
+// List lists all DeadLetterTopologies in the indexer.
+func (s *deadLetterTopologyLister) List(selector labels.Selector) (ret []*v1alpha1.DeadLetterTopology, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeadLetterTopology))
+	})
+	return ret, err
+}
+
+// DeadLetterTopologies returns an object that can list and get DeadLetterTopologies.
+func (s *deadLetterTopologyLister) DeadLetterTopologies(namespace string) DeadLetterTopologyNamespaceLister {
+	return deadLetterTopologyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DeadLetterTopologyNamespaceLister helps list and get DeadLetterTopologies.
+// All objects returned here must be treated as read-only.
+type DeadLetterTopologyNamespaceLister interface {
+	// List lists all DeadLetterTopologies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DeadLetterTopology, err error)
+	// Get retrieves the DeadLetterTopology from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DeadLetterTopology, error)
+	DeadLetterTopologyNamespaceListerExpansion
+}
+
+// deadLetterTopologyNamespaceLister implements the DeadLetterTopologyNamespaceLister
+// interface.
+type deadLetterTopologyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DeadLetterTopologies in the indexer for a given namespace.
+func (s deadLetterTopologyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DeadLetterTopology, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DeadLetterTopology))
+	})
+	return ret, err
+}
+
+// Get retrieves the DeadLetterTopology from the indexer for a given namespace and name.
+func (s deadLetterTopologyNamespaceLister) Get(name string) (*v1alpha1.DeadLetterTopology, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("deadlettertopology"), name)
+	}
+	return obj.(*v1alpha1.DeadLetterTopology), nil
+}
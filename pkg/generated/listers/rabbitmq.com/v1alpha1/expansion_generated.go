@@ -11,6 +11,30 @@ This product may include a number of subcomponents with separate copyright notic
 
 package v1alpha1
 
+// DeadLetterTopologyListerExpansion allows custom methods to be added to
+// DeadLetterTopologyLister.
+type DeadLetterTopologyListerExpansion interface{}
+
+// DeadLetterTopologyNamespaceListerExpansion allows custom methods to be added to
+// DeadLetterTopologyNamespaceLister.
+type DeadLetterTopologyNamespaceListerExpansion interface{}
+
+// MessagingApplicationListerExpansion allows custom methods to be added to
+// MessagingApplicationLister.
+type MessagingApplicationListerExpansion interface{}
+
+// MessagingApplicationNamespaceListerExpansion allows custom methods to be added to
+// MessagingApplicationNamespaceLister.
+type MessagingApplicationNamespaceListerExpansion interface{}
+
+// RetryTopologyListerExpansion allows custom methods to be added to
+// RetryTopologyLister.
+type RetryTopologyListerExpansion interface{}
+
+// RetryTopologyNamespaceListerExpansion allows custom methods to be added to
+// RetryTopologyNamespaceLister.
+type RetryTopologyNamespaceListerExpansion interface{}
+
 // SuperStreamListerExpansion allows custom methods to be added to
 // SuperStreamLister.
 type SuperStreamListerExpansion interface{}
@@ -18,3 +42,11 @@ type SuperStreamListerExpansion interface{}
 // SuperStreamNamespaceListerExpansion allows custom methods to be added to
 // SuperStreamNamespaceLister.
 type SuperStreamNamespaceListerExpansion interface{}
+
+// SuperStreamConsumerListerExpansion allows custom methods to be added to
+// SuperStreamConsumerLister.
+type SuperStreamConsumerListerExpansion interface{}
+
+// SuperStreamConsumerNamespaceListerExpansion allows custom methods to be added to
+// SuperStreamConsumerNamespaceLister.
+type SuperStreamConsumerNamespaceListerExpansion interface{}
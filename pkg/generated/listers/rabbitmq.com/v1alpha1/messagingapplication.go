@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MessagingApplicationLister helps list MessagingApplications.
+// All objects returned here must be treated as read-only.
+type MessagingApplicationLister interface {
+	// List lists all MessagingApplications in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.MessagingApplication, err error)
+	// MessagingApplications returns an object that can list and get MessagingApplications.
+	MessagingApplications(namespace string) MessagingApplicationNamespaceLister
+	MessagingApplicationListerExpansion
+}
+
+// messagingApplicationLister implements the MessagingApplicationLister interface.
+type messagingApplicationLister struct {
+	indexer cache.Indexer
+}
+
+// NewMessagingApplicationLister returns a new MessagingApplicationLister.
+func NewMessagingApplicationLister(indexer cache.Indexer) MessagingApplicationLister {
+	return &messagingApplicationLister{indexer: indexer}
+}
+
+// List lists all MessagingApplications in the indexer.
+func (s *messagingApplicationLister) List(selector labels.Selector) (ret []*v1alpha1.MessagingApplication, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.MessagingApplication))
+	})
+	return ret, err
+}
+
+// MessagingApplications returns an object that can list and get MessagingApplications.
+func (s *messagingApplicationLister) MessagingApplications(namespace string) MessagingApplicationNamespaceLister {
+	return messagingApplicationNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MessagingApplicationNamespaceLister helps list and get MessagingApplications.
+// All objects returned here must be treated as read-only.
+type MessagingApplicationNamespaceLister interface {
+	// List lists all MessagingApplications in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.MessagingApplication, err error)
+	// Get retrieves the MessagingApplication from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.MessagingApplication, error)
+	MessagingApplicationNamespaceListerExpansion
+}
+
+// messagingApplicationNamespaceLister implements the MessagingApplicationNamespaceLister
+// interface.
+type messagingApplicationNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MessagingApplications in the indexer for a given namespace.
+func (s messagingApplicationNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.MessagingApplication, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.MessagingApplication))
+	})
+	return ret, err
+}
+
+// Get retrieves the MessagingApplication from the indexer for a given namespace and name.
+func (s messagingApplicationNamespaceLister) Get(name string) (*v1alpha1.MessagingApplication, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("messagingapplication"), name)
+	}
+	return obj.(*v1alpha1.MessagingApplication), nil
+}
@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/rabbitmq/messaging-topology-operator/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RetryTopologyLister helps list RetryTopologies.
+// All objects returned here must be treated as read-only.
+type RetryTopologyLister interface {
+	// List lists all RetryTopologies in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RetryTopology, err error)
+	// RetryTopologies returns an object that can list and get RetryTopologies.
+	RetryTopologies(namespace string) RetryTopologyNamespaceLister
+	RetryTopologyListerExpansion
+}
+
+// retryTopologyLister implements the RetryTopologyLister interface.
+type retryTopologyLister struct {
+	indexer cache.Indexer
+}
+
+// NewRetryTopologyLister returns a new RetryTopologyLister.
+func NewRetryTopologyLister(indexer cache.Indexer) RetryTopologyLister {
+	return &retryTopologyLister{indexer: indexer}
+}
+
+// List lists all RetryTopologies in the indexer.
+func (s *retryTopologyLister) List(selector labels.Selector) (ret []*v1alpha1.RetryTopology, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RetryTopology))
+	})
+	return ret, err
+}
+
+// RetryTopologies returns an object that can list and get RetryTopologies.
+func (s *retryTopologyLister) RetryTopologies(namespace string) RetryTopologyNamespaceLister {
+	return retryTopologyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RetryTopologyNamespaceLister helps list and get RetryTopologies.
+// All objects returned here must be treated as read-only.
+type RetryTopologyNamespaceLister interface {
+	// List lists all RetryTopologies in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RetryTopology, err error)
+	// Get retrieves the RetryTopology from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RetryTopology, error)
+	RetryTopologyNamespaceListerExpansion
+}
+
+// retryTopologyNamespaceLister implements the RetryTopologyNamespaceLister
+// interface.
+type retryTopologyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RetryTopologies in the indexer for a given namespace.
+func (s retryTopologyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RetryTopology, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RetryTopology))
+	})
+	return ret, err
+}
+
+// Get retrieves the RetryTopology from the indexer for a given namespace and name.
+func (s retryTopologyNamespaceLister) Get(name string) (*v1alpha1.RetryTopology, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("retrytopology"), name)
+	}
+	return obj.(*v1alpha1.RetryTopology), nil
+}
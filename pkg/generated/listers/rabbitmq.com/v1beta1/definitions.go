@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DefinitionsLister helps list Definitionses.
+// All objects returned here must be treated as read-only.
+type DefinitionsLister interface {
+	// List lists all Definitionses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Definitions, err error)
+	// Definitionses returns an object that can list and get Definitionses.
+	Definitionses(namespace string) DefinitionsNamespaceLister
+	DefinitionsListerExpansion
+}
+
+// definitionsLister implements the DefinitionsLister interface.
+type definitionsLister struct {
+	indexer cache.Indexer
+}
+
+// NewDefinitionsLister returns a new DefinitionsLister.
+func NewDefinitionsLister(indexer cache.Indexer) DefinitionsLister {
+	return &definitionsLister{indexer: indexer}
+}
+
+// List lists all Definitionses in the indexer.
+func (s *definitionsLister) List(selector labels.Selector) (ret []*v1beta1.Definitions, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Definitions))
+	})
+	return ret, err
+}
+
+// Definitionses returns an object that can list and get Definitionses.
+func (s *definitionsLister) Definitionses(namespace string) DefinitionsNamespaceLister {
+	return definitionsNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DefinitionsNamespaceLister helps list and get Definitionses.
+// All objects returned here must be treated as read-only.
+type DefinitionsNamespaceLister interface {
+	// List lists all Definitionses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.Definitions, err error)
+	// Get retrieves the Definitions from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.Definitions, error)
+	DefinitionsNamespaceListerExpansion
+}
+
+// definitionsNamespaceLister implements the DefinitionsNamespaceLister
+// interface.
+type definitionsNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Definitionses in the indexer for a given namespace.
+func (s definitionsNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.Definitions, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.Definitions))
+	})
+	return ret, err
+}
+
+// Get retrieves the Definitions from the indexer for a given namespace and name.
+func (s definitionsNamespaceLister) Get(name string) (*v1beta1.Definitions, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("definitions"), name)
+	}
+	return obj.(*v1beta1.Definitions), nil
+}
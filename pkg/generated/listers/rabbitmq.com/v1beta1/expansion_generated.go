@@ -19,6 +19,14 @@ type BindingListerExpansion interface{}
 // BindingNamespaceLister.
 type BindingNamespaceListerExpansion interface{}
 
+// DefinitionsListerExpansion allows custom methods to be added to
+// DefinitionsLister.
+type DefinitionsListerExpansion interface{}
+
+// DefinitionsNamespaceListerExpansion allows custom methods to be added to
+// DefinitionsNamespaceLister.
+type DefinitionsNamespaceListerExpansion interface{}
+
 // ExchangeListerExpansion allows custom methods to be added to
 // ExchangeLister.
 type ExchangeListerExpansion interface{}
@@ -35,6 +43,14 @@ type FederationListerExpansion interface{}
 // FederationNamespaceLister.
 type FederationNamespaceListerExpansion interface{}
 
+// OperatorPolicyListerExpansion allows custom methods to be added to
+// OperatorPolicyLister.
+type OperatorPolicyListerExpansion interface{}
+
+// OperatorPolicyNamespaceListerExpansion allows custom methods to be added to
+// OperatorPolicyNamespaceLister.
+type OperatorPolicyNamespaceListerExpansion interface{}
+
 // PermissionListerExpansion allows custom methods to be added to
 // PermissionLister.
 type PermissionListerExpansion interface{}
@@ -59,6 +75,14 @@ type QueueListerExpansion interface{}
 // QueueNamespaceLister.
 type QueueNamespaceListerExpansion interface{}
 
+// RuntimeParameterListerExpansion allows custom methods to be added to
+// RuntimeParameterLister.
+type RuntimeParameterListerExpansion interface{}
+
+// RuntimeParameterNamespaceListerExpansion allows custom methods to be added to
+// RuntimeParameterNamespaceLister.
+type RuntimeParameterNamespaceListerExpansion interface{}
+
 // SchemaReplicationListerExpansion allows custom methods to be added to
 // SchemaReplicationLister.
 type SchemaReplicationListerExpansion interface{}
@@ -75,6 +99,14 @@ type ShovelListerExpansion interface{}
 // ShovelNamespaceLister.
 type ShovelNamespaceListerExpansion interface{}
 
+// TopicPermissionListerExpansion allows custom methods to be added to
+// TopicPermissionLister.
+type TopicPermissionListerExpansion interface{}
+
+// TopicPermissionNamespaceListerExpansion allows custom methods to be added to
+// TopicPermissionNamespaceLister.
+type TopicPermissionNamespaceListerExpansion interface{}
+
 // UserListerExpansion allows custom methods to be added to
 // UserLister.
 type UserListerExpansion interface{}
@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RuntimeParameterLister helps list RuntimeParameters.
+// All objects returned here must be treated as read-only.
+type RuntimeParameterLister interface {
+	// List lists all RuntimeParameters in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.RuntimeParameter, err error)
+	// RuntimeParameters returns an object that can list and get RuntimeParameters.
+	RuntimeParameters(namespace string) RuntimeParameterNamespaceLister
+	RuntimeParameterListerExpansion
+}
+
+// runtimeParameterLister implements the RuntimeParameterLister interface.
+type runtimeParameterLister struct {
+	indexer cache.Indexer
+}
+
+// NewRuntimeParameterLister returns a new RuntimeParameterLister.
+func NewRuntimeParameterLister(indexer cache.Indexer) RuntimeParameterLister {
+	return &runtimeParameterLister{indexer: indexer}
+}
+
+// List lists all RuntimeParameters in the indexer.
+func (s *runtimeParameterLister) List(selector labels.Selector) (ret []*v1beta1.RuntimeParameter, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.RuntimeParameter))
+	})
+	return ret, err
+}
+
+// RuntimeParameters returns an object that can list and get RuntimeParameters.
+func (s *runtimeParameterLister) RuntimeParameters(namespace string) RuntimeParameterNamespaceLister {
+	return runtimeParameterNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RuntimeParameterNamespaceLister helps list and get RuntimeParameters.
+// All objects returned here must be treated as read-only.
+type RuntimeParameterNamespaceLister interface {
+	// List lists all RuntimeParameters in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.RuntimeParameter, err error)
+	// Get retrieves the RuntimeParameter from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.RuntimeParameter, error)
+	RuntimeParameterNamespaceListerExpansion
+}
+
+// runtimeParameterNamespaceLister implements the RuntimeParameterNamespaceLister
+// interface.
+type runtimeParameterNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RuntimeParameters in the indexer for a given namespace.
+func (s runtimeParameterNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.RuntimeParameter, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.RuntimeParameter))
+	})
+	return ret, err
+}
+
+// Get retrieves the RuntimeParameter from the indexer for a given namespace and name.
+func (s runtimeParameterNamespaceLister) Get(name string) (*v1beta1.RuntimeParameter, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("runtimeparameter"), name)
+	}
+	return obj.(*v1beta1.RuntimeParameter), nil
+}
@@ -0,0 +1,92 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TopicPermissionLister helps list TopicPermissions.
+// All objects returned here must be treated as read-only.
+type TopicPermissionLister interface {
+	// List lists all TopicPermissions in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.TopicPermission, err error)
+	// TopicPermissions returns an object that can list and get TopicPermissions.
+	TopicPermissions(namespace string) TopicPermissionNamespaceLister
+	TopicPermissionListerExpansion
+}
+
+// topicPermissionLister implements the TopicPermissionLister interface.
+type topicPermissionLister struct {
+	indexer cache.Indexer
+}
+
+// NewTopicPermissionLister returns a new TopicPermissionLister.
+func NewTopicPermissionLister(indexer cache.Indexer) TopicPermissionLister {
+	return &topicPermissionLister{indexer: indexer}
+}
+
+// List lists all TopicPermissions in the indexer.
+func (s *topicPermissionLister) List(selector labels.Selector) (ret []*v1beta1.TopicPermission, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.TopicPermission))
+	})
+	return ret, err
+}
+
+// TopicPermissions returns an object that can list and get TopicPermissions.
+func (s *topicPermissionLister) TopicPermissions(namespace string) TopicPermissionNamespaceLister {
+	return topicPermissionNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TopicPermissionNamespaceLister helps list and get TopicPermissions.
+// All objects returned here must be treated as read-only.
+type TopicPermissionNamespaceLister interface {
+	// List lists all TopicPermissions in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.TopicPermission, err error)
+	// Get retrieves the TopicPermission from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.TopicPermission, error)
+	TopicPermissionNamespaceListerExpansion
+}
+
+// topicPermissionNamespaceLister implements the TopicPermissionNamespaceLister
+// interface.
+type topicPermissionNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TopicPermissions in the indexer for a given namespace.
+func (s topicPermissionNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.TopicPermission, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.TopicPermission))
+	})
+	return ret, err
+}
+
+// Get retrieves the TopicPermission from the indexer for a given namespace and name.
+func (s topicPermissionNamespaceLister) Get(name string) (*v1beta1.TopicPermission, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("topicpermission"), name)
+	}
+	return obj.(*v1beta1.TopicPermission), nil
+}
@@ -30,6 +30,10 @@ func (c ClusterCredentials) Data(key string) ([]byte, bool) {
 
 var SecretStoreClientProvider = GetSecretStoreClient
 
+// VaultCredentialsPathAnnotation can be set on a RabbitmqCluster to the path of a role of a Vault RabbitMQ secrets engine, e.g. 'rabbitmq/creds/topology-operator',
+// from which the operator obtains leased credentials to manage the cluster
+const VaultCredentialsPathAnnotation = "rabbitmq.com/topology-vault-credentials-path"
+
 var (
 	NoSuchRabbitmqClusterError = errors.New("RabbitmqCluster object does not exist")
 	ResourceNotAllowedError    = errors.New("resource is not allowed to reference defined cluster reference. Check the namespace of the resource is allowed as part of the cluster's `rabbitmq.com/topology-allowed-namespaces` annotation")
@@ -38,35 +42,27 @@ var (
 
 func ParseReference(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string, clusterDomain string) (ConnectionCredentials, bool, error) {
 	if rmq.ConnectionSecret != nil {
-		secret := &corev1.Secret{}
-		if err := c.Get(ctx, types.NamespacedName{Namespace: requestNamespace, Name: rmq.ConnectionSecret.Name}, secret); err != nil {
-			return nil, false, err
-		}
-		return readCredentialsFromKubernetesSecret(secret)
+		return readConnectionSecret(ctx, c, rmq, requestNamespace)
 	}
 
-	var namespace string
-	if rmq.Namespace == "" {
-		namespace = requestNamespace
-	} else {
-		namespace = rmq.Namespace
-	}
-
-	cluster := &rabbitmqv1beta1.RabbitmqCluster{}
-	if err := c.Get(ctx, types.NamespacedName{Name: rmq.Name, Namespace: namespace}, cluster); err != nil {
-		return nil, false, fmt.Errorf("failed to get cluster from reference: %s Error: %w", err, NoSuchRabbitmqClusterError)
-	}
-
-	if !AllowedNamespace(rmq, requestNamespace, cluster) {
-		return nil, false, ResourceNotAllowedError
-	}
-
-	if cluster.Status.DefaultUser == nil || cluster.Status.DefaultUser.ServiceReference == nil {
-		return nil, false, NoServiceReferenceSetError
+	cluster, svc, err := referencedCluster(ctx, c, rmq, requestNamespace)
+	if err != nil {
+		return nil, false, err
 	}
 
 	var user, pass string
-	if cluster.Spec.SecretBackend.Vault != nil && cluster.Spec.SecretBackend.Vault.DefaultUserPath != "" {
+	if path := cluster.Annotations[VaultCredentialsPathAnnotation]; path != "" {
+		// ask the configured secure store for credentials issued with a lease by a dynamic secrets engine
+		secretStoreClient, err := SecretStoreClientProvider()
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to create a client connection to secret store: %w", err)
+		}
+
+		user, pass, err = secretStoreClient.ReadLeasedCredentials(path)
+		if err != nil {
+			return nil, false, fmt.Errorf("unable to retrieve leased credentials from secret store: %w", err)
+		}
+	} else if cluster.Spec.SecretBackend.Vault != nil && cluster.Spec.SecretBackend.Vault.DefaultUserPath != "" {
 		// ask the configured secure store for the credentials available at the path retrieved from the cluster resource
 		secretStoreClient, err := SecretStoreClientProvider()
 		if err != nil {
@@ -84,21 +80,15 @@ func ParseReference(ctx context.Context, c client.Client, rmq topology.RabbitmqC
 		}
 
 		secret := &corev1.Secret{}
-		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cluster.Status.Binding.Name}, secret); err != nil {
+		if err := c.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Status.Binding.Name}, secret); err != nil {
 			return nil, false, err
 		}
-		var err error
 		user, pass, err = readUsernamePassword(secret)
 		if err != nil {
 			return nil, false, fmt.Errorf("unable to retrieve credentials from Kubernetes secret %s: %w", secret.Name, err)
 		}
 	}
 
-	svc := &corev1.Service{}
-	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cluster.Status.DefaultUser.ServiceReference.Name}, svc); err != nil {
-		return nil, false, err
-	}
-
 	endpoint, err := managementURI(svc, cluster.TLSEnabled(), clusterDomain)
 	if err != nil {
 		return nil, false, fmt.Errorf("failed to get endpoint from specified rabbitmqcluster: %w", err)
@@ -113,6 +103,127 @@ func ParseReference(ctx context.Context, c client.Client, rmq topology.RabbitmqC
 	}, cluster.TLSEnabled(), nil
 }
 
+// AMQPConnection describes how applications connect to a cluster over AMQP
+type AMQPConnection struct {
+	Host       string
+	Port       int32
+	TLSEnabled bool
+	// PEM encoded certificate of the CA of the cluster; only set for RabbitmqClusters with TLS enabled,
+	// from the 'ca.crt' key of the CA secret of the cluster, or of its TLS secret when it has no CA secret
+	CACertificate []byte
+}
+
+// Endpoint returns the AMQP endpoint of the connection, e.g. 'amqps://rmq.rabbitmq-system.svc:5671'
+func (a *AMQPConnection) Endpoint() string {
+	scheme := "amqp"
+	if a.TLSEnabled {
+		scheme = "amqps"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, a.Host, a.Port)
+}
+
+// AMQPEndpoint returns the AMQP endpoint of the referenced cluster, e.g. 'amqps://rmq.rabbitmq-system.svc:5671'.
+func AMQPEndpoint(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string, clusterDomain string) (string, error) {
+	connection, err := ParseAMQPConnection(ctx, c, rmq, requestNamespace, clusterDomain)
+	if err != nil {
+		return "", err
+	}
+	return connection.Endpoint(), nil
+}
+
+// ParseAMQPConnection returns how to connect to the referenced cluster over AMQP.
+// For a RabbitmqCluster, the host is its Service, with port 'amqps' when TLS is enabled; for a connection secret,
+// the host is the host of the management uri, with the default AMQPS port when the management uri uses https.
+func ParseAMQPConnection(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string, clusterDomain string) (*AMQPConnection, error) {
+	if rmq.ConnectionSecret != nil {
+		credentials, tlsEnabled, err := readConnectionSecret(ctx, c, rmq, requestNamespace)
+		if err != nil {
+			return nil, err
+		}
+		uri, _ := credentials.Data("uri")
+		parsed, err := url.Parse(string(uri))
+		if err != nil {
+			return nil, err
+		}
+		if tlsEnabled {
+			return &AMQPConnection{Host: parsed.Hostname(), Port: 5671, TLSEnabled: true}, nil
+		}
+		return &AMQPConnection{Host: parsed.Hostname(), Port: 5672}, nil
+	}
+
+	cluster, svc, err := referencedCluster(ctx, c, rmq, requestNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	portName := "amqp"
+	if cluster.TLSEnabled() {
+		portName = "amqps"
+	}
+	connection := &AMQPConnection{
+		Host:       fmt.Sprintf("%s.%s.svc%s", svc.Name, svc.Namespace, clusterDomain),
+		TLSEnabled: cluster.TLSEnabled(),
+	}
+	for _, port := range svc.Spec.Ports {
+		if port.Name == portName {
+			connection.Port = port.Port
+		}
+	}
+	if connection.Port == 0 {
+		return nil, fmt.Errorf("failed to find '%s' port from service %s", portName, svc.Name)
+	}
+
+	if cluster.TLSEnabled() {
+		caSecretName := cluster.Spec.TLS.CaSecretName
+		if caSecretName == "" {
+			caSecretName = cluster.Spec.TLS.SecretName
+		}
+		caSecret := &corev1.Secret{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: cluster.Namespace, Name: caSecretName}, caSecret); client.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		connection.CACertificate = caSecret.Data["ca.crt"]
+	}
+	return connection, nil
+}
+
+// readConnectionSecret reads the credentials and the management uri of a cluster from the connection secret of rmq
+func readConnectionSecret(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string) (ConnectionCredentials, bool, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: requestNamespace, Name: rmq.ConnectionSecret.Name}, secret); err != nil {
+		return nil, false, err
+	}
+	return readCredentialsFromKubernetesSecret(secret)
+}
+
+// referencedCluster returns the RabbitmqCluster referenced by rmq, and its Service,
+// once checked that resources in requestNamespace are allowed to reference the cluster
+func referencedCluster(ctx context.Context, c client.Client, rmq topology.RabbitmqClusterReference, requestNamespace string) (*rabbitmqv1beta1.RabbitmqCluster, *corev1.Service, error) {
+	namespace := rmq.Namespace
+	if namespace == "" {
+		namespace = requestNamespace
+	}
+
+	cluster := &rabbitmqv1beta1.RabbitmqCluster{}
+	if err := c.Get(ctx, types.NamespacedName{Name: rmq.Name, Namespace: namespace}, cluster); err != nil {
+		return nil, nil, fmt.Errorf("failed to get cluster from reference: %s Error: %w", err, NoSuchRabbitmqClusterError)
+	}
+
+	if !AllowedNamespace(rmq, requestNamespace, cluster) {
+		return nil, nil, ResourceNotAllowedError
+	}
+
+	if cluster.Status.DefaultUser == nil || cluster.Status.DefaultUser.ServiceReference == nil {
+		return nil, nil, NoServiceReferenceSetError
+	}
+
+	svc := &corev1.Service{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: cluster.Status.DefaultUser.ServiceReference.Name}, svc); err != nil {
+		return nil, nil, err
+	}
+	return cluster, svc, nil
+}
+
 func AllowedNamespace(rmq topology.RabbitmqClusterReference, requestNamespace string, cluster *rabbitmqv1beta1.RabbitmqCluster) bool {
 	if rmq.Namespace != "" && rmq.Namespace != requestNamespace {
 		var isAllowed bool
@@ -210,6 +210,27 @@ var _ = Describe("ParseReference", func() {
 					Expect(err).To(MatchError(rabbitmqclient.NoServiceReferenceSetError))
 				})
 			})
+
+			When("RabbitmqCluster has the Vault credentials path annotation", func() {
+				BeforeEach(func() {
+					existingRabbitMQCluster.Annotations = map[string]string{
+						rabbitmqclient.VaultCredentialsPathAnnotation: "rabbitmq/creds/topology-operator",
+					}
+					fakeSecretStoreClient.ReadLeasedCredentialsReturns("a-leased-user", "a-leased-password", nil)
+				})
+
+				It("uses leased credentials from the annotation path instead of the default user path", func() {
+					Expect(err).NotTo(HaveOccurred())
+					usernameBytes, _ := credsProv.Data("username")
+					passwordBytes, _ := credsProv.Data("password")
+					Expect(usernameBytes).To(Equal([]byte("a-leased-user")))
+					Expect(passwordBytes).To(Equal([]byte("a-leased-password")))
+
+					Expect(fakeSecretStoreClient.ReadLeasedCredentialsCallCount()).To(Equal(1))
+					Expect(fakeSecretStoreClient.ReadLeasedCredentialsArgsForCall(0)).To(Equal("rabbitmq/creds/topology-operator"))
+					Expect(fakeSecretStoreClient.ReadCredentialsCallCount()).To(Equal(0))
+				})
+			})
 		})
 	})
 
@@ -465,6 +486,123 @@ var _ = Describe("ParseReference", func() {
 	})
 })
 
+var _ = Describe("AMQPEndpoint", func() {
+	var (
+		objs       []runtime.Object
+		fakeClient client.Client
+		cluster    *rabbitmqv1beta1.RabbitmqCluster
+		ctx        = context.Background()
+		namespace  = "rabbitmq-system"
+	)
+
+	BeforeEach(func() {
+		cluster = &rabbitmqv1beta1.RabbitmqCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rmq",
+				Namespace: namespace,
+			},
+			Status: rabbitmqv1beta1.RabbitmqClusterStatus{
+				DefaultUser: &rabbitmqv1beta1.RabbitmqClusterDefaultUser{
+					ServiceReference: &rabbitmqv1beta1.RabbitmqClusterServiceReference{
+						Name:      "rmq",
+						Namespace: namespace,
+					},
+				},
+			},
+		}
+		service := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rmq",
+				Namespace: namespace,
+			},
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{Name: "amqp", Port: int32(5672)},
+					{Name: "amqps", Port: int32(5671)},
+					{Name: "management", Port: int32(15672)},
+				},
+			},
+		}
+		connectionSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rmq-connection",
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				"uri":      []byte("https://rmq.example.com:15671"),
+				"username": []byte("a-user"),
+				"password": []byte("a-password"),
+			},
+		}
+		objs = []runtime.Object{cluster, service, connectionSecret}
+	})
+
+	JustBeforeEach(func() {
+		s := scheme.Scheme
+		s.AddKnownTypes(rabbitmqv1beta1.SchemeBuilder.GroupVersion, &rabbitmqv1beta1.RabbitmqCluster{})
+		fakeClient = fake.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build()
+	})
+
+	It("returns the amqp port of the cluster service", func() {
+		endpoint, err := rabbitmqclient.AMQPEndpoint(ctx, fakeClient, topology.RabbitmqClusterReference{Name: "rmq"}, namespace, ".cluster.local")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(endpoint).To(Equal("amqp://rmq.rabbitmq-system.svc.cluster.local:5672"))
+	})
+
+	When("the RabbitmqCluster is configured with TLS", func() {
+		BeforeEach(func() {
+			cluster.Spec.TLS = rabbitmqv1beta1.TLSSpec{SecretName: "a-tls-secret"}
+		})
+
+		It("returns the amqps port of the cluster service", func() {
+			endpoint, err := rabbitmqclient.AMQPEndpoint(ctx, fakeClient, topology.RabbitmqClusterReference{Name: "rmq"}, namespace, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint).To(Equal("amqps://rmq.rabbitmq-system.svc:5671"))
+		})
+	})
+
+	When("the cluster is referenced through a connection secret", func() {
+		It("returns the host of the management uri", func() {
+			endpoint, err := rabbitmqclient.AMQPEndpoint(ctx, fakeClient, topology.RabbitmqClusterReference{ConnectionSecret: &corev1.LocalObjectReference{Name: "rmq-connection"}}, namespace, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(endpoint).To(Equal("amqps://rmq.example.com:5671"))
+		})
+	})
+
+	When("the RabbitmqCluster is configured with TLS and a CA secret", func() {
+		BeforeEach(func() {
+			cluster.Spec.TLS = rabbitmqv1beta1.TLSSpec{SecretName: "a-tls-secret", CaSecretName: "a-ca-secret"}
+			objs = append(objs, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "a-ca-secret",
+					Namespace: namespace,
+				},
+				Data: map[string][]byte{
+					"ca.crt": []byte("a-ca-certificate"),
+				},
+			})
+		})
+
+		It("returns the host, amqps port and CA certificate of the cluster", func() {
+			connection, err := rabbitmqclient.ParseAMQPConnection(ctx, fakeClient, topology.RabbitmqClusterReference{Name: "rmq"}, namespace, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(connection).To(Equal(&rabbitmqclient.AMQPConnection{
+				Host:          "rmq.rabbitmq-system.svc",
+				Port:          5671,
+				TLSEnabled:    true,
+				CACertificate: []byte("a-ca-certificate"),
+			}))
+		})
+	})
+
+	When("the cluster does not allow the requesting namespace", func() {
+		It("errors", func() {
+			_, err := rabbitmqclient.AMQPEndpoint(ctx, fakeClient, topology.RabbitmqClusterReference{Name: "rmq", Namespace: namespace}, "another-namespace", "")
+			Expect(err).To(MatchError(rabbitmqclient.ResourceNotAllowedError))
+		})
+	})
+})
+
 var _ = Describe("AllowedNamespace", func() {
 	When("rabbitmqcluster reference namespace is an empty string", func() {
 		It("returns true", func() {
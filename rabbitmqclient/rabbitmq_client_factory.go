@@ -23,12 +23,17 @@ import (
 type Client interface {
 	PutUser(string, rabbithole.UserSettings) (*http.Response, error)
 	DeleteUser(string) (*http.Response, error)
+	GetUserLimits(username string) (rec []rabbithole.UserLimitsInfo, err error)
+	PutUserLimits(username string, limits rabbithole.UserLimitsValues) (res *http.Response, err error)
+	DeleteUserLimits(username string, limits rabbithole.UserLimits) (res *http.Response, err error)
 	DeclareBinding(string, rabbithole.BindingInfo) (*http.Response, error)
 	DeleteBinding(string, rabbithole.BindingInfo) (*http.Response, error)
 	ListQueueBindingsBetween(string, string, string) ([]rabbithole.BindingInfo, error)
 	ListExchangeBindingsBetween(string, string, string) ([]rabbithole.BindingInfo, error)
 	UpdatePermissionsIn(string, string, rabbithole.Permissions) (*http.Response, error)
 	ClearPermissionsIn(string, string) (*http.Response, error)
+	UpdateTopicPermissionsIn(string, string, rabbithole.TopicPermissions) (*http.Response, error)
+	DeleteTopicPermissionsIn(string, string, string) (*http.Response, error)
 	PutPolicy(string, string, rabbithole.Policy) (*http.Response, error)
 	DeletePolicy(string, string) (*http.Response, error)
 	DeclareQueue(string, string, rabbithole.QueueSettings) (*http.Response, error)
@@ -39,11 +44,21 @@ type Client interface {
 	DeleteVhost(string) (*http.Response, error)
 	PutGlobalParameter(name string, value interface{}) (*http.Response, error)
 	DeleteGlobalParameter(name string) (*http.Response, error)
+	PutRuntimeParameter(component, vhost, name string, value interface{}) (res *http.Response, err error)
+	DeleteRuntimeParameter(component, vhost, name string) (res *http.Response, err error)
 	PutFederationUpstream(vhost, name string, def rabbithole.FederationDefinition) (res *http.Response, err error)
 	DeleteFederationUpstream(vhost, name string) (res *http.Response, err error)
+	ListFederationLinksIn(vhost string) (links []map[string]interface{}, err error)
 	DeclareShovel(vhost, shovel string, info rabbithole.ShovelDefinition) (res *http.Response, err error)
 	DeleteShovel(vhost, shovel string) (res *http.Response, err error)
+	GetShovelStatus(vhost, name string) (*ShovelStatus, error)
 	GetVhost(vhost string) (rec *rabbithole.VhostInfo, err error)
+	GetQueue(vhost, queue string) (rec *rabbithole.DetailedQueueInfo, err error)
+	GetExchange(vhost, exchange string) (rec *rabbithole.DetailedExchangeInfo, err error)
+	GetPolicy(vhost, name string) (rec *rabbithole.Policy, err error)
+	GetVhostLimits(vhostname string) (rec []rabbithole.VhostLimitsInfo, err error)
+	PutVhostLimits(vhostname string, limits rabbithole.VhostLimitsValues) (res *http.Response, err error)
+	DeleteVhostLimits(vhostname string, limits rabbithole.VhostLimits) (res *http.Response, err error)
 	PutOperatorPolicy(string, string, rabbithole.OperatorPolicy) (*http.Response, error)
 	DeleteOperatorPolicy(vhost, name string) (res *http.Response, err error)
 }
@@ -79,17 +94,18 @@ func generateRabbitholeClient(connectionCreds ConnectionCredentials, tlsEnabled
 		cfg.RootCAs = certPool
 
 		transport := &http.Transport{TLSClientConfig: cfg}
-		rabbitmqClient, err = rabbithole.NewTLSClient(fmt.Sprintf("%s", string(uri)), string(defaultUser), string(defaultUserPass), transport)
-		if err != nil {
-			return nil, fmt.Errorf("failed to instantiate rabbit rabbitmqClient: %v", err)
-		}
-	} else {
-		rabbitmqClient, err = rabbithole.NewClient(fmt.Sprintf("%s", string(uri)), string(defaultUser), string(defaultUserPass))
+		client, err := rabbithole.NewTLSClient(fmt.Sprintf("%s", string(uri)), string(defaultUser), string(defaultUserPass), transport)
 		if err != nil {
 			return nil, fmt.Errorf("failed to instantiate rabbit rabbitmqClient: %v", err)
 		}
+		return &rabbitholeClient{Client: client, transport: transport}, nil
+	}
+
+	client, err := rabbithole.NewClient(fmt.Sprintf("%s", string(uri)), string(defaultUser), string(defaultUserPass))
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate rabbit rabbitmqClient: %v", err)
 	}
-	return rabbitmqClient, nil
+	return &rabbitholeClient{Client: client}, nil
 }
 
 func keyMissingErr(key string) error {
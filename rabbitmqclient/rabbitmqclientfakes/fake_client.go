@@ -181,6 +181,21 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteRuntimeParameterStub        func(string, string, string) (*http.Response, error)
+	deleteRuntimeParameterMutex       sync.RWMutex
+	deleteRuntimeParameterArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteRuntimeParameterReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteRuntimeParameterReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	DeleteShovelStub        func(string, string) (*http.Response, error)
 	deleteShovelMutex       sync.RWMutex
 	deleteShovelArgsForCall []struct {
@@ -195,6 +210,21 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteTopicPermissionsInStub        func(string, string, string) (*http.Response, error)
+	deleteTopicPermissionsInMutex       sync.RWMutex
+	deleteTopicPermissionsInArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	deleteTopicPermissionsInReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteTopicPermissionsInReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	DeleteUserStub        func(string) (*http.Response, error)
 	deleteUserMutex       sync.RWMutex
 	deleteUserArgsForCall []struct {
@@ -208,6 +238,20 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteUserLimitsStub        func(string, rabbithole.UserLimits) (*http.Response, error)
+	deleteUserLimitsMutex       sync.RWMutex
+	deleteUserLimitsArgsForCall []struct {
+		arg1 string
+		arg2 rabbithole.UserLimits
+	}
+	deleteUserLimitsReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteUserLimitsReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	DeleteVhostStub        func(string) (*http.Response, error)
 	deleteVhostMutex       sync.RWMutex
 	deleteVhostArgsForCall []struct {
@@ -221,6 +265,89 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	DeleteVhostLimitsStub        func(string, rabbithole.VhostLimits) (*http.Response, error)
+	deleteVhostLimitsMutex       sync.RWMutex
+	deleteVhostLimitsArgsForCall []struct {
+		arg1 string
+		arg2 rabbithole.VhostLimits
+	}
+	deleteVhostLimitsReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	deleteVhostLimitsReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
+	GetExchangeStub        func(string, string) (*rabbithole.DetailedExchangeInfo, error)
+	getExchangeMutex       sync.RWMutex
+	getExchangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getExchangeReturns struct {
+		result1 *rabbithole.DetailedExchangeInfo
+		result2 error
+	}
+	getExchangeReturnsOnCall map[int]struct {
+		result1 *rabbithole.DetailedExchangeInfo
+		result2 error
+	}
+	GetPolicyStub        func(string, string) (*rabbithole.Policy, error)
+	getPolicyMutex       sync.RWMutex
+	getPolicyArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getPolicyReturns struct {
+		result1 *rabbithole.Policy
+		result2 error
+	}
+	getPolicyReturnsOnCall map[int]struct {
+		result1 *rabbithole.Policy
+		result2 error
+	}
+	GetQueueStub        func(string, string) (*rabbithole.DetailedQueueInfo, error)
+	getQueueMutex       sync.RWMutex
+	getQueueArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getQueueReturns struct {
+		result1 *rabbithole.DetailedQueueInfo
+		result2 error
+	}
+	getQueueReturnsOnCall map[int]struct {
+		result1 *rabbithole.DetailedQueueInfo
+		result2 error
+	}
+	GetShovelStatusStub        func(string, string) (*rabbitmqclient.ShovelStatus, error)
+	getShovelStatusMutex       sync.RWMutex
+	getShovelStatusArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getShovelStatusReturns struct {
+		result1 *rabbitmqclient.ShovelStatus
+		result2 error
+	}
+	getShovelStatusReturnsOnCall map[int]struct {
+		result1 *rabbitmqclient.ShovelStatus
+		result2 error
+	}
+	GetUserLimitsStub        func(string) ([]rabbithole.UserLimitsInfo, error)
+	getUserLimitsMutex       sync.RWMutex
+	getUserLimitsArgsForCall []struct {
+		arg1 string
+	}
+	getUserLimitsReturns struct {
+		result1 []rabbithole.UserLimitsInfo
+		result2 error
+	}
+	getUserLimitsReturnsOnCall map[int]struct {
+		result1 []rabbithole.UserLimitsInfo
+		result2 error
+	}
 	GetVhostStub        func(string) (*rabbithole.VhostInfo, error)
 	getVhostMutex       sync.RWMutex
 	getVhostArgsForCall []struct {
@@ -234,6 +361,19 @@ type FakeClient struct {
 		result1 *rabbithole.VhostInfo
 		result2 error
 	}
+	GetVhostLimitsStub        func(string) ([]rabbithole.VhostLimitsInfo, error)
+	getVhostLimitsMutex       sync.RWMutex
+	getVhostLimitsArgsForCall []struct {
+		arg1 string
+	}
+	getVhostLimitsReturns struct {
+		result1 []rabbithole.VhostLimitsInfo
+		result2 error
+	}
+	getVhostLimitsReturnsOnCall map[int]struct {
+		result1 []rabbithole.VhostLimitsInfo
+		result2 error
+	}
 	ListExchangeBindingsBetweenStub        func(string, string, string) ([]rabbithole.BindingInfo, error)
 	listExchangeBindingsBetweenMutex       sync.RWMutex
 	listExchangeBindingsBetweenArgsForCall []struct {
@@ -249,6 +389,19 @@ type FakeClient struct {
 		result1 []rabbithole.BindingInfo
 		result2 error
 	}
+	ListFederationLinksInStub        func(string) ([]map[string]interface{}, error)
+	listFederationLinksInMutex       sync.RWMutex
+	listFederationLinksInArgsForCall []struct {
+		arg1 string
+	}
+	listFederationLinksInReturns struct {
+		result1 []map[string]interface{}
+		result2 error
+	}
+	listFederationLinksInReturnsOnCall map[int]struct {
+		result1 []map[string]interface{}
+		result2 error
+	}
 	ListQueueBindingsBetweenStub        func(string, string, string) ([]rabbithole.BindingInfo, error)
 	listQueueBindingsBetweenMutex       sync.RWMutex
 	listQueueBindingsBetweenArgsForCall []struct {
@@ -323,6 +476,22 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	PutRuntimeParameterStub        func(string, string, string, interface{}) (*http.Response, error)
+	putRuntimeParameterMutex       sync.RWMutex
+	putRuntimeParameterArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 interface{}
+	}
+	putRuntimeParameterReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	putRuntimeParameterReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	PutUserStub        func(string, rabbithole.UserSettings) (*http.Response, error)
 	putUserMutex       sync.RWMutex
 	putUserArgsForCall []struct {
@@ -337,6 +506,20 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	PutUserLimitsStub        func(string, rabbithole.UserLimitsValues) (*http.Response, error)
+	putUserLimitsMutex       sync.RWMutex
+	putUserLimitsArgsForCall []struct {
+		arg1 string
+		arg2 rabbithole.UserLimitsValues
+	}
+	putUserLimitsReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	putUserLimitsReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	PutVhostStub        func(string, rabbithole.VhostSettings) (*http.Response, error)
 	putVhostMutex       sync.RWMutex
 	putVhostArgsForCall []struct {
@@ -351,6 +534,20 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	PutVhostLimitsStub        func(string, rabbithole.VhostLimitsValues) (*http.Response, error)
+	putVhostLimitsMutex       sync.RWMutex
+	putVhostLimitsArgsForCall []struct {
+		arg1 string
+		arg2 rabbithole.VhostLimitsValues
+	}
+	putVhostLimitsReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	putVhostLimitsReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	UpdatePermissionsInStub        func(string, string, rabbithole.Permissions) (*http.Response, error)
 	updatePermissionsInMutex       sync.RWMutex
 	updatePermissionsInArgsForCall []struct {
@@ -366,6 +563,21 @@ type FakeClient struct {
 		result1 *http.Response
 		result2 error
 	}
+	UpdateTopicPermissionsInStub        func(string, string, rabbithole.TopicPermissions) (*http.Response, error)
+	updateTopicPermissionsInMutex       sync.RWMutex
+	updateTopicPermissionsInArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 rabbithole.TopicPermissions
+	}
+	updateTopicPermissionsInReturns struct {
+		result1 *http.Response
+		result2 error
+	}
+	updateTopicPermissionsInReturnsOnCall map[int]struct {
+		result1 *http.Response
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1153,6 +1365,72 @@ func (fake *FakeClient) DeleteQueueReturnsOnCall(i int, result1 *http.Response,
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DeleteRuntimeParameter(arg1 string, arg2 string, arg3 string) (*http.Response, error) {
+	fake.deleteRuntimeParameterMutex.Lock()
+	ret, specificReturn := fake.deleteRuntimeParameterReturnsOnCall[len(fake.deleteRuntimeParameterArgsForCall)]
+	fake.deleteRuntimeParameterArgsForCall = append(fake.deleteRuntimeParameterArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteRuntimeParameterStub
+	fakeReturns := fake.deleteRuntimeParameterReturns
+	fake.recordInvocation("DeleteRuntimeParameter", []interface{}{arg1, arg2, arg3})
+	fake.deleteRuntimeParameterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteRuntimeParameterCallCount() int {
+	fake.deleteRuntimeParameterMutex.RLock()
+	defer fake.deleteRuntimeParameterMutex.RUnlock()
+	return len(fake.deleteRuntimeParameterArgsForCall)
+}
+
+func (fake *FakeClient) DeleteRuntimeParameterCalls(stub func(string, string, string) (*http.Response, error)) {
+	fake.deleteRuntimeParameterMutex.Lock()
+	defer fake.deleteRuntimeParameterMutex.Unlock()
+	fake.DeleteRuntimeParameterStub = stub
+}
+
+func (fake *FakeClient) DeleteRuntimeParameterArgsForCall(i int) (string, string, string) {
+	fake.deleteRuntimeParameterMutex.RLock()
+	defer fake.deleteRuntimeParameterMutex.RUnlock()
+	argsForCall := fake.deleteRuntimeParameterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteRuntimeParameterReturns(result1 *http.Response, result2 error) {
+	fake.deleteRuntimeParameterMutex.Lock()
+	defer fake.deleteRuntimeParameterMutex.Unlock()
+	fake.DeleteRuntimeParameterStub = nil
+	fake.deleteRuntimeParameterReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteRuntimeParameterReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteRuntimeParameterMutex.Lock()
+	defer fake.deleteRuntimeParameterMutex.Unlock()
+	fake.DeleteRuntimeParameterStub = nil
+	if fake.deleteRuntimeParameterReturnsOnCall == nil {
+		fake.deleteRuntimeParameterReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteRuntimeParameterReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DeleteShovel(arg1 string, arg2 string) (*http.Response, error) {
 	fake.deleteShovelMutex.Lock()
 	ret, specificReturn := fake.deleteShovelReturnsOnCall[len(fake.deleteShovelArgsForCall)]
@@ -1218,6 +1496,72 @@ func (fake *FakeClient) DeleteShovelReturnsOnCall(i int, result1 *http.Response,
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DeleteTopicPermissionsIn(arg1 string, arg2 string, arg3 string) (*http.Response, error) {
+	fake.deleteTopicPermissionsInMutex.Lock()
+	ret, specificReturn := fake.deleteTopicPermissionsInReturnsOnCall[len(fake.deleteTopicPermissionsInArgsForCall)]
+	fake.deleteTopicPermissionsInArgsForCall = append(fake.deleteTopicPermissionsInArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteTopicPermissionsInStub
+	fakeReturns := fake.deleteTopicPermissionsInReturns
+	fake.recordInvocation("DeleteTopicPermissionsIn", []interface{}{arg1, arg2, arg3})
+	fake.deleteTopicPermissionsInMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteTopicPermissionsInCallCount() int {
+	fake.deleteTopicPermissionsInMutex.RLock()
+	defer fake.deleteTopicPermissionsInMutex.RUnlock()
+	return len(fake.deleteTopicPermissionsInArgsForCall)
+}
+
+func (fake *FakeClient) DeleteTopicPermissionsInCalls(stub func(string, string, string) (*http.Response, error)) {
+	fake.deleteTopicPermissionsInMutex.Lock()
+	defer fake.deleteTopicPermissionsInMutex.Unlock()
+	fake.DeleteTopicPermissionsInStub = stub
+}
+
+func (fake *FakeClient) DeleteTopicPermissionsInArgsForCall(i int) (string, string, string) {
+	fake.deleteTopicPermissionsInMutex.RLock()
+	defer fake.deleteTopicPermissionsInMutex.RUnlock()
+	argsForCall := fake.deleteTopicPermissionsInArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteTopicPermissionsInReturns(result1 *http.Response, result2 error) {
+	fake.deleteTopicPermissionsInMutex.Lock()
+	defer fake.deleteTopicPermissionsInMutex.Unlock()
+	fake.DeleteTopicPermissionsInStub = nil
+	fake.deleteTopicPermissionsInReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteTopicPermissionsInReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteTopicPermissionsInMutex.Lock()
+	defer fake.deleteTopicPermissionsInMutex.Unlock()
+	fake.DeleteTopicPermissionsInStub = nil
+	if fake.deleteTopicPermissionsInReturnsOnCall == nil {
+		fake.deleteTopicPermissionsInReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteTopicPermissionsInReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DeleteUser(arg1 string) (*http.Response, error) {
 	fake.deleteUserMutex.Lock()
 	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
@@ -1282,6 +1626,71 @@ func (fake *FakeClient) DeleteUserReturnsOnCall(i int, result1 *http.Response, r
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DeleteUserLimits(arg1 string, arg2 rabbithole.UserLimits) (*http.Response, error) {
+	fake.deleteUserLimitsMutex.Lock()
+	ret, specificReturn := fake.deleteUserLimitsReturnsOnCall[len(fake.deleteUserLimitsArgsForCall)]
+	fake.deleteUserLimitsArgsForCall = append(fake.deleteUserLimitsArgsForCall, struct {
+		arg1 string
+		arg2 rabbithole.UserLimits
+	}{arg1, arg2})
+	stub := fake.DeleteUserLimitsStub
+	fakeReturns := fake.deleteUserLimitsReturns
+	fake.recordInvocation("DeleteUserLimits", []interface{}{arg1, arg2})
+	fake.deleteUserLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteUserLimitsCallCount() int {
+	fake.deleteUserLimitsMutex.RLock()
+	defer fake.deleteUserLimitsMutex.RUnlock()
+	return len(fake.deleteUserLimitsArgsForCall)
+}
+
+func (fake *FakeClient) DeleteUserLimitsCalls(stub func(string, rabbithole.UserLimits) (*http.Response, error)) {
+	fake.deleteUserLimitsMutex.Lock()
+	defer fake.deleteUserLimitsMutex.Unlock()
+	fake.DeleteUserLimitsStub = stub
+}
+
+func (fake *FakeClient) DeleteUserLimitsArgsForCall(i int) (string, rabbithole.UserLimits) {
+	fake.deleteUserLimitsMutex.RLock()
+	defer fake.deleteUserLimitsMutex.RUnlock()
+	argsForCall := fake.deleteUserLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DeleteUserLimitsReturns(result1 *http.Response, result2 error) {
+	fake.deleteUserLimitsMutex.Lock()
+	defer fake.deleteUserLimitsMutex.Unlock()
+	fake.DeleteUserLimitsStub = nil
+	fake.deleteUserLimitsReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteUserLimitsReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteUserLimitsMutex.Lock()
+	defer fake.deleteUserLimitsMutex.Unlock()
+	fake.DeleteUserLimitsStub = nil
+	if fake.deleteUserLimitsReturnsOnCall == nil {
+		fake.deleteUserLimitsReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteUserLimitsReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DeleteVhost(arg1 string) (*http.Response, error) {
 	fake.deleteVhostMutex.Lock()
 	ret, specificReturn := fake.deleteVhostReturnsOnCall[len(fake.deleteVhostArgsForCall)]
@@ -1346,18 +1755,19 @@ func (fake *FakeClient) DeleteVhostReturnsOnCall(i int, result1 *http.Response,
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetVhost(arg1 string) (*rabbithole.VhostInfo, error) {
-	fake.getVhostMutex.Lock()
-	ret, specificReturn := fake.getVhostReturnsOnCall[len(fake.getVhostArgsForCall)]
-	fake.getVhostArgsForCall = append(fake.getVhostArgsForCall, struct {
+func (fake *FakeClient) DeleteVhostLimits(arg1 string, arg2 rabbithole.VhostLimits) (*http.Response, error) {
+	fake.deleteVhostLimitsMutex.Lock()
+	ret, specificReturn := fake.deleteVhostLimitsReturnsOnCall[len(fake.deleteVhostLimitsArgsForCall)]
+	fake.deleteVhostLimitsArgsForCall = append(fake.deleteVhostLimitsArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	stub := fake.GetVhostStub
-	fakeReturns := fake.getVhostReturns
-	fake.recordInvocation("GetVhost", []interface{}{arg1})
-	fake.getVhostMutex.Unlock()
+		arg2 rabbithole.VhostLimits
+	}{arg1, arg2})
+	stub := fake.DeleteVhostLimitsStub
+	fakeReturns := fake.deleteVhostLimitsReturns
+	fake.recordInvocation("DeleteVhostLimits", []interface{}{arg1, arg2})
+	fake.deleteVhostLimitsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1365,41 +1775,429 @@ func (fake *FakeClient) GetVhost(arg1 string) (*rabbithole.VhostInfo, error) {
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) GetVhostCallCount() int {
-	fake.getVhostMutex.RLock()
-	defer fake.getVhostMutex.RUnlock()
-	return len(fake.getVhostArgsForCall)
+func (fake *FakeClient) DeleteVhostLimitsCallCount() int {
+	fake.deleteVhostLimitsMutex.RLock()
+	defer fake.deleteVhostLimitsMutex.RUnlock()
+	return len(fake.deleteVhostLimitsArgsForCall)
 }
 
-func (fake *FakeClient) GetVhostCalls(stub func(string) (*rabbithole.VhostInfo, error)) {
-	fake.getVhostMutex.Lock()
-	defer fake.getVhostMutex.Unlock()
-	fake.GetVhostStub = stub
+func (fake *FakeClient) DeleteVhostLimitsCalls(stub func(string, rabbithole.VhostLimits) (*http.Response, error)) {
+	fake.deleteVhostLimitsMutex.Lock()
+	defer fake.deleteVhostLimitsMutex.Unlock()
+	fake.DeleteVhostLimitsStub = stub
 }
 
-func (fake *FakeClient) GetVhostArgsForCall(i int) string {
-	fake.getVhostMutex.RLock()
-	defer fake.getVhostMutex.RUnlock()
-	argsForCall := fake.getVhostArgsForCall[i]
-	return argsForCall.arg1
+func (fake *FakeClient) DeleteVhostLimitsArgsForCall(i int) (string, rabbithole.VhostLimits) {
+	fake.deleteVhostLimitsMutex.RLock()
+	defer fake.deleteVhostLimitsMutex.RUnlock()
+	argsForCall := fake.deleteVhostLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeClient) GetVhostReturns(result1 *rabbithole.VhostInfo, result2 error) {
-	fake.getVhostMutex.Lock()
-	defer fake.getVhostMutex.Unlock()
-	fake.GetVhostStub = nil
-	fake.getVhostReturns = struct {
-		result1 *rabbithole.VhostInfo
+func (fake *FakeClient) DeleteVhostLimitsReturns(result1 *http.Response, result2 error) {
+	fake.deleteVhostLimitsMutex.Lock()
+	defer fake.deleteVhostLimitsMutex.Unlock()
+	fake.DeleteVhostLimitsStub = nil
+	fake.deleteVhostLimitsReturns = struct {
+		result1 *http.Response
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) GetVhostReturnsOnCall(i int, result1 *rabbithole.VhostInfo, result2 error) {
-	fake.getVhostMutex.Lock()
-	defer fake.getVhostMutex.Unlock()
-	fake.GetVhostStub = nil
-	if fake.getVhostReturnsOnCall == nil {
-		fake.getVhostReturnsOnCall = make(map[int]struct {
+func (fake *FakeClient) DeleteVhostLimitsReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.deleteVhostLimitsMutex.Lock()
+	defer fake.deleteVhostLimitsMutex.Unlock()
+	fake.DeleteVhostLimitsStub = nil
+	if fake.deleteVhostLimitsReturnsOnCall == nil {
+		fake.deleteVhostLimitsReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.deleteVhostLimitsReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetExchange(arg1 string, arg2 string) (*rabbithole.DetailedExchangeInfo, error) {
+	fake.getExchangeMutex.Lock()
+	ret, specificReturn := fake.getExchangeReturnsOnCall[len(fake.getExchangeArgsForCall)]
+	fake.getExchangeArgsForCall = append(fake.getExchangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetExchangeStub
+	fakeReturns := fake.getExchangeReturns
+	fake.recordInvocation("GetExchange", []interface{}{arg1, arg2})
+	fake.getExchangeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetExchangeCallCount() int {
+	fake.getExchangeMutex.RLock()
+	defer fake.getExchangeMutex.RUnlock()
+	return len(fake.getExchangeArgsForCall)
+}
+
+func (fake *FakeClient) GetExchangeCalls(stub func(string, string) (*rabbithole.DetailedExchangeInfo, error)) {
+	fake.getExchangeMutex.Lock()
+	defer fake.getExchangeMutex.Unlock()
+	fake.GetExchangeStub = stub
+}
+
+func (fake *FakeClient) GetExchangeArgsForCall(i int) (string, string) {
+	fake.getExchangeMutex.RLock()
+	defer fake.getExchangeMutex.RUnlock()
+	argsForCall := fake.getExchangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetExchangeReturns(result1 *rabbithole.DetailedExchangeInfo, result2 error) {
+	fake.getExchangeMutex.Lock()
+	defer fake.getExchangeMutex.Unlock()
+	fake.GetExchangeStub = nil
+	fake.getExchangeReturns = struct {
+		result1 *rabbithole.DetailedExchangeInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetExchangeReturnsOnCall(i int, result1 *rabbithole.DetailedExchangeInfo, result2 error) {
+	fake.getExchangeMutex.Lock()
+	defer fake.getExchangeMutex.Unlock()
+	fake.GetExchangeStub = nil
+	if fake.getExchangeReturnsOnCall == nil {
+		fake.getExchangeReturnsOnCall = make(map[int]struct {
+			result1 *rabbithole.DetailedExchangeInfo
+			result2 error
+		})
+	}
+	fake.getExchangeReturnsOnCall[i] = struct {
+		result1 *rabbithole.DetailedExchangeInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetPolicy(arg1 string, arg2 string) (*rabbithole.Policy, error) {
+	fake.getPolicyMutex.Lock()
+	ret, specificReturn := fake.getPolicyReturnsOnCall[len(fake.getPolicyArgsForCall)]
+	fake.getPolicyArgsForCall = append(fake.getPolicyArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPolicyStub
+	fakeReturns := fake.getPolicyReturns
+	fake.recordInvocation("GetPolicy", []interface{}{arg1, arg2})
+	fake.getPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetPolicyCallCount() int {
+	fake.getPolicyMutex.RLock()
+	defer fake.getPolicyMutex.RUnlock()
+	return len(fake.getPolicyArgsForCall)
+}
+
+func (fake *FakeClient) GetPolicyCalls(stub func(string, string) (*rabbithole.Policy, error)) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = stub
+}
+
+func (fake *FakeClient) GetPolicyArgsForCall(i int) (string, string) {
+	fake.getPolicyMutex.RLock()
+	defer fake.getPolicyMutex.RUnlock()
+	argsForCall := fake.getPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetPolicyReturns(result1 *rabbithole.Policy, result2 error) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = nil
+	fake.getPolicyReturns = struct {
+		result1 *rabbithole.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetPolicyReturnsOnCall(i int, result1 *rabbithole.Policy, result2 error) {
+	fake.getPolicyMutex.Lock()
+	defer fake.getPolicyMutex.Unlock()
+	fake.GetPolicyStub = nil
+	if fake.getPolicyReturnsOnCall == nil {
+		fake.getPolicyReturnsOnCall = make(map[int]struct {
+			result1 *rabbithole.Policy
+			result2 error
+		})
+	}
+	fake.getPolicyReturnsOnCall[i] = struct {
+		result1 *rabbithole.Policy
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetQueue(arg1 string, arg2 string) (*rabbithole.DetailedQueueInfo, error) {
+	fake.getQueueMutex.Lock()
+	ret, specificReturn := fake.getQueueReturnsOnCall[len(fake.getQueueArgsForCall)]
+	fake.getQueueArgsForCall = append(fake.getQueueArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetQueueStub
+	fakeReturns := fake.getQueueReturns
+	fake.recordInvocation("GetQueue", []interface{}{arg1, arg2})
+	fake.getQueueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetQueueCallCount() int {
+	fake.getQueueMutex.RLock()
+	defer fake.getQueueMutex.RUnlock()
+	return len(fake.getQueueArgsForCall)
+}
+
+func (fake *FakeClient) GetQueueCalls(stub func(string, string) (*rabbithole.DetailedQueueInfo, error)) {
+	fake.getQueueMutex.Lock()
+	defer fake.getQueueMutex.Unlock()
+	fake.GetQueueStub = stub
+}
+
+func (fake *FakeClient) GetQueueArgsForCall(i int) (string, string) {
+	fake.getQueueMutex.RLock()
+	defer fake.getQueueMutex.RUnlock()
+	argsForCall := fake.getQueueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetQueueReturns(result1 *rabbithole.DetailedQueueInfo, result2 error) {
+	fake.getQueueMutex.Lock()
+	defer fake.getQueueMutex.Unlock()
+	fake.GetQueueStub = nil
+	fake.getQueueReturns = struct {
+		result1 *rabbithole.DetailedQueueInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetQueueReturnsOnCall(i int, result1 *rabbithole.DetailedQueueInfo, result2 error) {
+	fake.getQueueMutex.Lock()
+	defer fake.getQueueMutex.Unlock()
+	fake.GetQueueStub = nil
+	if fake.getQueueReturnsOnCall == nil {
+		fake.getQueueReturnsOnCall = make(map[int]struct {
+			result1 *rabbithole.DetailedQueueInfo
+			result2 error
+		})
+	}
+	fake.getQueueReturnsOnCall[i] = struct {
+		result1 *rabbithole.DetailedQueueInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetShovelStatus(arg1 string, arg2 string) (*rabbitmqclient.ShovelStatus, error) {
+	fake.getShovelStatusMutex.Lock()
+	ret, specificReturn := fake.getShovelStatusReturnsOnCall[len(fake.getShovelStatusArgsForCall)]
+	fake.getShovelStatusArgsForCall = append(fake.getShovelStatusArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetShovelStatusStub
+	fakeReturns := fake.getShovelStatusReturns
+	fake.recordInvocation("GetShovelStatus", []interface{}{arg1, arg2})
+	fake.getShovelStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetShovelStatusCallCount() int {
+	fake.getShovelStatusMutex.RLock()
+	defer fake.getShovelStatusMutex.RUnlock()
+	return len(fake.getShovelStatusArgsForCall)
+}
+
+func (fake *FakeClient) GetShovelStatusCalls(stub func(string, string) (*rabbitmqclient.ShovelStatus, error)) {
+	fake.getShovelStatusMutex.Lock()
+	defer fake.getShovelStatusMutex.Unlock()
+	fake.GetShovelStatusStub = stub
+}
+
+func (fake *FakeClient) GetShovelStatusArgsForCall(i int) (string, string) {
+	fake.getShovelStatusMutex.RLock()
+	defer fake.getShovelStatusMutex.RUnlock()
+	argsForCall := fake.getShovelStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetShovelStatusReturns(result1 *rabbitmqclient.ShovelStatus, result2 error) {
+	fake.getShovelStatusMutex.Lock()
+	defer fake.getShovelStatusMutex.Unlock()
+	fake.GetShovelStatusStub = nil
+	fake.getShovelStatusReturns = struct {
+		result1 *rabbitmqclient.ShovelStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetShovelStatusReturnsOnCall(i int, result1 *rabbitmqclient.ShovelStatus, result2 error) {
+	fake.getShovelStatusMutex.Lock()
+	defer fake.getShovelStatusMutex.Unlock()
+	fake.GetShovelStatusStub = nil
+	if fake.getShovelStatusReturnsOnCall == nil {
+		fake.getShovelStatusReturnsOnCall = make(map[int]struct {
+			result1 *rabbitmqclient.ShovelStatus
+			result2 error
+		})
+	}
+	fake.getShovelStatusReturnsOnCall[i] = struct {
+		result1 *rabbitmqclient.ShovelStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetUserLimits(arg1 string) ([]rabbithole.UserLimitsInfo, error) {
+	fake.getUserLimitsMutex.Lock()
+	ret, specificReturn := fake.getUserLimitsReturnsOnCall[len(fake.getUserLimitsArgsForCall)]
+	fake.getUserLimitsArgsForCall = append(fake.getUserLimitsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetUserLimitsStub
+	fakeReturns := fake.getUserLimitsReturns
+	fake.recordInvocation("GetUserLimits", []interface{}{arg1})
+	fake.getUserLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetUserLimitsCallCount() int {
+	fake.getUserLimitsMutex.RLock()
+	defer fake.getUserLimitsMutex.RUnlock()
+	return len(fake.getUserLimitsArgsForCall)
+}
+
+func (fake *FakeClient) GetUserLimitsCalls(stub func(string) ([]rabbithole.UserLimitsInfo, error)) {
+	fake.getUserLimitsMutex.Lock()
+	defer fake.getUserLimitsMutex.Unlock()
+	fake.GetUserLimitsStub = stub
+}
+
+func (fake *FakeClient) GetUserLimitsArgsForCall(i int) string {
+	fake.getUserLimitsMutex.RLock()
+	defer fake.getUserLimitsMutex.RUnlock()
+	argsForCall := fake.getUserLimitsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) GetUserLimitsReturns(result1 []rabbithole.UserLimitsInfo, result2 error) {
+	fake.getUserLimitsMutex.Lock()
+	defer fake.getUserLimitsMutex.Unlock()
+	fake.GetUserLimitsStub = nil
+	fake.getUserLimitsReturns = struct {
+		result1 []rabbithole.UserLimitsInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetUserLimitsReturnsOnCall(i int, result1 []rabbithole.UserLimitsInfo, result2 error) {
+	fake.getUserLimitsMutex.Lock()
+	defer fake.getUserLimitsMutex.Unlock()
+	fake.GetUserLimitsStub = nil
+	if fake.getUserLimitsReturnsOnCall == nil {
+		fake.getUserLimitsReturnsOnCall = make(map[int]struct {
+			result1 []rabbithole.UserLimitsInfo
+			result2 error
+		})
+	}
+	fake.getUserLimitsReturnsOnCall[i] = struct {
+		result1 []rabbithole.UserLimitsInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetVhost(arg1 string) (*rabbithole.VhostInfo, error) {
+	fake.getVhostMutex.Lock()
+	ret, specificReturn := fake.getVhostReturnsOnCall[len(fake.getVhostArgsForCall)]
+	fake.getVhostArgsForCall = append(fake.getVhostArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetVhostStub
+	fakeReturns := fake.getVhostReturns
+	fake.recordInvocation("GetVhost", []interface{}{arg1})
+	fake.getVhostMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetVhostCallCount() int {
+	fake.getVhostMutex.RLock()
+	defer fake.getVhostMutex.RUnlock()
+	return len(fake.getVhostArgsForCall)
+}
+
+func (fake *FakeClient) GetVhostCalls(stub func(string) (*rabbithole.VhostInfo, error)) {
+	fake.getVhostMutex.Lock()
+	defer fake.getVhostMutex.Unlock()
+	fake.GetVhostStub = stub
+}
+
+func (fake *FakeClient) GetVhostArgsForCall(i int) string {
+	fake.getVhostMutex.RLock()
+	defer fake.getVhostMutex.RUnlock()
+	argsForCall := fake.getVhostArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) GetVhostReturns(result1 *rabbithole.VhostInfo, result2 error) {
+	fake.getVhostMutex.Lock()
+	defer fake.getVhostMutex.Unlock()
+	fake.GetVhostStub = nil
+	fake.getVhostReturns = struct {
+		result1 *rabbithole.VhostInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetVhostReturnsOnCall(i int, result1 *rabbithole.VhostInfo, result2 error) {
+	fake.getVhostMutex.Lock()
+	defer fake.getVhostMutex.Unlock()
+	fake.GetVhostStub = nil
+	if fake.getVhostReturnsOnCall == nil {
+		fake.getVhostReturnsOnCall = make(map[int]struct {
 			result1 *rabbithole.VhostInfo
 			result2 error
 		})
@@ -1410,6 +2208,70 @@ func (fake *FakeClient) GetVhostReturnsOnCall(i int, result1 *rabbithole.VhostIn
 	}{result1, result2}
 }
 
+func (fake *FakeClient) GetVhostLimits(arg1 string) ([]rabbithole.VhostLimitsInfo, error) {
+	fake.getVhostLimitsMutex.Lock()
+	ret, specificReturn := fake.getVhostLimitsReturnsOnCall[len(fake.getVhostLimitsArgsForCall)]
+	fake.getVhostLimitsArgsForCall = append(fake.getVhostLimitsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetVhostLimitsStub
+	fakeReturns := fake.getVhostLimitsReturns
+	fake.recordInvocation("GetVhostLimits", []interface{}{arg1})
+	fake.getVhostLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) GetVhostLimitsCallCount() int {
+	fake.getVhostLimitsMutex.RLock()
+	defer fake.getVhostLimitsMutex.RUnlock()
+	return len(fake.getVhostLimitsArgsForCall)
+}
+
+func (fake *FakeClient) GetVhostLimitsCalls(stub func(string) ([]rabbithole.VhostLimitsInfo, error)) {
+	fake.getVhostLimitsMutex.Lock()
+	defer fake.getVhostLimitsMutex.Unlock()
+	fake.GetVhostLimitsStub = stub
+}
+
+func (fake *FakeClient) GetVhostLimitsArgsForCall(i int) string {
+	fake.getVhostLimitsMutex.RLock()
+	defer fake.getVhostLimitsMutex.RUnlock()
+	argsForCall := fake.getVhostLimitsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) GetVhostLimitsReturns(result1 []rabbithole.VhostLimitsInfo, result2 error) {
+	fake.getVhostLimitsMutex.Lock()
+	defer fake.getVhostLimitsMutex.Unlock()
+	fake.GetVhostLimitsStub = nil
+	fake.getVhostLimitsReturns = struct {
+		result1 []rabbithole.VhostLimitsInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetVhostLimitsReturnsOnCall(i int, result1 []rabbithole.VhostLimitsInfo, result2 error) {
+	fake.getVhostLimitsMutex.Lock()
+	defer fake.getVhostLimitsMutex.Unlock()
+	fake.GetVhostLimitsStub = nil
+	if fake.getVhostLimitsReturnsOnCall == nil {
+		fake.getVhostLimitsReturnsOnCall = make(map[int]struct {
+			result1 []rabbithole.VhostLimitsInfo
+			result2 error
+		})
+	}
+	fake.getVhostLimitsReturnsOnCall[i] = struct {
+		result1 []rabbithole.VhostLimitsInfo
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListExchangeBindingsBetween(arg1 string, arg2 string, arg3 string) ([]rabbithole.BindingInfo, error) {
 	fake.listExchangeBindingsBetweenMutex.Lock()
 	ret, specificReturn := fake.listExchangeBindingsBetweenReturnsOnCall[len(fake.listExchangeBindingsBetweenArgsForCall)]
@@ -1476,6 +2338,70 @@ func (fake *FakeClient) ListExchangeBindingsBetweenReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListFederationLinksIn(arg1 string) ([]map[string]interface{}, error) {
+	fake.listFederationLinksInMutex.Lock()
+	ret, specificReturn := fake.listFederationLinksInReturnsOnCall[len(fake.listFederationLinksInArgsForCall)]
+	fake.listFederationLinksInArgsForCall = append(fake.listFederationLinksInArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ListFederationLinksInStub
+	fakeReturns := fake.listFederationLinksInReturns
+	fake.recordInvocation("ListFederationLinksIn", []interface{}{arg1})
+	fake.listFederationLinksInMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListFederationLinksInCallCount() int {
+	fake.listFederationLinksInMutex.RLock()
+	defer fake.listFederationLinksInMutex.RUnlock()
+	return len(fake.listFederationLinksInArgsForCall)
+}
+
+func (fake *FakeClient) ListFederationLinksInCalls(stub func(string) ([]map[string]interface{}, error)) {
+	fake.listFederationLinksInMutex.Lock()
+	defer fake.listFederationLinksInMutex.Unlock()
+	fake.ListFederationLinksInStub = stub
+}
+
+func (fake *FakeClient) ListFederationLinksInArgsForCall(i int) string {
+	fake.listFederationLinksInMutex.RLock()
+	defer fake.listFederationLinksInMutex.RUnlock()
+	argsForCall := fake.listFederationLinksInArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListFederationLinksInReturns(result1 []map[string]interface{}, result2 error) {
+	fake.listFederationLinksInMutex.Lock()
+	defer fake.listFederationLinksInMutex.Unlock()
+	fake.ListFederationLinksInStub = nil
+	fake.listFederationLinksInReturns = struct {
+		result1 []map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListFederationLinksInReturnsOnCall(i int, result1 []map[string]interface{}, result2 error) {
+	fake.listFederationLinksInMutex.Lock()
+	defer fake.listFederationLinksInMutex.Unlock()
+	fake.ListFederationLinksInStub = nil
+	if fake.listFederationLinksInReturnsOnCall == nil {
+		fake.listFederationLinksInReturnsOnCall = make(map[int]struct {
+			result1 []map[string]interface{}
+			result2 error
+		})
+	}
+	fake.listFederationLinksInReturnsOnCall[i] = struct {
+		result1 []map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListQueueBindingsBetween(arg1 string, arg2 string, arg3 string) ([]rabbithole.BindingInfo, error) {
 	fake.listQueueBindingsBetweenMutex.Lock()
 	ret, specificReturn := fake.listQueueBindingsBetweenReturnsOnCall[len(fake.listQueueBindingsBetweenArgsForCall)]
@@ -1805,6 +2731,73 @@ func (fake *FakeClient) PutPolicyReturnsOnCall(i int, result1 *http.Response, re
 	}{result1, result2}
 }
 
+func (fake *FakeClient) PutRuntimeParameter(arg1 string, arg2 string, arg3 string, arg4 interface{}) (*http.Response, error) {
+	fake.putRuntimeParameterMutex.Lock()
+	ret, specificReturn := fake.putRuntimeParameterReturnsOnCall[len(fake.putRuntimeParameterArgsForCall)]
+	fake.putRuntimeParameterArgsForCall = append(fake.putRuntimeParameterArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 interface{}
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PutRuntimeParameterStub
+	fakeReturns := fake.putRuntimeParameterReturns
+	fake.recordInvocation("PutRuntimeParameter", []interface{}{arg1, arg2, arg3, arg4})
+	fake.putRuntimeParameterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PutRuntimeParameterCallCount() int {
+	fake.putRuntimeParameterMutex.RLock()
+	defer fake.putRuntimeParameterMutex.RUnlock()
+	return len(fake.putRuntimeParameterArgsForCall)
+}
+
+func (fake *FakeClient) PutRuntimeParameterCalls(stub func(string, string, string, interface{}) (*http.Response, error)) {
+	fake.putRuntimeParameterMutex.Lock()
+	defer fake.putRuntimeParameterMutex.Unlock()
+	fake.PutRuntimeParameterStub = stub
+}
+
+func (fake *FakeClient) PutRuntimeParameterArgsForCall(i int) (string, string, string, interface{}) {
+	fake.putRuntimeParameterMutex.RLock()
+	defer fake.putRuntimeParameterMutex.RUnlock()
+	argsForCall := fake.putRuntimeParameterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) PutRuntimeParameterReturns(result1 *http.Response, result2 error) {
+	fake.putRuntimeParameterMutex.Lock()
+	defer fake.putRuntimeParameterMutex.Unlock()
+	fake.PutRuntimeParameterStub = nil
+	fake.putRuntimeParameterReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PutRuntimeParameterReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.putRuntimeParameterMutex.Lock()
+	defer fake.putRuntimeParameterMutex.Unlock()
+	fake.PutRuntimeParameterStub = nil
+	if fake.putRuntimeParameterReturnsOnCall == nil {
+		fake.putRuntimeParameterReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.putRuntimeParameterReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) PutUser(arg1 string, arg2 rabbithole.UserSettings) (*http.Response, error) {
 	fake.putUserMutex.Lock()
 	ret, specificReturn := fake.putUserReturnsOnCall[len(fake.putUserArgsForCall)]
@@ -1870,6 +2863,71 @@ func (fake *FakeClient) PutUserReturnsOnCall(i int, result1 *http.Response, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) PutUserLimits(arg1 string, arg2 rabbithole.UserLimitsValues) (*http.Response, error) {
+	fake.putUserLimitsMutex.Lock()
+	ret, specificReturn := fake.putUserLimitsReturnsOnCall[len(fake.putUserLimitsArgsForCall)]
+	fake.putUserLimitsArgsForCall = append(fake.putUserLimitsArgsForCall, struct {
+		arg1 string
+		arg2 rabbithole.UserLimitsValues
+	}{arg1, arg2})
+	stub := fake.PutUserLimitsStub
+	fakeReturns := fake.putUserLimitsReturns
+	fake.recordInvocation("PutUserLimits", []interface{}{arg1, arg2})
+	fake.putUserLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PutUserLimitsCallCount() int {
+	fake.putUserLimitsMutex.RLock()
+	defer fake.putUserLimitsMutex.RUnlock()
+	return len(fake.putUserLimitsArgsForCall)
+}
+
+func (fake *FakeClient) PutUserLimitsCalls(stub func(string, rabbithole.UserLimitsValues) (*http.Response, error)) {
+	fake.putUserLimitsMutex.Lock()
+	defer fake.putUserLimitsMutex.Unlock()
+	fake.PutUserLimitsStub = stub
+}
+
+func (fake *FakeClient) PutUserLimitsArgsForCall(i int) (string, rabbithole.UserLimitsValues) {
+	fake.putUserLimitsMutex.RLock()
+	defer fake.putUserLimitsMutex.RUnlock()
+	argsForCall := fake.putUserLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) PutUserLimitsReturns(result1 *http.Response, result2 error) {
+	fake.putUserLimitsMutex.Lock()
+	defer fake.putUserLimitsMutex.Unlock()
+	fake.PutUserLimitsStub = nil
+	fake.putUserLimitsReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PutUserLimitsReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.putUserLimitsMutex.Lock()
+	defer fake.putUserLimitsMutex.Unlock()
+	fake.PutUserLimitsStub = nil
+	if fake.putUserLimitsReturnsOnCall == nil {
+		fake.putUserLimitsReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.putUserLimitsReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) PutVhost(arg1 string, arg2 rabbithole.VhostSettings) (*http.Response, error) {
 	fake.putVhostMutex.Lock()
 	ret, specificReturn := fake.putVhostReturnsOnCall[len(fake.putVhostArgsForCall)]
@@ -1935,6 +2993,71 @@ func (fake *FakeClient) PutVhostReturnsOnCall(i int, result1 *http.Response, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) PutVhostLimits(arg1 string, arg2 rabbithole.VhostLimitsValues) (*http.Response, error) {
+	fake.putVhostLimitsMutex.Lock()
+	ret, specificReturn := fake.putVhostLimitsReturnsOnCall[len(fake.putVhostLimitsArgsForCall)]
+	fake.putVhostLimitsArgsForCall = append(fake.putVhostLimitsArgsForCall, struct {
+		arg1 string
+		arg2 rabbithole.VhostLimitsValues
+	}{arg1, arg2})
+	stub := fake.PutVhostLimitsStub
+	fakeReturns := fake.putVhostLimitsReturns
+	fake.recordInvocation("PutVhostLimits", []interface{}{arg1, arg2})
+	fake.putVhostLimitsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PutVhostLimitsCallCount() int {
+	fake.putVhostLimitsMutex.RLock()
+	defer fake.putVhostLimitsMutex.RUnlock()
+	return len(fake.putVhostLimitsArgsForCall)
+}
+
+func (fake *FakeClient) PutVhostLimitsCalls(stub func(string, rabbithole.VhostLimitsValues) (*http.Response, error)) {
+	fake.putVhostLimitsMutex.Lock()
+	defer fake.putVhostLimitsMutex.Unlock()
+	fake.PutVhostLimitsStub = stub
+}
+
+func (fake *FakeClient) PutVhostLimitsArgsForCall(i int) (string, rabbithole.VhostLimitsValues) {
+	fake.putVhostLimitsMutex.RLock()
+	defer fake.putVhostLimitsMutex.RUnlock()
+	argsForCall := fake.putVhostLimitsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) PutVhostLimitsReturns(result1 *http.Response, result2 error) {
+	fake.putVhostLimitsMutex.Lock()
+	defer fake.putVhostLimitsMutex.Unlock()
+	fake.PutVhostLimitsStub = nil
+	fake.putVhostLimitsReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PutVhostLimitsReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.putVhostLimitsMutex.Lock()
+	defer fake.putVhostLimitsMutex.Unlock()
+	fake.PutVhostLimitsStub = nil
+	if fake.putVhostLimitsReturnsOnCall == nil {
+		fake.putVhostLimitsReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.putVhostLimitsReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) UpdatePermissionsIn(arg1 string, arg2 string, arg3 rabbithole.Permissions) (*http.Response, error) {
 	fake.updatePermissionsInMutex.Lock()
 	ret, specificReturn := fake.updatePermissionsInReturnsOnCall[len(fake.updatePermissionsInArgsForCall)]
@@ -2001,6 +3124,72 @@ func (fake *FakeClient) UpdatePermissionsInReturnsOnCall(i int, result1 *http.Re
 	}{result1, result2}
 }
 
+func (fake *FakeClient) UpdateTopicPermissionsIn(arg1 string, arg2 string, arg3 rabbithole.TopicPermissions) (*http.Response, error) {
+	fake.updateTopicPermissionsInMutex.Lock()
+	ret, specificReturn := fake.updateTopicPermissionsInReturnsOnCall[len(fake.updateTopicPermissionsInArgsForCall)]
+	fake.updateTopicPermissionsInArgsForCall = append(fake.updateTopicPermissionsInArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 rabbithole.TopicPermissions
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateTopicPermissionsInStub
+	fakeReturns := fake.updateTopicPermissionsInReturns
+	fake.recordInvocation("UpdateTopicPermissionsIn", []interface{}{arg1, arg2, arg3})
+	fake.updateTopicPermissionsInMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpdateTopicPermissionsInCallCount() int {
+	fake.updateTopicPermissionsInMutex.RLock()
+	defer fake.updateTopicPermissionsInMutex.RUnlock()
+	return len(fake.updateTopicPermissionsInArgsForCall)
+}
+
+func (fake *FakeClient) UpdateTopicPermissionsInCalls(stub func(string, string, rabbithole.TopicPermissions) (*http.Response, error)) {
+	fake.updateTopicPermissionsInMutex.Lock()
+	defer fake.updateTopicPermissionsInMutex.Unlock()
+	fake.UpdateTopicPermissionsInStub = stub
+}
+
+func (fake *FakeClient) UpdateTopicPermissionsInArgsForCall(i int) (string, string, rabbithole.TopicPermissions) {
+	fake.updateTopicPermissionsInMutex.RLock()
+	defer fake.updateTopicPermissionsInMutex.RUnlock()
+	argsForCall := fake.updateTopicPermissionsInArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UpdateTopicPermissionsInReturns(result1 *http.Response, result2 error) {
+	fake.updateTopicPermissionsInMutex.Lock()
+	defer fake.updateTopicPermissionsInMutex.Unlock()
+	fake.UpdateTopicPermissionsInStub = nil
+	fake.updateTopicPermissionsInReturns = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateTopicPermissionsInReturnsOnCall(i int, result1 *http.Response, result2 error) {
+	fake.updateTopicPermissionsInMutex.Lock()
+	defer fake.updateTopicPermissionsInMutex.Unlock()
+	fake.UpdateTopicPermissionsInStub = nil
+	if fake.updateTopicPermissionsInReturnsOnCall == nil {
+		fake.updateTopicPermissionsInReturnsOnCall = make(map[int]struct {
+			result1 *http.Response
+			result2 error
+		})
+	}
+	fake.updateTopicPermissionsInReturnsOnCall[i] = struct {
+		result1 *http.Response
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2028,16 +3217,38 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.deletePolicyMutex.RUnlock()
 	fake.deleteQueueMutex.RLock()
 	defer fake.deleteQueueMutex.RUnlock()
+	fake.deleteRuntimeParameterMutex.RLock()
+	defer fake.deleteRuntimeParameterMutex.RUnlock()
 	fake.deleteShovelMutex.RLock()
 	defer fake.deleteShovelMutex.RUnlock()
+	fake.deleteTopicPermissionsInMutex.RLock()
+	defer fake.deleteTopicPermissionsInMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
 	defer fake.deleteUserMutex.RUnlock()
+	fake.deleteUserLimitsMutex.RLock()
+	defer fake.deleteUserLimitsMutex.RUnlock()
 	fake.deleteVhostMutex.RLock()
 	defer fake.deleteVhostMutex.RUnlock()
+	fake.deleteVhostLimitsMutex.RLock()
+	defer fake.deleteVhostLimitsMutex.RUnlock()
+	fake.getExchangeMutex.RLock()
+	defer fake.getExchangeMutex.RUnlock()
+	fake.getPolicyMutex.RLock()
+	defer fake.getPolicyMutex.RUnlock()
+	fake.getQueueMutex.RLock()
+	defer fake.getQueueMutex.RUnlock()
+	fake.getShovelStatusMutex.RLock()
+	defer fake.getShovelStatusMutex.RUnlock()
+	fake.getUserLimitsMutex.RLock()
+	defer fake.getUserLimitsMutex.RUnlock()
 	fake.getVhostMutex.RLock()
 	defer fake.getVhostMutex.RUnlock()
+	fake.getVhostLimitsMutex.RLock()
+	defer fake.getVhostLimitsMutex.RUnlock()
 	fake.listExchangeBindingsBetweenMutex.RLock()
 	defer fake.listExchangeBindingsBetweenMutex.RUnlock()
+	fake.listFederationLinksInMutex.RLock()
+	defer fake.listFederationLinksInMutex.RUnlock()
 	fake.listQueueBindingsBetweenMutex.RLock()
 	defer fake.listQueueBindingsBetweenMutex.RUnlock()
 	fake.putFederationUpstreamMutex.RLock()
@@ -2048,12 +3259,20 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.putOperatorPolicyMutex.RUnlock()
 	fake.putPolicyMutex.RLock()
 	defer fake.putPolicyMutex.RUnlock()
+	fake.putRuntimeParameterMutex.RLock()
+	defer fake.putRuntimeParameterMutex.RUnlock()
 	fake.putUserMutex.RLock()
 	defer fake.putUserMutex.RUnlock()
+	fake.putUserLimitsMutex.RLock()
+	defer fake.putUserLimitsMutex.RUnlock()
 	fake.putVhostMutex.RLock()
 	defer fake.putVhostMutex.RUnlock()
+	fake.putVhostLimitsMutex.RLock()
+	defer fake.putVhostLimitsMutex.RUnlock()
 	fake.updatePermissionsInMutex.RLock()
 	defer fake.updatePermissionsInMutex.RUnlock()
+	fake.updateTopicPermissionsInMutex.RLock()
+	defer fake.updateTopicPermissionsInMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
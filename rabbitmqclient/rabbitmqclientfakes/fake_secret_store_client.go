@@ -23,6 +23,34 @@ type FakeSecretStoreClient struct {
 		result2 string
 		result3 error
 	}
+	ReadLeasedCredentialsStub        func(string) (string, string, error)
+	readLeasedCredentialsMutex       sync.RWMutex
+	readLeasedCredentialsArgsForCall []struct {
+		arg1 string
+	}
+	readLeasedCredentialsReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	readLeasedCredentialsReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	WriteCredentialsStub        func(string, string, string) error
+	writeCredentialsMutex       sync.RWMutex
+	writeCredentialsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	writeCredentialsReturns struct {
+		result1 error
+	}
+	writeCredentialsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -94,11 +122,145 @@ func (fake *FakeSecretStoreClient) ReadCredentialsReturnsOnCall(i int, result1 s
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSecretStoreClient) ReadLeasedCredentials(arg1 string) (string, string, error) {
+	fake.readLeasedCredentialsMutex.Lock()
+	ret, specificReturn := fake.readLeasedCredentialsReturnsOnCall[len(fake.readLeasedCredentialsArgsForCall)]
+	fake.readLeasedCredentialsArgsForCall = append(fake.readLeasedCredentialsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ReadLeasedCredentialsStub
+	fakeReturns := fake.readLeasedCredentialsReturns
+	fake.recordInvocation("ReadLeasedCredentials", []interface{}{arg1})
+	fake.readLeasedCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSecretStoreClient) ReadLeasedCredentialsCallCount() int {
+	fake.readLeasedCredentialsMutex.RLock()
+	defer fake.readLeasedCredentialsMutex.RUnlock()
+	return len(fake.readLeasedCredentialsArgsForCall)
+}
+
+func (fake *FakeSecretStoreClient) ReadLeasedCredentialsCalls(stub func(string) (string, string, error)) {
+	fake.readLeasedCredentialsMutex.Lock()
+	defer fake.readLeasedCredentialsMutex.Unlock()
+	fake.ReadLeasedCredentialsStub = stub
+}
+
+func (fake *FakeSecretStoreClient) ReadLeasedCredentialsArgsForCall(i int) string {
+	fake.readLeasedCredentialsMutex.RLock()
+	defer fake.readLeasedCredentialsMutex.RUnlock()
+	argsForCall := fake.readLeasedCredentialsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecretStoreClient) ReadLeasedCredentialsReturns(result1 string, result2 string, result3 error) {
+	fake.readLeasedCredentialsMutex.Lock()
+	defer fake.readLeasedCredentialsMutex.Unlock()
+	fake.ReadLeasedCredentialsStub = nil
+	fake.readLeasedCredentialsReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecretStoreClient) ReadLeasedCredentialsReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.readLeasedCredentialsMutex.Lock()
+	defer fake.readLeasedCredentialsMutex.Unlock()
+	fake.ReadLeasedCredentialsStub = nil
+	if fake.readLeasedCredentialsReturnsOnCall == nil {
+		fake.readLeasedCredentialsReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.readLeasedCredentialsReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentials(arg1 string, arg2 string, arg3 string) error {
+	fake.writeCredentialsMutex.Lock()
+	ret, specificReturn := fake.writeCredentialsReturnsOnCall[len(fake.writeCredentialsArgsForCall)]
+	fake.writeCredentialsArgsForCall = append(fake.writeCredentialsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.WriteCredentialsStub
+	fakeReturns := fake.writeCredentialsReturns
+	fake.recordInvocation("WriteCredentials", []interface{}{arg1, arg2, arg3})
+	fake.writeCredentialsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentialsCallCount() int {
+	fake.writeCredentialsMutex.RLock()
+	defer fake.writeCredentialsMutex.RUnlock()
+	return len(fake.writeCredentialsArgsForCall)
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentialsCalls(stub func(string, string, string) error) {
+	fake.writeCredentialsMutex.Lock()
+	defer fake.writeCredentialsMutex.Unlock()
+	fake.WriteCredentialsStub = stub
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentialsArgsForCall(i int) (string, string, string) {
+	fake.writeCredentialsMutex.RLock()
+	defer fake.writeCredentialsMutex.RUnlock()
+	argsForCall := fake.writeCredentialsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentialsReturns(result1 error) {
+	fake.writeCredentialsMutex.Lock()
+	defer fake.writeCredentialsMutex.Unlock()
+	fake.WriteCredentialsStub = nil
+	fake.writeCredentialsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretStoreClient) WriteCredentialsReturnsOnCall(i int, result1 error) {
+	fake.writeCredentialsMutex.Lock()
+	defer fake.writeCredentialsMutex.Unlock()
+	fake.WriteCredentialsStub = nil
+	if fake.writeCredentialsReturnsOnCall == nil {
+		fake.writeCredentialsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.writeCredentialsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecretStoreClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.readCredentialsMutex.RLock()
 	defer fake.readCredentialsMutex.RUnlock()
+	fake.readLeasedCredentialsMutex.RLock()
+	defer fake.readLeasedCredentialsMutex.RUnlock()
+	fake.writeCredentialsMutex.RLock()
+	defer fake.writeCredentialsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
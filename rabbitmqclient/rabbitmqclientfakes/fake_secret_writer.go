@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package rabbitmqclientfakes
+
+import (
+	"sync"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+)
+
+type FakeSecretWriter struct {
+	WriteSecretStub        func(string, map[string]interface{}) (*api.Secret, error)
+	writeSecretMutex       sync.RWMutex
+	writeSecretArgsForCall []struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}
+	writeSecretReturns struct {
+		result1 *api.Secret
+		result2 error
+	}
+	writeSecretReturnsOnCall map[int]struct {
+		result1 *api.Secret
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecretWriter) WriteSecret(arg1 string, arg2 map[string]interface{}) (*api.Secret, error) {
+	fake.writeSecretMutex.Lock()
+	ret, specificReturn := fake.writeSecretReturnsOnCall[len(fake.writeSecretArgsForCall)]
+	fake.writeSecretArgsForCall = append(fake.writeSecretArgsForCall, struct {
+		arg1 string
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	stub := fake.WriteSecretStub
+	fakeReturns := fake.writeSecretReturns
+	fake.recordInvocation("WriteSecret", []interface{}{arg1, arg2})
+	fake.writeSecretMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSecretWriter) WriteSecretCallCount() int {
+	fake.writeSecretMutex.RLock()
+	defer fake.writeSecretMutex.RUnlock()
+	return len(fake.writeSecretArgsForCall)
+}
+
+func (fake *FakeSecretWriter) WriteSecretCalls(stub func(string, map[string]interface{}) (*api.Secret, error)) {
+	fake.writeSecretMutex.Lock()
+	defer fake.writeSecretMutex.Unlock()
+	fake.WriteSecretStub = stub
+}
+
+func (fake *FakeSecretWriter) WriteSecretArgsForCall(i int) (string, map[string]interface{}) {
+	fake.writeSecretMutex.RLock()
+	defer fake.writeSecretMutex.RUnlock()
+	argsForCall := fake.writeSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSecretWriter) WriteSecretReturns(result1 *api.Secret, result2 error) {
+	fake.writeSecretMutex.Lock()
+	defer fake.writeSecretMutex.Unlock()
+	fake.WriteSecretStub = nil
+	fake.writeSecretReturns = struct {
+		result1 *api.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretWriter) WriteSecretReturnsOnCall(i int, result1 *api.Secret, result2 error) {
+	fake.writeSecretMutex.Lock()
+	defer fake.writeSecretMutex.Unlock()
+	fake.WriteSecretStub = nil
+	if fake.writeSecretReturnsOnCall == nil {
+		fake.writeSecretReturnsOnCall = make(map[int]struct {
+			result1 *api.Secret
+			result2 error
+		})
+	}
+	fake.writeSecretReturnsOnCall[i] = struct {
+		result1 *api.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretWriter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.writeSecretMutex.RLock()
+	defer fake.writeSecretMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSecretWriter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rabbitmqclient.SecretWriter = new(FakeSecretWriter)
@@ -0,0 +1,84 @@
+/*
+RabbitMQ Messaging Topology Kubernetes Operator
+Copyright 2021 VMware, Inc.
+
+This product is licensed to you under the Mozilla Public License 2.0 license (the "License").  You may not use this product except in compliance with the Mozilla 2.0 License.
+
+This product may include a number of subcomponents with separate copyright notices and license terms. Your use of these subcomponents is subject to the terms and conditions of the subcomponent's license, as noted in the LICENSE file.
+*/
+
+package rabbitmqclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+)
+
+// ShovelStatus is the status of a shovel as reported by the RabbitMQ management API
+// unlike rabbithole.ShovelStatus, it includes the node running the shovel and the reason it terminated
+type ShovelStatus struct {
+	Name  string `json:"name"`
+	Vhost string `json:"vhost"`
+	Type  string `json:"type"`
+	// State is one of 'starting', 'running' or 'terminated'
+	State string `json:"state"`
+	Node  string `json:"node"`
+	// Reason is only reported for terminated shovels
+	Reason string `json:"reason"`
+}
+
+// shovelStatusTimeout bounds the time to get the status of shovels, so that a management API which does not respond
+// does not block the reconcile of a shovel indefinitely
+const shovelStatusTimeout = 10 * time.Second
+
+// rabbitholeClient extends rabbithole.Client with requests which rabbithole does not support
+type rabbitholeClient struct {
+	*rabbithole.Client
+	transport http.RoundTripper
+}
+
+// GetShovelStatus returns the status of a shovel
+// returns nil if the shovel is not reported by RabbitMQ, which is the case when it has not been started yet
+func (c *rabbitholeClient) GetShovelStatus(vhost, name string) (*ShovelStatus, error) {
+	req, err := http.NewRequest(http.MethodGet, c.Endpoint+"/api/shovels/"+url.PathEscape(vhost), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Close = true
+	req.SetBasicAuth(c.Username, c.Password)
+
+	httpc := &http.Client{Timeout: shovelStatusTimeout}
+	if c.transport != nil {
+		httpc.Transport = c.transport
+	}
+	res, err := httpc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		rme := rabbithole.ErrorResponse{}
+		if err = json.NewDecoder(res.Body).Decode(&rme); err != nil {
+			rme.Message = fmt.Sprintf("Error %d from RabbitMQ: %s", res.StatusCode, err)
+		}
+		rme.StatusCode = res.StatusCode
+		return nil, rme
+	}
+
+	var statuses []ShovelStatus
+	if err = json.NewDecoder(res.Body).Decode(&statuses); err != nil {
+		return nil, err
+	}
+	for i := range statuses {
+		if statuses[i].Name == name {
+			return &statuses[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,96 @@
+package rabbitmqclient_test
+
+import (
+	"crypto/x509"
+	"errors"
+	"net/http"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
+	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient/rabbitmqclientfakes"
+)
+
+var _ = Describe("GetShovelStatus", func() {
+	var (
+		fakeRabbitMQServer *ghttp.Server
+		generatedClient    rabbitmqclient.Client
+	)
+
+	BeforeEach(func() {
+		fakeRabbitMQServer = mockRabbitMQServer()
+		fakeRabbitMQURL, _, err := mockRabbitMQURLPort(fakeRabbitMQServer)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnectionCredentials := &rabbitmqclientfakes.FakeConnectionCredentials{}
+		fakeConnectionCredentials.DataReturnsOnCall(0, []byte("abc123"), true)
+		fakeConnectionCredentials.DataReturnsOnCall(1, []byte("foo1234"), true)
+		fakeConnectionCredentials.DataReturnsOnCall(2, []byte(fakeRabbitMQURL.String()), true)
+
+		generatedClient, err = rabbitmqclient.RabbitholeClientFactory(fakeConnectionCredentials, false, x509.NewCertPool())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		fakeRabbitMQServer.Close()
+	})
+
+	When("the shovel is reported by RabbitMQ", func() {
+		BeforeEach(func() {
+			fakeRabbitMQServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/shovels/a-vhost"),
+				ghttp.VerifyBasicAuth("abc123", "foo1234"),
+				ghttp.RespondWith(http.StatusOK, `[
+					{"name": "other-shovel", "vhost": "a-vhost", "type": "dynamic", "state": "running", "node": "rabbit@node-0"},
+					{"name": "a-shovel", "vhost": "a-vhost", "type": "dynamic", "state": "terminated", "node": "rabbit@node-1", "reason": "needs a uri"}
+				]`),
+			))
+		})
+
+		It("returns the status of the shovel including node and reason", func() {
+			status, err := generatedClient.GetShovelStatus("a-vhost", "a-shovel")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*status).To(Equal(rabbitmqclient.ShovelStatus{
+				Name:   "a-shovel",
+				Vhost:  "a-vhost",
+				Type:   "dynamic",
+				State:  "terminated",
+				Node:   "rabbit@node-1",
+				Reason: "needs a uri",
+			}))
+		})
+	})
+
+	When("the shovel is not reported by RabbitMQ", func() {
+		BeforeEach(func() {
+			fakeRabbitMQServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/shovels//"),
+				ghttp.RespondWith(http.StatusOK, `[]`),
+			))
+		})
+
+		It("returns nil", func() {
+			status, err := generatedClient.GetShovelStatus("/", "a-shovel")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(BeNil())
+		})
+	})
+
+	When("RabbitMQ responds with an error", func() {
+		BeforeEach(func() {
+			fakeRabbitMQServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", "/api/shovels/a-vhost"),
+				ghttp.RespondWith(http.StatusNotFound, `{"error": "Object Not Found", "reason": "Not Found"}`),
+			))
+		})
+
+		It("returns a rabbithole error response", func() {
+			_, err := generatedClient.GetShovelStatus("a-vhost", "a-shovel")
+			var errResponse rabbithole.ErrorResponse
+			Expect(errors.As(err, &errResponse)).To(BeTrue())
+			Expect(errResponse.StatusCode).To(Equal(http.StatusNotFound))
+		})
+	})
+})
@@ -15,8 +15,22 @@ import (
 	vault "github.com/hashicorp/vault/api"
 )
 
-const defaultAuthPath string = "auth/kubernetes"
 const defaultVaultRole string = "messaging-topology-operator"
+const defaultServiceAccountTokenPath string = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// Vault auth methods which can be set with OPERATOR_VAULT_AUTH_METHOD
+const (
+	KubernetesAuthMethod string = "kubernetes"
+	AppRoleAuthMethod    string = "approle"
+	JWTAuthMethod        string = "jwt"
+	TokenAuthMethod      string = "token"
+)
+
+var defaultAuthPaths = map[string]string{
+	KubernetesAuthMethod: "auth/kubernetes",
+	AppRoleAuthMethod:    "auth/approle",
+	JWTAuthMethod:        "auth/jwt",
+}
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecretReader
 type SecretReader interface {
@@ -35,13 +49,100 @@ func (s VaultSecretReader) ReadSecret(path string) (*vault.Secret, error) {
 	return secret, nil
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecretWriter
+type SecretWriter interface {
+	WriteSecret(path string, data map[string]interface{}) (*vault.Secret, error)
+}
+
+func (s VaultSecretReader) WriteSecret(path string, data map[string]interface{}) (*vault.Secret, error) {
+	return s.client.Logical().Write(path, data)
+}
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SecretStoreClient
 type SecretStoreClient interface {
 	ReadCredentials(path string) (string, string, error)
+	ReadLeasedCredentials(path string) (string, string, error)
+	WriteCredentials(path, username, password string) error
 }
 
 type VaultClient struct {
 	Reader SecretReader
+	Writer SecretWriter
+	Leases *CredentialLeases
+}
+
+// CredentialLeases holds the credentials issued with a lease by a dynamic secrets engine, such as the RabbitMQ secrets engine,
+// so that the same credentials are returned for a path until their lease can no longer be renewed
+type CredentialLeases struct {
+	mu          sync.Mutex
+	credentials map[string]leasedCredentials
+	// a lock per path, held while credentials of the path are looked up and issued,
+	// so that concurrent reads of a path without credentials issue a single lease
+	pathLocks map[string]*sync.Mutex
+	renew     func(secret *vault.Secret, expire func())
+}
+
+type leasedCredentials struct {
+	leaseID  string
+	username string
+	password string
+}
+
+// NewCredentialLeases returns an empty set of leases. renew is called for each new lease; it must keep the lease renewed,
+// and call expire once the lease can no longer be renewed.
+func NewCredentialLeases(renew func(secret *vault.Secret, expire func())) *CredentialLeases {
+	return &CredentialLeases{
+		credentials: map[string]leasedCredentials{},
+		pathLocks:   map[string]*sync.Mutex{},
+		renew:       renew,
+	}
+}
+
+// lockPath locks path, and returns the function which unlocks it
+func (l *CredentialLeases) lockPath(path string) func() {
+	l.mu.Lock()
+	pathLock, ok := l.pathLocks[path]
+	if !ok {
+		pathLock = &sync.Mutex{}
+		l.pathLocks[path] = pathLock
+	}
+	l.mu.Unlock()
+
+	pathLock.Lock()
+	return pathLock.Unlock
+}
+
+func (l *CredentialLeases) get(path string) (leasedCredentials, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	credentials, ok := l.credentials[path]
+	return credentials, ok
+}
+
+func (l *CredentialLeases) add(path string, secret *vault.Secret, username, password string) {
+	l.mu.Lock()
+	l.credentials[path] = leasedCredentials{
+		leaseID:  secret.LeaseID,
+		username: username,
+		password: password,
+	}
+	l.mu.Unlock()
+
+	l.renew(secret, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		// the credentials of the path may have been issued again since
+		if l.credentials[path].leaseID == secret.LeaseID {
+			delete(l.credentials, path)
+		}
+	})
+}
+
+// ExpireAll forgets all leased credentials, so that new credentials are issued on next read
+func (l *CredentialLeases) ExpireAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.credentials = map[string]leasedCredentials{}
 }
 
 // Created - and exported from package - for testing purposes
@@ -49,6 +150,7 @@ var (
 	ReadServiceAccountTokenFunc = ReadServiceAccountToken
 	ReadVaultClientSecretFunc   = ReadVaultClientSecret
 	LoginToVaultFunc            = LoginToVault
+	LookupVaultTokenFunc        = LookupVaultToken
 	FirstLoginAttemptResultCh   = make(chan error, 1)
 )
 
@@ -90,15 +192,22 @@ func InitializeClient() func() {
 			return
 		}
 
-		go renewToken(vaultClient, FirstLoginAttemptResultCh)
+		leases := NewCredentialLeases(func(secret *vault.Secret, expire func()) {
+			go manageLeaseLifecycle(vaultClient, secret, expire)
+		})
+
+		go renewToken(vaultClient, FirstLoginAttemptResultCh, leases)
 		err = <-FirstLoginAttemptResultCh
 		if err != nil {
 			SecretClientCreationError = fmt.Errorf("unable to login to Vault: %w", err)
 			return
 		}
 
+		secretReader := &VaultSecretReader{client: vaultClient}
 		SecretClient = VaultClient{
-			Reader: &VaultSecretReader{client: vaultClient},
+			Reader: secretReader,
+			Writer: secretReader,
+			Leases: leases,
 		}
 	}
 }
@@ -147,6 +256,71 @@ func (vc VaultClient) ReadCredentials(path string) (string, string, error) {
 	return username, password, nil
 }
 
+// ReadLeasedCredentials reads credentials issued by a dynamic secrets engine, such as the RabbitMQ secrets engine
+// at 'rabbitmq/creds/<role>'. Credentials issued with a lease are returned on subsequent reads of the path,
+// for as long as their lease is renewed, instead of issuing new credentials on every read.
+func (vc VaultClient) ReadLeasedCredentials(path string) (string, string, error) {
+	if vc.Leases != nil {
+		unlock := vc.Leases.lockPath(path)
+		defer unlock()
+		if credentials, ok := vc.Leases.get(path); ok {
+			return credentials.username, credentials.password, nil
+		}
+	}
+
+	secret, err := vc.Reader.ReadSecret(path)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to read Vault secret: %w", err)
+	}
+
+	if secret == nil {
+		return "", "", errors.New("returned Vault secret is nil")
+	}
+
+	if len(secret.Warnings) > 0 {
+		return "", "", fmt.Errorf("warnings were returned from Vault: %v", secret.Warnings)
+	}
+
+	if len(secret.Data) == 0 {
+		return "", "", errors.New("returned Vault secret has an empty Data map")
+	}
+
+	username, err := getValue("username", secret.Data)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get username from Vault secret: %w", err)
+	}
+
+	password, err := getValue("password", secret.Data)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to get password from Vault secret: %w", err)
+	}
+
+	if vc.Leases != nil && secret.LeaseID != "" {
+		vc.Leases.add(path, secret, username, password)
+	}
+
+	return username, password, nil
+}
+
+// WriteCredentials writes username and password to a path of a KV secrets engine version 2,
+// in the format read by ReadCredentials
+func (vc VaultClient) WriteCredentials(path, username, password string) error {
+	secret, err := vc.Writer.WriteSecret(path, map[string]interface{}{
+		"data": map[string]interface{}{
+			"username": username,
+			"password": password,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to write Vault secret: %w", err)
+	}
+
+	if secret != nil && len(secret.Warnings) > 0 {
+		return fmt.Errorf("warnings were returned from Vault: %v", secret.Warnings)
+	}
+	return nil
+}
+
 func getValue(key string, data map[string]interface{}) (string, error) {
 	result, ok := data[key].(string)
 	if !ok {
@@ -167,48 +341,138 @@ func availableKeys(m map[string]interface{}) []string {
 }
 
 func login(vaultClient *vault.Client) (*vault.Secret, error) {
-	logger := ctrl.LoggerFrom(nil)
+	vaultNamespace := os.Getenv("OPERATOR_VAULT_NAMESPACE")
+	if vaultNamespace != "" {
+		vaultClient.SetNamespace(vaultNamespace)
+	}
 
-	jwt, err := ReadServiceAccountTokenFunc()
+	authMethod := os.Getenv("OPERATOR_VAULT_AUTH_METHOD")
+	if authMethod == "" {
+		authMethod = KubernetesAuthMethod
+	}
+
+	var vaultSecret *vault.Secret
+	var err error
+	switch authMethod {
+	case KubernetesAuthMethod, JWTAuthMethod:
+		vaultSecret, err = loginWithJWT(vaultClient, authMethod, vaultNamespace)
+	case AppRoleAuthMethod:
+		vaultSecret, err = loginWithAppRole(vaultClient, vaultNamespace)
+	case TokenAuthMethod:
+		vaultSecret, err = loginWithTokenFile(vaultClient, vaultNamespace)
+	default:
+		return nil, fmt.Errorf("unsupported Vault auth method %q set with OPERATOR_VAULT_AUTH_METHOD; supported methods are %s, %s, %s and %s",
+			authMethod, KubernetesAuthMethod, AppRoleAuthMethod, JWTAuthMethod, TokenAuthMethod)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("unable to read file containing service account token: %w", err)
+		return nil, err
 	}
 
-	vaultNamespace := os.Getenv("OPERATOR_VAULT_NAMESPACE")
-	if vaultNamespace != "" {
-		vaultClient.SetNamespace(vaultNamespace)
+	if vaultSecret == nil || vaultSecret.Auth == nil || vaultSecret.Auth.ClientToken == "" {
+		return nil, fmt.Errorf("no client token found in Vault secret")
 	}
 
+	vaultClient.SetToken(vaultSecret.Auth.ClientToken)
+	return vaultSecret, nil
+}
+
+func authPath(authMethod string) string {
 	loginAuthPath := os.Getenv("OPERATOR_VAULT_AUTH_PATH")
 	if loginAuthPath == "" {
-		loginAuthPath = defaultAuthPath
+		loginAuthPath = defaultAuthPaths[authMethod]
 	}
+	return loginAuthPath
+}
+
+// loginWithJWT authenticates with the Kubernetes or JWT auth method, with the service account token of the operator,
+// or the token read from OPERATOR_VAULT_JWT_PATH
+func loginWithJWT(vaultClient *vault.Client, authMethod, vaultNamespace string) (*vault.Secret, error) {
+	logger := ctrl.LoggerFrom(nil)
+
+	jwt, err := ReadServiceAccountTokenFunc()
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file containing service account token: %w", err)
+	}
+
+	loginAuthPath := authPath(authMethod)
 
 	role := os.Getenv("OPERATOR_VAULT_ROLE")
 	if role == "" {
 		role = defaultVaultRole
 	}
 
-	logger.Info("Authenticating to Vault", "vault role", role, "vault namespace", vaultNamespace, "vault auth path", loginAuthPath)
+	logger.Info("Authenticating to Vault", "vault auth method", authMethod, "vault role", role, "vault namespace", vaultNamespace, "vault auth path", loginAuthPath)
 
 	vaultSecret, err := ReadVaultClientSecretFunc(vaultClient, string(jwt), role, loginAuthPath)
 	if err != nil {
 		return nil, fmt.Errorf("unable to obtain Vault client secret: %w", err)
 	}
+	return vaultSecret, nil
+}
+
+// loginWithAppRole authenticates with the AppRole auth method, with the role ID set with OPERATOR_VAULT_APPROLE_ROLE_ID,
+// and the secret ID read from OPERATOR_VAULT_APPROLE_SECRET_ID_PATH, if set
+func loginWithAppRole(vaultClient *vault.Client, vaultNamespace string) (*vault.Secret, error) {
+	logger := ctrl.LoggerFrom(nil)
+
+	roleID := os.Getenv("OPERATOR_VAULT_APPROLE_ROLE_ID")
+	if roleID == "" {
+		return nil, errors.New("OPERATOR_VAULT_APPROLE_ROLE_ID environment variable not set; required by the approle Vault auth method")
+	}
 
-	if vaultSecret == nil || vaultSecret.Auth == nil || vaultSecret.Auth.ClientToken == "" {
-		return nil, fmt.Errorf("no client token found in Vault secret")
+	params := map[string]interface{}{
+		"role_id": roleID,
 	}
 
-	vaultClient.SetToken(vaultSecret.Auth.ClientToken)
+	// the secret ID can be omitted for roles created with bind_secret_id=false
+	if secretIDPath := os.Getenv("OPERATOR_VAULT_APPROLE_SECRET_ID_PATH"); secretIDPath != "" {
+		secretID, err := readFile(secretIDPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file containing AppRole secret ID: %w", err)
+		}
+		params["secret_id"] = secretID
+	}
+
+	loginAuthPath := authPath(AppRoleAuthMethod)
+
+	logger.Info("Authenticating to Vault", "vault auth method", AppRoleAuthMethod, "vault role ID", roleID, "vault namespace", vaultNamespace, "vault auth path", loginAuthPath)
+
+	vaultSecret, err := LoginToVaultFunc(vaultClient, loginAuthPath, params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to obtain Vault client secret: %w", err)
+	}
+	return vaultSecret, nil
+}
+
+// loginWithTokenFile uses a pre-issued token read from OPERATOR_VAULT_TOKEN_PATH, e.g. the sink file of a Vault agent
+func loginWithTokenFile(vaultClient *vault.Client, vaultNamespace string) (*vault.Secret, error) {
+	logger := ctrl.LoggerFrom(nil)
+
+	tokenPath := os.Getenv("OPERATOR_VAULT_TOKEN_PATH")
+	if tokenPath == "" {
+		return nil, errors.New("OPERATOR_VAULT_TOKEN_PATH environment variable not set; required by the token Vault auth method")
+	}
+
+	token, err := readFile(tokenPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file containing Vault token: %w", err)
+	}
+
+	logger.Info("Authenticating to Vault", "vault auth method", TokenAuthMethod, "vault namespace", vaultNamespace, "vault token path", tokenPath)
+
+	vaultSecret, err := LookupVaultTokenFunc(vaultClient, token)
+	if err != nil {
+		return nil, fmt.Errorf("unable to look up Vault token: %w", err)
+	}
 	return vaultSecret, nil
 }
 
-func renewToken(client *vault.Client, initialLoginErrorCh chan<- error) {
+func renewToken(client *vault.Client, initialLoginErrorCh chan<- error, leases *CredentialLeases) {
 	logger := ctrl.LoggerFrom(nil)
 	sentFirstLoginAttemptErr := false
 
 	for {
+		previousToken := client.Token()
 		vaultLoginResp, err := login(client)
 		if err != nil {
 			logger.Error(err, "unable to authenticate to Vault server")
@@ -223,6 +487,9 @@ func renewToken(client *vault.Client, initialLoginErrorCh chan<- error) {
 				return
 			}
 			logger.Info("Initiating lifecycle management of Vault token")
+		} else if err == nil && client.Token() != previousToken {
+			// leases are revoked along with the token that created them, once it expires
+			leases.ExpireAll()
 		}
 
 		err = manageTokenLifecycle(client, vaultLoginResp)
@@ -235,6 +502,18 @@ func renewToken(client *vault.Client, initialLoginErrorCh chan<- error) {
 	}
 }
 
+// nonRenewableTokenRefreshInterval is how often a non-renewable token without TTL is read again
+const nonRenewableTokenRefreshInterval = 5 * time.Minute
+
+// nonRenewableTokenWait returns how long to use a non-renewable token with a TTL of leaseDuration seconds
+// before logging in again: two thirds of its TTL, so that a replaced token is picked up before the previous one expires
+func nonRenewableTokenWait(leaseDuration int) time.Duration {
+	if leaseDuration <= 0 {
+		return nonRenewableTokenRefreshInterval
+	}
+	return time.Duration(leaseDuration) * time.Second * 2 / 3
+}
+
 func manageTokenLifecycle(client *vault.Client, token *vault.Secret) error {
 	logger := ctrl.LoggerFrom(nil)
 
@@ -245,7 +524,10 @@ func manageTokenLifecycle(client *vault.Client, token *vault.Secret) error {
 
 	renew := token.Auth.Renewable
 	if !renew {
-		logger.Info("Token is not configured to be renewable. Re-attempting login")
+		// e.g. a token of a Vault agent sink, which the agent replaces before it expires
+		wait := nonRenewableTokenWait(token.Auth.LeaseDuration)
+		logger.Info("Token is not configured to be renewable. Re-attempting login before it expires", "wait", wait.String())
+		time.Sleep(wait)
 		return nil
 	}
 
@@ -279,11 +561,48 @@ func manageTokenLifecycle(client *vault.Client, token *vault.Secret) error {
 	}
 }
 
+func manageLeaseLifecycle(client *vault.Client, secret *vault.Secret, expire func()) {
+	logger := ctrl.LoggerFrom(nil)
+	defer expire()
+
+	watcher, err := client.NewLifetimeWatcher(&vault.LifetimeWatcherInput{
+		Secret: secret,
+	})
+	if err != nil {
+		logger.Error(err, "unable to initialize new lifetime watcher for renewing lease of credentials", "lease", secret.LeaseID)
+		return
+	}
+
+	go watcher.Start()
+	defer watcher.Stop()
+
+	for {
+		select {
+		// `DoneCh` will return once the lease is about to expire, because it is not renewable,
+		// it reached its maximum TTL, or renewal failed. Credentials are issued again on next read.
+		case err := <-watcher.DoneCh():
+			if err != nil {
+				logger.Error(err, "Failed to renew lease of Vault credentials", "lease", secret.LeaseID)
+				return
+			}
+			logger.Info("Lease of Vault credentials can no longer be renewed", "lease", secret.LeaseID)
+			return
+
+		// Successfully completed renewal
+		case renewal := <-watcher.RenewCh():
+			logger.Info("Successfully renewed lease of Vault credentials", "lease", secret.LeaseID, "lease duration", renewal.Secret.LeaseDuration)
+		}
+	}
+}
+
 func ReadServiceAccountToken() ([]byte, error) {
 	// Read the service-account token from the path where the token's Kubernetes Secret is mounted.
 	// By default, Kubernetes will mount this to /var/run/secrets/kubernetes.io/serviceaccount/token
-	// but an administrator may have configured it to be mounted elsewhere.
-	path := "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	// but an administrator may have configured it to be mounted elsewhere, or may use another token with the JWT auth method.
+	path := os.Getenv("OPERATOR_VAULT_JWT_PATH")
+	if path == "" {
+		path = defaultServiceAccountTokenPath
+	}
 	token, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read file %s: %w", path, err)
@@ -303,3 +622,38 @@ func ReadVaultClientSecret(vaultClient *vault.Client, jwtToken string, vaultRole
 func LoginToVault(vaultClient *vault.Client, authPath string, params map[string]interface{}) (*vault.Secret, error) {
 	return vaultClient.Logical().Write(authPath+"/login", params)
 }
+
+// LookupVaultToken sets token on the client, and returns it as the secret of a login, with its TTL and whether it is renewable
+func LookupVaultToken(vaultClient *vault.Client, token string) (*vault.Secret, error) {
+	vaultClient.SetToken(token)
+	lookup, err := vaultClient.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, err
+	}
+
+	ttl, err := lookup.TokenTTL()
+	if err != nil {
+		return nil, err
+	}
+
+	renewable, err := lookup.TokenIsRenewable()
+	if err != nil {
+		return nil, err
+	}
+
+	return &vault.Secret{
+		Auth: &vault.SecretAuth{
+			ClientToken:   token,
+			Renewable:     renewable,
+			LeaseDuration: int(ttl.Seconds()),
+		},
+	}, nil
+}
+
+func readFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read file %s: %w", path, err)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
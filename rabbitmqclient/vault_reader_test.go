@@ -5,6 +5,8 @@ import (
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient"
 	"github.com/rabbitmq/messaging-topology-operator/rabbitmqclient/rabbitmqclientfakes"
 	"os"
+	"sync"
+	"time"
 
 	vault "github.com/hashicorp/vault/api"
 	. "github.com/onsi/ginkgo/v2"
@@ -295,6 +297,213 @@ var _ = Describe("VaultReader", func() {
 
 	})
 
+	Describe("Write Credentials", func() {
+		var fakeSecretWriter *rabbitmqclientfakes.FakeSecretWriter
+
+		BeforeEach(func() {
+			fakeSecretWriter = &rabbitmqclientfakes.FakeSecretWriter{}
+			secretStoreClient = rabbitmqclient.VaultClient{Writer: fakeSecretWriter}
+		})
+
+		When("the credentials are written", func() {
+			BeforeEach(func() {
+				fakeSecretWriter.WriteSecretReturns(&vault.Secret{}, nil)
+			})
+
+			JustBeforeEach(func() {
+				err = secretStoreClient.WriteCredentials("secret/data/some/path", existingRabbitMQUsername, existingRabbitMQPassword)
+			})
+
+			It("should not error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should write username and password under the 'data' key of the path", func() {
+				Expect(fakeSecretWriter.WriteSecretCallCount()).To(Equal(1))
+				path, data := fakeSecretWriter.WriteSecretArgsForCall(0)
+				Expect(path).To(Equal("secret/data/some/path"))
+				Expect(data).To(Equal(map[string]interface{}{
+					"data": map[string]interface{}{
+						"username": existingRabbitMQUsername,
+						"password": existingRabbitMQPassword,
+					},
+				}))
+			})
+		})
+
+		When("unable to write secret to Vault", func() {
+			BeforeEach(func() {
+				fakeSecretWriter.WriteSecretReturns(nil, errors.New("something bad happened"))
+			})
+
+			JustBeforeEach(func() {
+				err = secretStoreClient.WriteCredentials("secret/data/some/path", existingRabbitMQUsername, existingRabbitMQPassword)
+			})
+
+			It("should have returned an error", func() {
+				Expect(err).To(MatchError("unable to write Vault secret: something bad happened"))
+			})
+		})
+
+		When("Vault returns warnings", func() {
+			BeforeEach(func() {
+				fakeSecretWriter.WriteSecretReturns(&vault.Secret{Warnings: []string{"something bad happened"}}, nil)
+			})
+
+			JustBeforeEach(func() {
+				err = secretStoreClient.WriteCredentials("secret/data/some/path", existingRabbitMQUsername, existingRabbitMQPassword)
+			})
+
+			It("should have returned an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("warnings were returned from Vault"))
+			})
+		})
+	})
+
+	Describe("Read Leased Credentials", func() {
+		var (
+			leases *rabbitmqclient.CredentialLeases
+			expire func()
+		)
+
+		BeforeEach(func() {
+			expire = nil
+			leases = rabbitmqclient.NewCredentialLeases(func(_ *vault.Secret, e func()) {
+				expire = e
+			})
+			fakeSecretReader = &rabbitmqclientfakes.FakeSecretReader{}
+			secretStoreClient = rabbitmqclient.VaultClient{Reader: fakeSecretReader, Leases: leases}
+		})
+
+		When("credentials are issued with a lease", func() {
+			BeforeEach(func() {
+				fakeSecretReader.ReadSecretReturnsOnCall(0, &vault.Secret{
+					LeaseID:       "rabbitmq/creds/topology-operator/first",
+					LeaseDuration: 3600,
+					Renewable:     true,
+					Data:          map[string]interface{}{"username": "first-user", "password": "first-password"},
+				}, nil)
+				fakeSecretReader.ReadSecretReturnsOnCall(1, &vault.Secret{
+					LeaseID:       "rabbitmq/creds/topology-operator/second",
+					LeaseDuration: 3600,
+					Renewable:     true,
+					Data:          map[string]interface{}{"username": "second-user", "password": "second-password"},
+				}, nil)
+			})
+
+			JustBeforeEach(func() {
+				username, password, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+			})
+
+			It("should return the credentials from the top level of the secret data", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(username).To(Equal("first-user"))
+				Expect(password).To(Equal("first-password"))
+				Expect(fakeSecretReader.ReadSecretArgsForCall(0)).To(Equal("rabbitmq/creds/topology-operator"))
+			})
+
+			It("should return the same credentials until the lease can no longer be renewed", func() {
+				username, password, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(username).To(Equal("first-user"))
+				Expect(fakeSecretReader.ReadSecretCallCount()).To(Equal(1))
+
+				Expect(expire).NotTo(BeNil())
+				expire()
+
+				username, password, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(username).To(Equal("second-user"))
+				Expect(password).To(Equal("second-password"))
+				Expect(fakeSecretReader.ReadSecretCallCount()).To(Equal(2))
+			})
+
+			It("should issue a single lease for concurrent reads", func() {
+				leases.ExpireAll()
+				fakeSecretReader.ReadSecretCalls(func(string) (*vault.Secret, error) {
+					time.Sleep(10 * time.Millisecond)
+					return &vault.Secret{
+						LeaseID:       "rabbitmq/creds/topology-operator/concurrent",
+						LeaseDuration: 3600,
+						Renewable:     true,
+						Data:          map[string]interface{}{"username": "concurrent-user", "password": "concurrent-password"},
+					}, nil
+				})
+
+				var wg sync.WaitGroup
+				usernames := make([]string, 5)
+				for i := range usernames {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+						var readErr error
+						usernames[i], _, readErr = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+						Expect(readErr).NotTo(HaveOccurred())
+					}(i)
+				}
+				wg.Wait()
+
+				Expect(usernames).To(HaveEach(Equal("concurrent-user")))
+				Expect(fakeSecretReader.ReadSecretCallCount()).To(Equal(2))
+			})
+
+			It("should issue new credentials once all leases are expired", func() {
+				leases.ExpireAll()
+				username, _, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(username).To(Equal("second-user"))
+			})
+		})
+
+		When("the credentials have no lease", func() {
+			BeforeEach(func() {
+				fakeSecretReader.ReadSecretReturns(&vault.Secret{
+					Data: map[string]interface{}{"username": existingRabbitMQUsername, "password": existingRabbitMQPassword},
+				}, nil)
+			})
+
+			It("should read the credentials on every call", func() {
+				_, _, err = secretStoreClient.ReadLeasedCredentials("some/path")
+				Expect(err).NotTo(HaveOccurred())
+				username, password, err = secretStoreClient.ReadLeasedCredentials("some/path")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(username).To(Equal(existingRabbitMQUsername))
+				Expect(password).To(Equal(existingRabbitMQPassword))
+				Expect(fakeSecretReader.ReadSecretCallCount()).To(Equal(2))
+				Expect(expire).To(BeNil())
+			})
+		})
+
+		When("unable to read secret from Vault", func() {
+			BeforeEach(func() {
+				fakeSecretReader.ReadSecretReturns(nil, errors.New("something bad happened"))
+			})
+
+			It("should have returned an error", func() {
+				_, _, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+				Expect(err).To(MatchError("unable to read Vault secret: something bad happened"))
+			})
+		})
+
+		When("Vault secret data does not contain password", func() {
+			BeforeEach(func() {
+				fakeSecretReader.ReadSecretReturns(&vault.Secret{
+					LeaseID: "rabbitmq/creds/topology-operator/first",
+					Data:    map[string]interface{}{"username": existingRabbitMQUsername},
+				}, nil)
+			})
+
+			It("should have returned an error, and not hold the lease", func() {
+				_, _, err = secretStoreClient.ReadLeasedCredentials("rabbitmq/creds/topology-operator")
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("unable to get password from Vault secret"))
+				Expect(expire).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Initialize secret store client", func() {
 		var (
 			vaultSpec                  *rabbitmqv1beta1.VaultSpec
@@ -532,6 +741,181 @@ var _ = Describe("VaultReader", func() {
 			})
 		})
 
+		When("the jwt auth method is set in the environment", func() {
+			var jwtUsedForLogin, authPathUsedForLogin string
+
+			BeforeEach(func() {
+				tokenFile, err := os.CreateTemp("", "jwt")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = tokenFile.WriteString("a-jwt")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tokenFile.Close()).To(Succeed())
+
+				_ = os.Setenv("OPERATOR_VAULT_AUTH_METHOD", "jwt")
+				_ = os.Setenv("OPERATOR_VAULT_JWT_PATH", tokenFile.Name())
+				rabbitmqclient.FirstLoginAttemptResultCh = make(chan error, 1)
+				rabbitmqclient.SecretClient = nil
+				rabbitmqclient.SecretClientCreationError = nil
+				rabbitmqclient.ReadVaultClientSecretFunc = func(vaultClient *vault.Client, jwtToken string, vaultRole string, authPath string) (*vault.Secret, error) {
+					jwtUsedForLogin = jwtToken
+					authPathUsedForLogin = authPath
+					return &vault.Secret{
+						Auth: &vault.SecretAuth{
+							ClientToken: "vault-secret-token",
+						},
+					}, nil
+				}
+			})
+
+			AfterEach(func() {
+				_ = os.Remove(os.Getenv("OPERATOR_VAULT_JWT_PATH"))
+				_ = os.Unsetenv("OPERATOR_VAULT_AUTH_METHOD")
+				_ = os.Unsetenv("OPERATOR_VAULT_JWT_PATH")
+				rabbitmqclient.ReadVaultClientSecretFunc = rabbitmqclient.ReadVaultClientSecret
+			})
+
+			JustBeforeEach(func() {
+				rabbitmqclient.InitializeClient()()
+				secretStoreClient, err = rabbitmqclient.SecretClient, rabbitmqclient.SecretClientCreationError
+			})
+
+			It("should authenticate to the jwt auth path with the token read from OPERATOR_VAULT_JWT_PATH", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretStoreClient).NotTo(BeNil())
+				Expect(jwtUsedForLogin).To(Equal("a-jwt"))
+				Expect(authPathUsedForLogin).To(Equal("auth/jwt"))
+			})
+		})
+
+		When("the approle auth method is set in the environment", func() {
+			var (
+				authPathUsedForLogin string
+				paramsUsedForLogin   map[string]interface{}
+			)
+
+			BeforeEach(func() {
+				secretIDFile, err := os.CreateTemp("", "secret-id")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = secretIDFile.WriteString("a-secret-id\n")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretIDFile.Close()).To(Succeed())
+
+				_ = os.Setenv("OPERATOR_VAULT_AUTH_METHOD", "approle")
+				_ = os.Setenv("OPERATOR_VAULT_APPROLE_ROLE_ID", "a-role-id")
+				_ = os.Setenv("OPERATOR_VAULT_APPROLE_SECRET_ID_PATH", secretIDFile.Name())
+				rabbitmqclient.FirstLoginAttemptResultCh = make(chan error, 1)
+				rabbitmqclient.SecretClient = nil
+				rabbitmqclient.SecretClientCreationError = nil
+				rabbitmqclient.LoginToVaultFunc = func(vaultClient *vault.Client, authPath string, params map[string]interface{}) (*vault.Secret, error) {
+					authPathUsedForLogin = authPath
+					paramsUsedForLogin = params
+					return &vault.Secret{
+						Auth: &vault.SecretAuth{
+							ClientToken: "vault-secret-token",
+						},
+					}, nil
+				}
+			})
+
+			AfterEach(func() {
+				_ = os.Remove(os.Getenv("OPERATOR_VAULT_APPROLE_SECRET_ID_PATH"))
+				_ = os.Unsetenv("OPERATOR_VAULT_AUTH_METHOD")
+				_ = os.Unsetenv("OPERATOR_VAULT_APPROLE_ROLE_ID")
+				_ = os.Unsetenv("OPERATOR_VAULT_APPROLE_SECRET_ID_PATH")
+				rabbitmqclient.LoginToVaultFunc = rabbitmqclient.LoginToVault
+			})
+
+			JustBeforeEach(func() {
+				rabbitmqclient.InitializeClient()()
+				secretStoreClient, err = rabbitmqclient.SecretClient, rabbitmqclient.SecretClientCreationError
+			})
+
+			It("should authenticate to the approle auth path with the role ID and secret ID", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretStoreClient).NotTo(BeNil())
+				Expect(authPathUsedForLogin).To(Equal("auth/approle"))
+				Expect(paramsUsedForLogin).To(Equal(map[string]interface{}{
+					"role_id":   "a-role-id",
+					"secret_id": "a-secret-id",
+				}))
+			})
+
+			When("the role ID is not set", func() {
+				BeforeEach(func() {
+					_ = os.Unsetenv("OPERATOR_VAULT_APPROLE_ROLE_ID")
+				})
+
+				It("should have returned an error", func() {
+					Expect(secretStoreClient).To(BeNil())
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("OPERATOR_VAULT_APPROLE_ROLE_ID environment variable not set"))
+				})
+			})
+		})
+
+		When("the token auth method is set in the environment", func() {
+			var tokenUsedForLogin string
+
+			BeforeEach(func() {
+				tokenFile, err := os.CreateTemp("", "vault-token")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = tokenFile.WriteString("a-vault-token\n")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tokenFile.Close()).To(Succeed())
+
+				_ = os.Setenv("OPERATOR_VAULT_AUTH_METHOD", "token")
+				_ = os.Setenv("OPERATOR_VAULT_TOKEN_PATH", tokenFile.Name())
+				rabbitmqclient.FirstLoginAttemptResultCh = make(chan error, 1)
+				rabbitmqclient.SecretClient = nil
+				rabbitmqclient.SecretClientCreationError = nil
+				rabbitmqclient.LookupVaultTokenFunc = func(vaultClient *vault.Client, token string) (*vault.Secret, error) {
+					tokenUsedForLogin = token
+					return &vault.Secret{
+						Auth: &vault.SecretAuth{
+							ClientToken: token,
+						},
+					}, nil
+				}
+			})
+
+			AfterEach(func() {
+				_ = os.Remove(os.Getenv("OPERATOR_VAULT_TOKEN_PATH"))
+				_ = os.Unsetenv("OPERATOR_VAULT_AUTH_METHOD")
+				_ = os.Unsetenv("OPERATOR_VAULT_TOKEN_PATH")
+				rabbitmqclient.LookupVaultTokenFunc = rabbitmqclient.LookupVaultToken
+			})
+
+			JustBeforeEach(func() {
+				rabbitmqclient.InitializeClient()()
+				secretStoreClient, err = rabbitmqclient.SecretClient, rabbitmqclient.SecretClientCreationError
+			})
+
+			It("should use the token read from OPERATOR_VAULT_TOKEN_PATH", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretStoreClient).NotTo(BeNil())
+				Expect(tokenUsedForLogin).To(Equal("a-vault-token"))
+			})
+		})
+
+		When("an unsupported auth method is set in the environment", func() {
+			BeforeEach(func() {
+				_ = os.Setenv("OPERATOR_VAULT_AUTH_METHOD", "userpass")
+				rabbitmqclient.FirstLoginAttemptResultCh = make(chan error, 1)
+				rabbitmqclient.SecretClient = nil
+				rabbitmqclient.SecretClientCreationError = nil
+			})
+
+			AfterEach(func() {
+				_ = os.Unsetenv("OPERATOR_VAULT_AUTH_METHOD")
+			})
+
+			It("should have returned an error", func() {
+				rabbitmqclient.InitializeClient()()
+				Expect(rabbitmqclient.SecretClient).To(BeNil())
+				Expect(rabbitmqclient.SecretClientCreationError).To(MatchError(ContainSubstring(`unsupported Vault auth method "userpass"`)))
+			})
+		})
+
 		When("VAULT_ADDR is not set", func() {
 			BeforeEach(func() {
 				vaultSpec = &rabbitmqv1beta1.VaultSpec{
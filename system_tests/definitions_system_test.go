@@ -0,0 +1,102 @@
+package system_tests
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+var _ = Describe("Definitions", func() {
+	var (
+		namespace   = MustHaveEnv("NAMESPACE")
+		ctx         = context.Background()
+		configMap   *corev1.ConfigMap
+		definitions *topology.Definitions
+	)
+
+	BeforeEach(func() {
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "definitions-test",
+				Namespace: namespace,
+			},
+			Data: map[string]string{
+				"definitions.json": `{
+  "exchanges": [{"name": "definitions-test", "vhost": "/", "type": "direct", "durable": true, "auto_delete": false, "internal": false, "arguments": {}}],
+  "queues": [{"name": "definitions-test", "vhost": "/", "durable": true, "auto_delete": false, "arguments": {}}],
+  "bindings": [{"source": "definitions-test", "vhost": "/", "destination": "definitions-test", "destination_type": "queue", "routing_key": "a-key", "arguments": {}}],
+  "policies": [{"name": "definitions-test", "vhost": "/", "pattern": "^definitions-test$", "apply-to": "queues", "definition": {"max-length": 10}, "priority": 0}]
+}`,
+			},
+		}
+		Expect(k8sClient.Create(ctx, configMap, &client.CreateOptions{})).To(Succeed())
+
+		definitions = &topology.Definitions{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "definitions-test",
+				Namespace: namespace,
+			},
+			Spec: topology.DefinitionsSpec{
+				ConfigMap:      &corev1.LocalObjectReference{Name: configMap.Name},
+				DeletionPolicy: "delete",
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: rmq.Name,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, configMap)).To(Succeed())
+	})
+
+	It("imports and deletes definitions successfully", func() {
+		By("importing definitions")
+		Expect(k8sClient.Create(ctx, definitions, &client.CreateOptions{})).To(Succeed())
+		Eventually(func() error {
+			_, err := rabbitClient.GetQueue("/", "definitions-test")
+			return err
+		}, 10, 2).Should(Succeed())
+		_, err := rabbitClient.GetExchange("/", "definitions-test")
+		Expect(err).NotTo(HaveOccurred())
+		bindings, err := rabbitClient.ListQueueBindingsBetween("/", "definitions-test", "definitions-test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(bindings).To(HaveLen(1))
+		_, err = rabbitClient.GetPolicy("/", "definitions-test")
+		Expect(err).NotTo(HaveOccurred())
+
+		By("listing created objects in status")
+		fetched := topology.Definitions{}
+		Eventually(func() []topology.Condition {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: definitions.Name, Namespace: definitions.Namespace}, &fetched)).To(Succeed())
+			return fetched.Status.Conditions
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "Definitions status condition should be present")
+
+		readyCondition := fetched.Status.Conditions[0]
+		Expect(string(readyCondition.Type)).To(Equal("Ready"))
+		Expect(readyCondition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(fetched.Status.Created).To(HaveLen(4))
+		Expect(fetched.Status.AlreadyExisted).To(BeEmpty())
+		Expect(fetched.Status.Failed).To(BeEmpty())
+
+		By("deleting imported objects")
+		Expect(k8sClient.Delete(ctx, definitions)).To(Succeed())
+		Eventually(func() error {
+			_, err = rabbitClient.GetQueue("/", "definitions-test")
+			return err
+		}, 10).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Object Not Found"))
+		_, err = rabbitClient.GetExchange("/", "definitions-test")
+		Expect(err).To(HaveOccurred())
+		_, err = rabbitClient.GetPolicy("/", "definitions-test")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -84,7 +84,7 @@ var _ = Describe("federation", func() {
 		Eventually(func() []topology.Condition {
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: federation.Name, Namespace: federation.Namespace}, &updatedFederation)).To(Succeed())
 			return updatedFederation.Status.Conditions
-		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "Federation status condition should be present")
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(2), "Federation status conditions should be present")
 
 		readyCondition := updatedFederation.Status.Conditions[0]
 		Expect(string(readyCondition.Type)).To(Equal("Ready"))
@@ -95,6 +95,14 @@ var _ = Describe("federation", func() {
 		By("setting status.observedGeneration")
 		Expect(updatedFederation.Status.ObservedGeneration).To(Equal(updatedFederation.GetGeneration()))
 
+		By("reporting that no federation link uses the upstream")
+		// no policy applies the upstream to exchanges or queues in this test
+		runningCondition := updatedFederation.Status.Conditions[1]
+		Expect(string(runningCondition.Type)).To(Equal("Running"))
+		Expect(runningCondition.Status).To(Equal(corev1.ConditionFalse))
+		Expect(runningCondition.Reason).To(Equal("NoLinks"))
+		Expect(updatedFederation.Status.Links).To(BeEmpty())
+
 		By("not allowing updates on certain fields")
 		updateTest := topology.Federation{}
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: federation.Name, Namespace: federation.Namespace}, &updateTest)).To(Succeed())
@@ -0,0 +1,117 @@
+package system_tests
+
+import (
+	"context"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+var _ = Describe("OperatorPolicy", func() {
+	var (
+		namespace      = MustHaveEnv("NAMESPACE")
+		ctx            = context.Background()
+		operatorPolicy *topology.OperatorPolicy
+	)
+
+	BeforeEach(func() {
+		operatorPolicy = &topology.OperatorPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "operator-policy-test",
+				Namespace: namespace,
+			},
+			Spec: topology.OperatorPolicySpec{
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: rmq.Name,
+				},
+				Name:    "operator-policy-test",
+				Pattern: "test-queue",
+				ApplyTo: "queues",
+				Definition: &runtime.RawExtension{
+					Raw: []byte(`{"max-length":10000}`),
+				},
+			},
+		}
+	})
+
+	It("creates, updates and deletes an operator policy successfully", func() {
+		By("creating operator policy")
+		Expect(k8sClient.Create(ctx, operatorPolicy, &client.CreateOptions{})).To(Succeed())
+		var fetchedOperatorPolicy *rabbithole.OperatorPolicy
+		Eventually(func() error {
+			var err error
+			fetchedOperatorPolicy, err = rabbitClient.GetOperatorPolicy(operatorPolicy.Spec.Vhost, operatorPolicy.Spec.Name)
+			return err
+		}, 10, 2).Should(BeNil())
+
+		Expect(*fetchedOperatorPolicy).To(MatchFields(IgnoreExtras, Fields{
+			"Name":     Equal(operatorPolicy.Spec.Name),
+			"Vhost":    Equal(operatorPolicy.Spec.Vhost),
+			"Pattern":  Equal("test-queue"),
+			"ApplyTo":  Equal("queues"),
+			"Priority": Equal(0),
+		}))
+
+		Expect(fetchedOperatorPolicy.Definition).To(HaveKeyWithValue("max-length", float64(10000)))
+
+		By("updating status condition 'Ready'")
+		updatedOperatorPolicy := topology.OperatorPolicy{}
+
+		Eventually(func() []topology.Condition {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &updatedOperatorPolicy)).To(Succeed())
+			return updatedOperatorPolicy.Status.Conditions
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "OperatorPolicy status condition should be present")
+
+		readyCondition := updatedOperatorPolicy.Status.Conditions[0]
+		Expect(string(readyCondition.Type)).To(Equal("Ready"))
+		Expect(readyCondition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(readyCondition.Reason).To(Equal("SuccessfulCreateOrUpdate"))
+		Expect(readyCondition.LastTransitionTime).NotTo(Equal(metav1.Time{}))
+
+		By("setting status.observedGeneration")
+		Expect(updatedOperatorPolicy.Status.ObservedGeneration).To(Equal(updatedOperatorPolicy.GetGeneration()))
+
+		By("not allowing definition keys that operator policies do not support")
+		invalidTest := topology.OperatorPolicy{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, &invalidTest)).To(Succeed())
+		invalidTest.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"ha-mode":"all"}`)}
+		Expect(k8sClient.Update(ctx, &invalidTest).Error()).To(ContainSubstring(`spec.definition[ha-mode]: Unsupported value: "ha-mode"`))
+
+		By("updating operator policy definitions successfully")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: operatorPolicy.Name, Namespace: operatorPolicy.Namespace}, operatorPolicy)).To(Succeed())
+		operatorPolicy.Spec.Definition = &runtime.RawExtension{
+			Raw: []byte(`{"max-length":100,
+"message-ttl": 60000
+}`)}
+		Expect(k8sClient.Update(ctx, operatorPolicy, &client.UpdateOptions{})).To(Succeed())
+
+		Eventually(func() rabbithole.PolicyDefinition {
+			var err error
+			fetchedOperatorPolicy, err = rabbitClient.GetOperatorPolicy(operatorPolicy.Spec.Vhost, operatorPolicy.Spec.Name)
+			Expect(err).NotTo(HaveOccurred())
+			return fetchedOperatorPolicy.Definition
+		}, 10, 2).Should(HaveLen(2))
+
+		Expect(fetchedOperatorPolicy.Definition).To(HaveKeyWithValue("max-length", float64(100)))
+		Expect(fetchedOperatorPolicy.Definition).To(HaveKeyWithValue("message-ttl", float64(60000)))
+
+		By("deleting operator policy")
+		Expect(k8sClient.Delete(ctx, operatorPolicy)).To(Succeed())
+		var err error
+		Eventually(func() error {
+			_, err = rabbitClient.GetOperatorPolicy(operatorPolicy.Spec.Vhost, operatorPolicy.Spec.Name)
+			return err
+		}, 10).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Object Not Found"))
+	})
+})
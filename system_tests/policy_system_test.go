@@ -87,6 +87,11 @@ var _ = Describe("Policy", func() {
 		updateTest.Spec.Vhost = "/a-new-vhost"
 		Expect(k8sClient.Update(ctx, &updateTest).Error()).To(ContainSubstring("spec.vhost: Forbidden: updates on name, vhost and rabbitmqClusterReference are all forbidden"))
 
+		By("not allowing invalid definitions")
+		updateTest.Spec.Vhost = policy.Spec.Vhost
+		updateTest.Spec.Definition = &runtime.RawExtension{Raw: []byte(`{"alternate-exchange": "an-exchange"}`)}
+		Expect(k8sClient.Update(ctx, &updateTest).Error()).To(ContainSubstring("spec.definition[alternate-exchange]: Invalid value: \"an-exchange\": does not apply to queues"))
+
 		By("updating policy definitions successfully")
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: policy.Name, Namespace: policy.Namespace}, policy)).To(Succeed())
 		policy.Spec.Definition = &runtime.RawExtension{
@@ -41,6 +41,8 @@ var _ = Describe("Queue Controller", func() {
 				Arguments: &runtime.RawExtension{
 					Raw: []byte(`{"x-quorum-initial-group-size": 3}`),
 				},
+				DeadLetterExchange: "queue-test-dlx",
+				Overflow:           "reject-publish",
 			},
 		}
 	})
@@ -63,6 +65,8 @@ var _ = Describe("Queue Controller", func() {
 		}))
 		Expect(qInfo.Arguments).To(HaveKeyWithValue("x-quorum-initial-group-size", float64(3)))
 		Expect(qInfo.Arguments).To(HaveKeyWithValue("x-queue-type", "quorum"))
+		Expect(qInfo.Arguments).To(HaveKeyWithValue("x-dead-letter-exchange", "queue-test-dlx"))
+		Expect(qInfo.Arguments).To(HaveKeyWithValue("x-overflow", "reject-publish"))
 
 		By("updating status condition 'Ready'")
 		updatedQueue := topology.Queue{}
@@ -89,6 +93,12 @@ var _ = Describe("Queue Controller", func() {
 		updateQ.Spec.Name = q.Spec.Name
 		updateQ.Spec.Type = "classic"
 		Expect(k8sClient.Update(ctx, &updateQ).Error()).To(ContainSubstring("spec.type: Invalid value: \"classic\": queue type cannot be updated"))
+		updateQ.Spec.Type = q.Spec.Type
+		updateQ.Spec.DeadLetterExchange = "another-dlx"
+		Expect(k8sClient.Update(ctx, &updateQ).Error()).To(ContainSubstring("spec.deadLetterExchange: Invalid value: \"another-dlx\": x-dead-letter-exchange cannot be updated"))
+		updateQ.Spec.DeadLetterExchange = q.Spec.DeadLetterExchange
+		updateQ.Spec.Arguments = &runtime.RawExtension{Raw: []byte(`{"x-max-priority": 10}`)}
+		Expect(k8sClient.Update(ctx, &updateQ).Error()).To(ContainSubstring("spec.arguments[x-max-priority]: Invalid value: 10: not supported by queues of type 'quorum'"))
 
 		By("deleting queue")
 		Expect(k8sClient.Delete(ctx, q)).To(Succeed())
@@ -99,4 +109,51 @@ var _ = Describe("Queue Controller", func() {
 		}, 30).Should(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("Object Not Found"))
 	})
+
+	It("declares a stream with stream settings", func() {
+		q.Name = "stream-test"
+		q.Spec.Name = "stream-test"
+		q.Spec.Type = "stream"
+		q.Spec.Arguments = nil
+		q.Spec.DeadLetterExchange = ""
+		q.Spec.Overflow = ""
+		q.Spec.Stream = &topology.StreamSettings{
+			MaxAge:              "7D",
+			MaxLengthBytes:      20000000000,
+			MaxSegmentSizeBytes: 50000000,
+			InitialClusterSize:  1,
+		}
+
+		By("declaring stream")
+		Expect(k8sClient.Create(ctx, q, &client.CreateOptions{})).To(Succeed())
+		var qInfo *rabbithole.DetailedQueueInfo
+		Eventually(func() error {
+			var err error
+			qInfo, err = rabbitClient.GetQueue(q.Spec.Vhost, q.Spec.Name)
+			return err
+		}, 10, 2).Should(BeNil())
+
+		Expect(qInfo.Arguments).To(SatisfyAll(
+			HaveKeyWithValue("x-queue-type", "stream"),
+			HaveKeyWithValue("x-max-age", "7D"),
+			HaveKeyWithValue("x-max-length-bytes", float64(20000000000)),
+			HaveKeyWithValue("x-stream-max-segment-size-bytes", float64(50000000)),
+			HaveKeyWithValue("x-initial-cluster-size", float64(1)),
+		))
+
+		By("not allowing updates on stream settings")
+		updateQ := topology.Queue{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: q.Name, Namespace: q.Namespace}, &updateQ)).To(Succeed())
+		updateQ.Spec.Stream.MaxAge = "1D"
+		Expect(k8sClient.Update(ctx, &updateQ).Error()).To(ContainSubstring("spec.stream.maxAge: Invalid value"))
+
+		By("deleting stream")
+		Expect(k8sClient.Delete(ctx, q)).To(Succeed())
+		var err error
+		Eventually(func() error {
+			_, err = rabbitClient.GetQueue(q.Spec.Vhost, q.Spec.Name)
+			return err
+		}, 30).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Object Not Found"))
+	})
 })
@@ -0,0 +1,110 @@
+package system_tests
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+var _ = Describe("RuntimeParameter", func() {
+	var (
+		namespace = MustHaveEnv("NAMESPACE")
+		ctx       = context.Background()
+		parameter *topology.RuntimeParameter
+	)
+
+	BeforeEach(func() {
+		parameter = &topology.RuntimeParameter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "runtime-parameter-test",
+				Namespace: namespace,
+			},
+			Spec: topology.RuntimeParameterSpec{
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: rmq.Name,
+				},
+				Name:      "runtime-parameter-test",
+				Component: "federation-upstream-set",
+				Value: &runtime.RawExtension{
+					Raw: []byte(`[{"upstream":"upstream-1"}]`),
+				},
+			},
+		}
+	})
+
+	It("creates, updates and deletes a runtime parameter successfully", func() {
+		By("creating runtime parameter")
+		Expect(k8sClient.Create(ctx, parameter, &client.CreateOptions{})).To(Succeed())
+		Eventually(func() interface{} {
+			fetched, err := rabbitClient.GetRuntimeParameter("federation-upstream-set", "/", "runtime-parameter-test")
+			if err != nil {
+				return nil
+			}
+			return fetched.Value
+		}, 10, 2).Should(Equal([]interface{}{map[string]interface{}{"upstream": "upstream-1"}}))
+
+		By("updating status condition 'Ready'")
+		updatedParameter := topology.RuntimeParameter{}
+
+		Eventually(func() []topology.Condition {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, &updatedParameter)).To(Succeed())
+			return updatedParameter.Status.Conditions
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "RuntimeParameter status condition should be present")
+
+		readyCondition := updatedParameter.Status.Conditions[0]
+		Expect(string(readyCondition.Type)).To(Equal("Ready"))
+		Expect(readyCondition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(readyCondition.Reason).To(Equal("SuccessfulCreateOrUpdate"))
+		Expect(readyCondition.LastTransitionTime).NotTo(Equal(metav1.Time{}))
+
+		By("setting status.observedGeneration")
+		Expect(updatedParameter.Status.ObservedGeneration).To(Equal(updatedParameter.GetGeneration()))
+
+		By("updating runtime parameter value successfully")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: parameter.Name, Namespace: parameter.Namespace}, parameter)).To(Succeed())
+		parameter.Spec.Value = &runtime.RawExtension{Raw: []byte(`[{"upstream":"upstream-1"},{"upstream":"upstream-2"}]`)}
+		Expect(k8sClient.Update(ctx, parameter, &client.UpdateOptions{})).To(Succeed())
+		Eventually(func() interface{} {
+			fetched, err := rabbitClient.GetRuntimeParameter("federation-upstream-set", "/", "runtime-parameter-test")
+			Expect(err).NotTo(HaveOccurred())
+			return fetched.Value
+		}, 10, 2).Should(HaveLen(2))
+
+		By("reporting a conflict when another object targets the same runtime parameter")
+		conflicting := &topology.RuntimeParameter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "runtime-parameter-test-conflict",
+				Namespace: namespace,
+			},
+			Spec: *parameter.Spec.DeepCopy(),
+		}
+		Expect(k8sClient.Create(ctx, conflicting, &client.CreateOptions{})).To(Succeed())
+		Eventually(func() string {
+			fetched := topology.RuntimeParameter{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: conflicting.Name, Namespace: conflicting.Namespace}, &fetched)).To(Succeed())
+			if len(fetched.Status.Conditions) == 0 {
+				return ""
+			}
+			return fetched.Status.Conditions[0].Message
+		}, waitUpdatedStatusCondition, 2).Should(ContainSubstring("is already managed by RuntimeParameter"))
+		Expect(k8sClient.Delete(ctx, conflicting)).To(Succeed())
+
+		By("deleting runtime parameter")
+		Expect(k8sClient.Delete(ctx, parameter)).To(Succeed())
+		var err error
+		Eventually(func() error {
+			_, err = rabbitClient.GetRuntimeParameter("federation-upstream-set", "/", "runtime-parameter-test")
+			return err
+		}, 10).Should(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Object Not Found"))
+	})
+})
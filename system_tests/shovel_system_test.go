@@ -11,6 +11,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
 )
 
@@ -90,7 +91,7 @@ var _ = Describe("Shovel", func() {
 		Eventually(func() []topology.Condition {
 			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: shovel.Name, Namespace: shovel.Namespace}, &updatedShovel)).To(Succeed())
 			return updatedShovel.Status.Conditions
-		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "Shovel status condition should be present")
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(2), "Shovel status conditions should be present")
 
 		readyCondition := updatedShovel.Status.Conditions[0]
 		Expect(string(readyCondition.Type)).To(Equal("Ready"))
@@ -101,6 +102,20 @@ var _ = Describe("Shovel", func() {
 		By("setting status.observedGeneration")
 		Expect(updatedShovel.Status.ObservedGeneration).To(Equal(updatedShovel.GetGeneration()))
 
+		By("reporting the live shovel state")
+		// source and destination uris are not reachable, so the shovel never runs
+		Eventually(func() topology.ShovelStatus {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: shovel.Name, Namespace: shovel.Namespace}, &updatedShovel)).To(Succeed())
+			return updatedShovel.Status
+		}, 60, 5).Should(MatchFields(IgnoreExtras, Fields{
+			"State": BeElementOf("starting", "terminated"),
+			"Node":  Not(BeEmpty()),
+			"Conditions": ContainElement(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(topology.ConditionType("Running")),
+				"Status": Equal(corev1.ConditionFalse),
+			})),
+		}))
+
 		By("not allowing updates on certain fields")
 		updateTest := topology.Shovel{}
 		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: shovel.Name, Namespace: shovel.Namespace}, &updateTest)).To(Succeed())
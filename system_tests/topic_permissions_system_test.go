@@ -0,0 +1,150 @@
+package system_tests
+
+import (
+	"context"
+
+	rabbithole "github.com/michaelklishin/rabbit-hole/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	topology "github.com/rabbitmq/messaging-topology-operator/api/v1beta1"
+)
+
+var _ = Describe("TopicPermission", func() {
+	var (
+		namespace       = MustHaveEnv("NAMESPACE")
+		ctx             = context.Background()
+		topicPermission *topology.TopicPermission
+		user            *topology.User
+		username        string
+	)
+
+	BeforeEach(func() {
+		user = &topology.User{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "topicuser",
+				Namespace: namespace,
+			},
+			Spec: topology.UserSpec{
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: rmq.Name,
+				},
+				Tags: []topology.UserTag{"management"},
+			},
+		}
+		Expect(k8sClient.Create(ctx, user, &client.CreateOptions{})).To(Succeed())
+		generatedSecretKey := types.NamespacedName{
+			Name:      "topicuser-user-credentials",
+			Namespace: namespace,
+		}
+		var generatedSecret = &corev1.Secret{}
+		Eventually(func() error {
+			return k8sClient.Get(ctx, generatedSecretKey, generatedSecret)
+		}, 30, 2).Should(Succeed())
+		username = string(generatedSecret.Data["username"])
+
+		topicPermission = &topology.TopicPermission{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "user-topic-permission",
+				Namespace: namespace,
+			},
+			Spec: topology.TopicPermissionSpec{
+				Vhost: "/",
+				User:  username,
+				Permissions: topology.TopicPermissionConfig{
+					Exchange: "amq.topic",
+					Read:     ".*",
+				},
+				RabbitmqClusterReference: topology.RabbitmqClusterReference{
+					Name: rmq.Name,
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, user)).To(Succeed())
+		Eventually(func() string {
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: user.Name, Namespace: user.Namespace}, &topology.User{}); err != nil {
+				return err.Error()
+			}
+			return ""
+		}, 10).Should(ContainSubstring("not found"))
+	})
+
+	DescribeTable("Server configurations updates", func(testcase string) {
+		if testcase == "UserReference" {
+			topicPermission.Spec.User = ""
+			topicPermission.Spec.UserReference = &corev1.LocalObjectReference{Name: user.Name}
+		}
+		Expect(k8sClient.Create(ctx, topicPermission, &client.CreateOptions{})).To(Succeed())
+		var fetchedTopicPermissionInfo []rabbithole.TopicPermissionInfo
+		Eventually(func() int {
+			var err error
+			fetchedTopicPermissionInfo, err = rabbitClient.GetTopicPermissionsIn(topicPermission.Spec.Vhost, username)
+			if err != nil {
+				return 0
+			}
+			return len(fetchedTopicPermissionInfo)
+		}, 20, 2).Should(Equal(1))
+		Expect(fetchedTopicPermissionInfo[0]).To(MatchFields(IgnoreExtras, Fields{
+			"Vhost":    Equal(topicPermission.Spec.Vhost),
+			"User":     Equal(username),
+			"Exchange": Equal("amq.topic"),
+			"Read":     Equal(topicPermission.Spec.Permissions.Read),
+			"Write":    Equal(topicPermission.Spec.Permissions.Write),
+		}))
+
+		By("updating status condition 'Ready'")
+		updatedTopicPermission := topology.TopicPermission{}
+
+		Eventually(func() []topology.Condition {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: topicPermission.Name, Namespace: topicPermission.Namespace}, &updatedTopicPermission)).To(Succeed())
+			return updatedTopicPermission.Status.Conditions
+		}, waitUpdatedStatusCondition, 2).Should(HaveLen(1), "TopicPermission status condition should be present")
+
+		readyCondition := updatedTopicPermission.Status.Conditions[0]
+		Expect(string(readyCondition.Type)).To(Equal("Ready"))
+		Expect(readyCondition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(readyCondition.Reason).To(Equal("SuccessfulCreateOrUpdate"))
+		Expect(readyCondition.LastTransitionTime).NotTo(Equal(metav1.Time{}))
+
+		By("not allowing updates on certain fields")
+		updateTest := topology.TopicPermission{}
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: topicPermission.Name, Namespace: topicPermission.Namespace}, &updateTest)).To(Succeed())
+		updateTest.Spec.Permissions.Exchange = "a-new-exchange"
+		Expect(k8sClient.Update(ctx, &updateTest).Error()).To(ContainSubstring("spec.permissions.exchange: Forbidden: updates on exchange, user, userReference, vhost and rabbitmqClusterReference are all forbidden"))
+
+		By("updating topic permissions successfully")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: topicPermission.Name, Namespace: topicPermission.Namespace}, topicPermission)).To(Succeed())
+		topicPermission.Spec.Permissions.Write = ".*"
+		topicPermission.Spec.Permissions.Read = "^$"
+		Expect(k8sClient.Update(ctx, topicPermission, &client.UpdateOptions{})).To(Succeed())
+
+		Eventually(func() string {
+			var err error
+			fetchedTopicPermissionInfo, err = rabbitClient.GetTopicPermissionsIn(topicPermission.Spec.Vhost, username)
+			Expect(err).NotTo(HaveOccurred())
+			return fetchedTopicPermissionInfo[0].Write
+		}, 20, 2).Should(Equal(".*"))
+		Expect(fetchedTopicPermissionInfo[0].Read).To(Equal("^$"))
+
+		By("revoking topic permissions successfully")
+		Expect(k8sClient.Delete(ctx, topicPermission)).To(Succeed())
+		Eventually(func() int {
+			topicPermissionInfos, err := rabbitClient.ListTopicPermissionsOf(username)
+			Expect(err).NotTo(HaveOccurred())
+			return len(topicPermissionInfos)
+		}, 10, 2).Should(Equal(0))
+	},
+
+		Entry("grants and revokes topic permissions successfully when spec.user is set", "User"),
+		Entry("grants and revokes topic permissions successfully when spec.userReference is set", "UserReference"),
+	)
+})
@@ -74,6 +74,37 @@ var _ = Describe("vhost", func() {
 		updateTest.Spec.Name = "new-name"
 		Expect(k8sClient.Update(ctx, &updateTest).Error()).To(ContainSubstring("spec.name: Forbidden: updates on name and rabbitmqClusterReference are all forbidden"))
 
+		By("applying vhost limits")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: vhost.Name, Namespace: vhost.Namespace}, vhost)).To(Succeed())
+		maxConnections := int32(10)
+		vhost.Spec.Limits = &topology.VhostLimits{MaxConnections: &maxConnections}
+		Expect(k8sClient.Update(ctx, vhost)).To(Succeed())
+		Eventually(func() rabbithole.VhostLimitsValues {
+			limits, err := rabbitClient.GetVhostLimits(vhost.Spec.Name)
+			Expect(err).NotTo(HaveOccurred())
+			if len(limits) == 0 {
+				return nil
+			}
+			return limits[0].Value
+		}, 30, 2).Should(Equal(rabbithole.VhostLimitsValues{"max-connections": 10}))
+		Eventually(func() *topology.VhostLimits {
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: vhost.Name, Namespace: vhost.Namespace}, &updatedVhost)).To(Succeed())
+			return updatedVhost.Status.Limits
+		}, waitUpdatedStatusCondition, 2).Should(Equal(&topology.VhostLimits{MaxConnections: &maxConnections}))
+
+		By("clearing vhost limits")
+		Expect(k8sClient.Get(ctx, types.NamespacedName{Name: vhost.Name, Namespace: vhost.Namespace}, vhost)).To(Succeed())
+		vhost.Spec.Limits = nil
+		Expect(k8sClient.Update(ctx, vhost)).To(Succeed())
+		Eventually(func() int {
+			limits, err := rabbitClient.GetVhostLimits(vhost.Spec.Name)
+			Expect(err).NotTo(HaveOccurred())
+			if len(limits) == 0 {
+				return 0
+			}
+			return len(limits[0].Value)
+		}, 30, 2).Should(Equal(0))
+
 		By("deleting a vhost")
 		Expect(k8sClient.Delete(ctx, vhost)).To(Succeed())
 		var err error